@@ -129,6 +129,9 @@ import (
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity"
 	meshseckeeper "github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/keeper"
 	meshsectypes "github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+	meshsecprov "github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider"
+	meshsecprovkeeper "github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/keeper"
+	meshsecprovtypes "github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/types"
 )
 
 const appName = "MeshApp"
@@ -200,6 +203,7 @@ var (
 		ica.AppModuleBasic{},
 		ibcfee.AppModuleBasic{},
 		meshsecurity.AppModuleBasic{},
+		meshsecprov.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -263,6 +267,7 @@ type MeshApp struct {
 	TransferKeeper      ibctransferkeeper.Keeper
 	WasmKeeper          wasmkeeper.Keeper
 	MeshSecKeeper       *meshseckeeper.Keeper
+	MeshSecProvKeeper   *meshsecprovkeeper.Keeper
 
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
@@ -314,6 +319,7 @@ func NewMeshApp(
 		wasmtypes.StoreKey, icahosttypes.StoreKey,
 		icacontrollertypes.StoreKey,
 		meshsectypes.StoreKey,
+		meshsecprovtypes.StoreKey,
 	)
 
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
@@ -415,6 +421,17 @@ func NewMeshApp(
 		app.StakingKeeper,
 		&app.WasmKeeper, // ensure this is a pointer as we instantiate the keeper a bit later
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		meshseckeeper.WithSlashingKeeper(&app.SlashingKeeper), // ensure this is a pointer as we instantiate the keeper a bit later
+		meshseckeeper.WithDistributionKeeper(app.DistrKeeper),
+	)
+
+	// setup the provider side of mesh-security for the vault and native staking contracts
+	app.MeshSecProvKeeper = meshsecprovkeeper.NewKeeper(
+		app.appCodec,
+		keys[meshsecprovtypes.StoreKey],
+		app.StakingKeeper,
+		app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
@@ -586,14 +603,17 @@ func NewMeshApp(
 		return wasmkeeper.NewMessageHandlerChain(
 			// security layer for system integrity, should always be first in chain
 			meshseckeeper.NewIntegrityHandler(app.MeshSecKeeper),
+			meshsecprovkeeper.NewIntegrityHandler(app.MeshSecProvKeeper),
 			nested,
-			// append our custom message handler for mesh-security
+			// append our custom message handlers for mesh-security
 			meshseckeeper.NewDefaultCustomMsgHandler(app.MeshSecKeeper),
+			meshsecprovkeeper.NewCustomMsgHandler(app.MeshSecProvKeeper),
 		)
 	})
 	wasmOpts = append(wasmOpts, meshMessageHandler,
 		// add support for the mesh-security queries
 		wasmkeeper.WithQueryHandlerDecorator(meshseckeeper.NewQueryDecorator(app.MeshSecKeeper, app.SlashingKeeper)),
+		wasmkeeper.WithQueryHandlerDecorator(meshsecprovkeeper.NewQueryDecorator(app.MeshSecProvKeeper)),
 	)
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
@@ -694,6 +714,7 @@ func NewMeshApp(
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		meshsecurity.NewAppModule(appCodec, app.MeshSecKeeper),
+		meshsecprov.NewAppModule(app.MeshSecProvKeeper),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them
 	)
 
@@ -715,6 +736,7 @@ func NewMeshApp(
 		ibcfeetypes.ModuleName,
 		wasmtypes.ModuleName,
 		meshsectypes.ModuleName,
+		meshsecprovtypes.ModuleName,
 	)
 
 	app.ModuleManager.SetOrderEndBlockers(
@@ -730,6 +752,7 @@ func NewMeshApp(
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		wasmtypes.ModuleName,
+		meshsecprovtypes.ModuleName,
 		meshsectypes.ModuleName, // last to capture all chain events
 	)
 
@@ -755,6 +778,7 @@ func NewMeshApp(
 		// wasm after ibc transfer
 		wasmtypes.ModuleName,
 		meshsectypes.ModuleName,
+		meshsecprovtypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
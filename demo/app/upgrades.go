@@ -85,7 +85,15 @@ func (app MeshApp) RegisterUpgradeHandlers() {
 			// Note: this migration is optional,
 			// You can include x/gov proposal migration documented in [UPGRADING.md](https://github.com/cosmos/cosmos-sdk/blob/main/UPGRADING.md)
 
-			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			versionMap, err := app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			if err != nil {
+				return nil, err
+			}
+			// let the virtual staking contracts resync their validator set after the upgrade
+			if err := app.MeshSecKeeper.ScheduleValsetSnapshots(ctx); err != nil {
+				return nil, err
+			}
+			return versionMap, nil
 		},
 	)
 
@@ -292,19 +292,27 @@ func (p *TestConsumerClient) ExecNewEpoch() {
 	}
 }
 
-// MustEnableVirtualStaking add authority to mint/burn virtual tokens gov proposal
+// MustEnableVirtualStaking add authority to mint/burn virtual tokens gov proposal.
+// The released virtual staking contract supports the legacy valset update format only and no valset snapshots.
 func (p *TestConsumerClient) MustEnableVirtualStaking(maxCap sdk.Coin) {
-	govProposal := &types.MsgSetVirtualStakingMaxCap{
-		Authority: p.app.MeshSecKeeper.GetAuthority(),
-		Contract:  p.contracts.staking.String(),
-		MaxCap:    maxCap,
-	}
-	p.MustExecGovProposal(govProposal)
+	authority := p.app.MeshSecKeeper.GetAuthority()
+	p.MustExecGovProposal(
+		&types.MsgSetVirtualStakingMaxCap{
+			Authority: authority,
+			Contract:  p.contracts.staking.String(),
+			MaxCap:    maxCap,
+		},
+		&types.MsgSetContractConfig{
+			Authority: authority,
+			Contract:  p.contracts.staking.String(),
+			Config:    types.ContractConfig{LegacySlashes: true, LegacyTombstones: true, LegacyValidators: true, DisableValsetSnapshots: true},
+		},
+	)
 }
 
 // MustExecGovProposal submit and vote yes on proposal
-func (p *TestConsumerClient) MustExecGovProposal(msg *types.MsgSetVirtualStakingMaxCap) {
-	proposalID := submitGovProposal(p.t, p.chain, msg)
+func (p *TestConsumerClient) MustExecGovProposal(msgs ...sdk.Msg) {
+	proposalID := submitGovProposal(p.t, p.chain, msgs...)
 	voteAndPassGovProposal(p.t, p.chain, proposalID)
 }
 
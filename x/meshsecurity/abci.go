@@ -22,52 +22,82 @@ func EndBlocker(ctx sdk.Context, k *keeper.Keeper, h TaskExecutionResponseHandle
 
 	do := rspHandler(ctx, h)
 	epochLength := k.GetRebalanceEpochLength(ctx)
-	do(k.ExecScheduledTasks(ctx, types.SchedulerTaskValsetUpdate, epochLength, func(ctx sdk.Context, contract sdk.AccAddress) error {
-		report, err := k.ValsetUpdateReport(ctx)
+	valsetUpdate := func(ctx sdk.Context, contract sdk.AccAddress) ([]byte, error) {
+		report, lastSeq, err := k.ValsetUpdateReport(ctx, contract)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		// If there was a slashing event, multiply the total slash amount by the delegator shares ratio for `contract`
-		if report.Slashed != nil {
-			for i, slash := range report.Slashed {
-				valAddr, err := sdk.ValAddressFromBech32(slash.ValidatorAddr)
-				if err != nil {
-					return fmt.Errorf("invalid validator address %s", slash.ValidatorAddr)
-				}
-				totalSlashAmount, ok := sdk.NewIntFromString(slash.SlashAmount)
-				if !ok {
-					return fmt.Errorf("invalid slash amount %s", slash.SlashAmount)
-				}
-				// Get total validator shares
-				validator, found := k.Staking.GetValidator(ctx, valAddr)
-				if !found {
-					return fmt.Errorf("validator %s not found", slash.ValidatorAddr)
-				}
-				validatorShares := validator.GetDelegatorShares()
-
-				delegatorSlashAmount := sdk.ZeroDec()
-				if !validatorShares.IsZero() {
-					// Query the `contract` delegation
-					delegation, found := k.Staking.GetDelegation(ctx, contract, valAddr)
-					delegatorShares := sdk.ZeroDec()
-					if found {
-						delegatorShares = delegation.GetShares()
-					}
-					delegatorSlashAmount = delegatorShares.Quo(validatorShares).MulInt(totalSlashAmount)
-				}
-
-				// Pass it to the contract
-				// FIXME? Remove entries with zero slash amounts from the Slashed array
-				// TODO: Convert to Coin
-				report.Slashed[i].SlashAmount = delegatorSlashAmount.RoundInt().String()
+		if lastSeq == 0 { // nothing pending, all entries were delivered already
+			return nil, nil
+		}
+		// the payload marks a failure in the contract for a retry. The retry rebuilds the report from the outbox
+		payload, err := k.EncodeValsetUpdate(ctx, contract, report)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.SendRawSudoMsg(ctx, contract, payload); err != nil {
+			return payload, err
+		}
+		// entries are removed from the outbox only after a successful delivery
+		k.AckValsetOutbox(ctx, contract, lastSeq)
+		return payload, nil
+	}
+	valsetSnapshot := func(ctx sdk.Context, contract sdk.AccAddress) ([]byte, error) {
+		if k.GetContractConfig(ctx, contract).DisableValsetSnapshots { // disabled after the task was scheduled
+			return nil, nil
+		}
+		snapshot, err := k.ValsetSnapshot(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// a retry sends a new snapshot of the current state
+		payload, err := keeper.EncodeValsetSnapshot(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		return payload, k.SendRawSudoMsg(ctx, contract, payload)
+	}
+	// retries of failed valset updates are sent before the reports of the current block
+	do(k.ExecRetryTasks(ctx, types.SchedulerTaskValsetUpdate, valsetUpdate))
+	do(k.ExecScheduledTasks(ctx, types.SchedulerTaskValsetUpdate, epochLength, valsetUpdate))
+	// snapshots are sent after the updates of the block so that they include all changes
+	do(k.ExecRetryTasks(ctx, types.SchedulerTaskValsetSnapshot, valsetSnapshot))
+	do(k.ExecScheduledTasks(ctx, types.SchedulerTaskValsetSnapshot, epochLength, valsetSnapshot))
+	do(k.ExecScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, epochLength, func(ctx sdk.Context, contract sdk.AccAddress) ([]byte, error) {
+		// power changes of the epoch are captured and delivered before the rebalance. This runs within the cached
+		// context and gas limit of the epoch, so that nothing is stored when the epoch fails.
+		changed, err := k.CaptureValidatorPowerChanges(ctx, contract)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case !changed:
+		case k.IsScheduledTasksPaused(ctx, types.SchedulerTaskValsetUpdate, contract):
+			// delivered with the valset updates after resume
+			if err := k.ScheduleOneShotTask(ctx, types.SchedulerTaskValsetUpdate, contract, uint64(ctx.BlockHeight())); err != nil {
+				return nil, err
+			}
+		default:
+			if _, err := valsetUpdate(ctx, contract); err != nil {
+				return nil, err
 			}
 		}
-		return k.SendValsetUpdate(ctx, contract, report)
-	}))
-	k.ClearPipedValsetOperations(ctx)
-	do(k.ExecScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, epochLength, func(ctx sdk.Context, contract sdk.AccAddress) error {
-		return k.SendHandleEpoch(ctx, contract)
+		return nil, k.SendHandleEpoch(ctx, contract)
 	}))
+	// max caps are enforced after the rebalance so that the contract can undelegate by itself in the last block
+	maxCapEnforcement := func(ctx sdk.Context, contract sdk.AccAddress) ([]byte, error) {
+		// the unbonding is module work that is not limited by the contract gas, so that it can not run out of gas.
+		// A failure is stored for a retry with the message as payload. The retry enforces the limit at that time.
+		payload, err := k.EnforceMaxCap(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), contract)
+		if err != nil || payload == nil {
+			return payload, err
+		}
+		// the contract is always notified within its gas limit but a failure does not revert the enforcement
+		k.NotifyMaxCapEnforced(ctx, contract, payload)
+		return payload, nil
+	}
+	do(k.ExecRetryTasks(ctx, types.SchedulerTaskMaxCapEnforcement, maxCapEnforcement))
+	do(k.ExecScheduledTasks(ctx, types.SchedulerTaskMaxCapEnforcement, epochLength, maxCapEnforcement))
 }
 
 func rspHandler(ctx sdk.Context, h TaskExecutionResponseHandler) func(results []keeper.ExecResult, err error) {
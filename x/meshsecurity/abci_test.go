@@ -2,8 +2,10 @@ package meshsecurity
 
 import (
 	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -12,7 +14,10 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/keeper"
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
@@ -69,20 +74,76 @@ func TestEndBlocker(t *testing.T) {
 				assert.Contains(t, logRecords.String(), "failed to execute scheduled task")
 			},
 		},
+		"rebalance - power changes delivered before the epoch": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				addBondedValidator(t, ctx, keepers.StakingKeeper, 5)
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000)))
+				require.NoError(t,
+					k.ScheduleRepeatingTask(ctx, types.SchedulerTaskHandleEpoch, myContractAddr, uint64(ctx.BlockHeight())))
+			},
+			assert: func(t *testing.T, ctx sdk.Context) {
+				require.Len(t, capturedCalls, 2)
+				assert.Equal(t, myContractAddr, capturedCalls[0].contractAddress)
+				assert.Contains(t, string(capturedCalls[0].msg), `"power_changes":[{"address":`)
+				assert.Contains(t, string(capturedCalls[0].msg), `"power":5,"previous_power":0`)
+				assert.JSONEq(t, `{"handle_epoch":{}}`, string(capturedCalls[1].msg))
+				assert.NotContains(t, logRecords.String(), "failed")
+				// and the delivered entries are acknowledged
+				assert.Zero(t, outboxSize(ctx, k, myContractAddr))
+			},
+		},
+		"rebalance - contract errored on power changes": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				addBondedValidator(t, ctx, keepers.StakingKeeper, 5)
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000)))
+				require.NoError(t,
+					k.ScheduleRepeatingTask(ctx, types.SchedulerTaskHandleEpoch, myContractAddr, uint64(ctx.BlockHeight())))
+				contractErr = myError
+			},
+			assert: func(t *testing.T, ctx sdk.Context) {
+				require.Len(t, capturedCalls, 1)
+				assert.Contains(t, string(capturedCalls[0].msg), `"power_changes":[{"address":`)
+				assert.Contains(t, logRecords.String(), "failed to execute scheduled task")
+				// and the power changes are not stored so that they are captured again with the next epoch
+				assert.Zero(t, outboxSize(ctx, k, myContractAddr))
+				assert.True(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, myContractAddr, true))
+			},
+		},
+		"rebalance - power changes with paused valset updates": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				addBondedValidator(t, ctx, keepers.StakingKeeper, 5)
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000)))
+				require.NoError(t, k.PauseScheduledTasks(ctx, types.SchedulerTaskValsetUpdate, myContractAddr))
+				require.NoError(t,
+					k.ScheduleRepeatingTask(ctx, types.SchedulerTaskHandleEpoch, myContractAddr, uint64(ctx.BlockHeight())))
+			},
+			assert: func(t *testing.T, ctx sdk.Context) {
+				require.Len(t, capturedCalls, 1)
+				assert.JSONEq(t, `{"handle_epoch":{}}`, string(capturedCalls[0].msg))
+				// and the power changes are kept for delivery after resume
+				assert.Equal(t, 1, outboxSize(ctx, k, myContractAddr))
+				assert.True(t, k.HasScheduledTask(ctx, types.SchedulerTaskValsetUpdate, myContractAddr, false))
+			},
+		},
 		"valset update - multiple contracts": {
 			setup: func(t *testing.T, ctx sdk.Context) {
 				anyLimit := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000_000))
 				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, anyLimit))
 				require.NoError(t, k.SetMaxCapLimit(ctx, myOtherContractAddr, anyLimit))
+				require.NoError(t, k.SetContractConfig(ctx, myOtherContractAddr, types.ContractConfig{LegacyValidators: true}))
 				require.NoError(t, k.Hooks().AfterValidatorBonded(ctx, nil, val1.GetOperator()))
 			},
 			assert: func(t *testing.T, ctx sdk.Context) {
 				require.Len(t, capturedCalls, 2)
 				assert.Equal(t, myContractAddr, capturedCalls[0].contractAddress)
-				exp := fmt.Sprintf(`{"valset_update":{"additions":[{"address":"%s","commission":"0.000000000000000000","max_commission":"0.000000000000000000","max_change_rate":"0.000000000000000000"}],"removals":[],"updated":[],"jailed":[],"unjailed":[],"slashed":[],"tombstoned":[]}}`, val1.GetOperator())
+				pk, err := val1.ConsPubKey()
+				require.NoError(t, err)
+				exp := fmt.Sprintf(`{"valset_update":{"additions":[{"address":"%s","commission":"0.000000000000000000","max_commission":"0.000000000000000000","max_change_rate":"0.000000000000000000","moniker":"","cons_pubkey":"%s"}],"removals":[],"updated":[],"jailed":[],"unjailed":[],"slashed":[],"tombstoned":[],"power_changes":[]}}`, val1.GetOperator(), base64.StdEncoding.EncodeToString(pk.Bytes()))
 				assert.JSONEq(t, exp, string(capturedCalls[0].msg))
 
+				// and the legacy format is sent to the contract with legacy validators
 				assert.Equal(t, myOtherContractAddr, capturedCalls[1].contractAddress)
+				exp = fmt.Sprintf(`{"valset_update":{"additions":[{"address":"%s","commission":"0.000000000000000000","max_commission":"0.000000000000000000","max_change_rate":"0.000000000000000000"}],"removals":[],"updated":[],"jailed":[],"unjailed":[],"slashed":[],"tombstoned":[]}}`, val1.GetOperator())
 				assert.JSONEq(t, exp, string(capturedCalls[1].msg))
 				assert.NotContains(t, logRecords.String(), "failed")
 			},
@@ -100,6 +161,134 @@ func TestEndBlocker(t *testing.T) {
 				assert.Contains(t, logRecords.String(), "failed to execute scheduled task")
 			},
 		},
+		"valset snapshot": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				val2 := keeper.MinValidatorFixture(t)
+				val2.Status = stakingtypes.Bonded
+				keepers.StakingKeeper.SetValidator(ctx, val2)
+				require.NoError(t, k.ScheduleValsetSnapshot(ctx, myContractAddr))
+			},
+			assert: func(t *testing.T, ctx sdk.Context) {
+				require.Len(t, capturedCalls, 1)
+				assert.Equal(t, myContractAddr, capturedCalls[0].contractAddress)
+				assert.Contains(t, string(capturedCalls[0].msg), `"valset_snapshot":{`)
+				assert.Contains(t, string(capturedCalls[0].msg), `"status":"bonded","jailed":false,"tombstoned":false`)
+				assert.NotContains(t, logRecords.String(), "failed")
+				// and not scheduled again
+				assert.False(t, k.HasScheduledTask(ctx, types.SchedulerTaskValsetSnapshot, myContractAddr, false))
+			},
+		},
+		"valset snapshot - disabled after scheduling": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				require.NoError(t, k.ScheduleValsetSnapshot(ctx, myContractAddr))
+				require.NoError(t, k.SetContractConfig(ctx, myContractAddr, types.ContractConfig{DisableValsetSnapshots: true}))
+			},
+			assert: func(t *testing.T, ctx sdk.Context) {
+				assert.Empty(t, capturedCalls)
+				assert.NotContains(t, logRecords.String(), "failed")
+				assert.False(t, k.HasScheduledTask(ctx, types.SchedulerTaskValsetSnapshot, myContractAddr, false))
+			},
+		},
+		"valset snapshot - contract errored": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				require.NoError(t, k.ScheduleValsetSnapshot(ctx, myContractAddr))
+				contractErr = myError
+			},
+			assert: func(t *testing.T, ctx sdk.Context) {
+				require.Len(t, capturedCalls, 1)
+				assert.Contains(t, logRecords.String(), "failed to execute scheduled task")
+				// and stored for a retry
+				var retries []types.FailedTask
+				k.IterateRetryTasks(ctx, math.MaxUint64, func(task types.FailedTask) bool {
+					retries = append(retries, task)
+					return false
+				})
+				require.Len(t, retries, 1)
+				assert.Equal(t, uint32(types.SchedulerTaskValsetSnapshot), retries[0].Type)
+			},
+		},
+		"max cap enforcement - contract errored on notification": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				unbondedVal := val1
+				unbondedVal.Status = stakingtypes.Unbonded
+				keepers.StakingKeeper.SetValidator(ctx, unbondedVal)
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+				_, err := k.Delegate(ctx, myContractAddr, val1.GetOperator(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+				require.NoError(t, err)
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 4)))
+				require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskMaxCapEnforcement, myContractAddr, uint64(ctx.BlockHeight())))
+				contractErr = myError
+			},
+			assert: func(t *testing.T, ctx sdk.Context) {
+				require.Len(t, capturedCalls, 1)
+				exp := fmt.Sprintf(`{"max_cap_enforced":{"height":%d,"time":%d,"max_cap":{"denom":"stake","amount":"4"},"undelegations":[{"validator":"%s","amount":{"denom":"stake","amount":"6"}}]}}`,
+					ctx.BlockHeight(), ctx.BlockTime().Unix(), val1.GetOperator())
+				assert.JSONEq(t, exp, string(capturedCalls[0].msg))
+				assert.Contains(t, logRecords.String(), "failed to notify contract about max cap enforcement")
+				assert.NotContains(t, logRecords.String(), "failed to execute scheduled task")
+				// and the limit is enforced
+				assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 4), k.GetTotalDelegated(ctx, myContractAddr))
+				assert.False(t, k.HasScheduledTask(ctx, types.SchedulerTaskMaxCapEnforcement, myContractAddr, false))
+			},
+		},
+		"max cap enforcement - not limited by contract gas": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				unbondedVal := val1
+				unbondedVal.Status = stakingtypes.Unbonded
+				keepers.StakingKeeper.SetValidator(ctx, unbondedVal)
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+				_, err := k.Delegate(ctx, myContractAddr, val1.GetOperator(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+				require.NoError(t, err)
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 4)))
+				require.NoError(t, k.SetContractConfig(ctx, myContractAddr, types.ContractConfig{MaxGasEndBlocker: 1}))
+				require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskMaxCapEnforcement, myContractAddr, uint64(ctx.BlockHeight())))
+			},
+			assert: func(t *testing.T, ctx sdk.Context) {
+				require.Len(t, capturedCalls, 1)
+				assert.Contains(t, string(capturedCalls[0].msg), `"max_cap_enforced":{`)
+				assert.NotContains(t, logRecords.String(), "failed to execute scheduled task")
+				assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 4), k.GetTotalDelegated(ctx, myContractAddr))
+			},
+		},
+		"max cap enforcement - failure stored for retry": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				unbondedVal := val1
+				unbondedVal.Status = stakingtypes.Unbonded
+				keepers.StakingKeeper.SetValidator(ctx, unbondedVal)
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+				_, err := k.Delegate(ctx, myContractAddr, val1.GetOperator(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+				require.NoError(t, err)
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 4)))
+				require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskMaxCapEnforcement, myContractAddr, uint64(ctx.BlockHeight())))
+				// undelegations fail while the recorded total is below the undelegated amount
+				setTotalDelegated(t, ctx, keepers.StoreKey, myContractAddr, 1)
+			},
+			assert: func(t *testing.T, ctx sdk.Context) {
+				assert.Empty(t, capturedCalls)
+				assert.Contains(t, logRecords.String(), "failed to execute scheduled task")
+				var retries []types.FailedTask
+				k.IterateRetryTasks(ctx, math.MaxUint64, func(task types.FailedTask) bool {
+					retries = append(retries, task)
+					return false
+				})
+				require.Len(t, retries, 1)
+				assert.Equal(t, uint32(types.SchedulerTaskMaxCapEnforcement), retries[0].Type)
+				assert.Contains(t, string(retries[0].Payload), `"max_cap_enforced":{`)
+
+				// when the retry is due
+				setTotalDelegated(t, ctx, keepers.StoreKey, myContractAddr, 10)
+				retryCtx := ctx.WithBlockHeight(int64(retries[0].Height))
+				EndBlocker(retryCtx, k, DefaultExecutionResponseHandler())
+				// then the limit is enforced
+				require.Len(t, capturedCalls, 1)
+				assert.Contains(t, string(capturedCalls[0].msg), `"max_cap_enforced":{`)
+				assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 4), k.GetTotalDelegated(ctx, myContractAddr))
+				k.IterateRetryTasks(ctx, math.MaxUint64, func(task types.FailedTask) bool {
+					t.Errorf("unexpected retry task: %v", task)
+					return false
+				})
+			},
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -114,6 +303,33 @@ func TestEndBlocker(t *testing.T) {
 	}
 }
 
+// addBondedValidator stores a bonded validator with the given consensus power
+func addBondedValidator(t *testing.T, ctx sdk.Context, stakingKeeper *stakingkeeper.Keeper, power int64) {
+	val := keeper.MinValidatorFixture(t)
+	val.Status = stakingtypes.Bonded
+	val.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	val.DelegatorShares = sdk.NewDecFromInt(val.Tokens)
+	stakingKeeper.SetValidator(ctx, val)
+	stakingKeeper.SetValidatorByPowerIndex(ctx, val)
+}
+
+// setTotalDelegated overwrites the total delegated amount recorded for the contract
+func setTotalDelegated(t *testing.T, ctx sdk.Context, storeKey storetypes.StoreKey, contractAddr sdk.AccAddress, amount int64) {
+	bz, err := sdkmath.NewInt(amount).Marshal()
+	require.NoError(t, err)
+	ctx.KVStore(storeKey).Set(types.BuildTotalDelegatedAmountKey(contractAddr), bz)
+}
+
+// outboxSize returns the number of valset outbox entries pending for the contract
+func outboxSize(ctx sdk.Context, k *keeper.Keeper, contractAddr sdk.AccAddress) int {
+	var r int
+	k.IterateValsetOutbox(ctx, contractAddr, func(types.ValsetOutboxEntry) bool {
+		r++
+		return false
+	})
+	return r
+}
+
 type capturedSudo = struct {
 	contractAddress sdk.AccAddress
 	msg             []byte
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -33,6 +34,17 @@ func SubmitProposalCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		ProposalSetVirtualStakingMaxCapCmd(),
+		ProposalSetVirtualStakingMaxCapsCmd(),
+		ProposalSetContractConfigCmd(),
+		ProposalScheduleTaskCmd(),
+		ProposalRescheduleTaskCmd(),
+		ProposalPauseScheduledTasksCmd(),
+		ProposalResumeScheduledTasksCmd(),
+		ProposalDeleteScheduledTasksCmd(),
+		ProposalDeleteDeadLetterTasksCmd(),
+		ProposalUpdateParamsCmd(),
+		ProposalRemoveVirtualStakingContractCmd(),
+		ProposalMigrateVirtualStakingContractCmd(),
 	)
 	return cmd
 }
@@ -100,6 +112,413 @@ func parseSetVirtualStakingMaxCapArgs(args []string, authority string) (types.Ms
 	return msg, nil
 }
 
+func ProposalSetVirtualStakingMaxCapsCmd() *cobra.Command {
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	cmd := newSchedulerProposalCmd(
+		"set-virtual-staking-max-caps [contract_addr_bech32=max_cap]... --title [text] --summary [text] --authority [address]",
+		"Submit a set virtual staking max caps proposal",
+		fmt.Sprintf(`Submit a proposal to set the virtual staking maximum cap limits of multiple contracts at once.
+The limits are applied together so that the total of all contracts is checked only for the final state.
+
+Example:
+$ %s tx meshsecurity submit-proposal set-virtual-staking-max-caps %s1l94ptufswr6v7qntax4m7nvn3jgf6k4gn2rknq=100stake %s1hqrdl6wstt8qzshwc6mrumpjk9338k0lpsv9kg=0stake --title "a title" --summary "a summary" --authority %s
+`, version.AppName, bech32Prefix, bech32Prefix, DefaultGovAuthority.String()),
+		1,
+		func(cmd *cobra.Command, args []string, authority string) (sdk.Msg, error) {
+			maxCaps := make([]types.VirtualStakingMaxCap, len(args))
+			for i, arg := range args {
+				contract, amount, ok := strings.Cut(arg, "=")
+				if !ok {
+					return nil, fmt.Errorf("max cap %q: expected format contract=amount", arg)
+				}
+				maxCap, err := sdk.ParseCoinNormalized(amount)
+				if err != nil {
+					return nil, errorsmod.Wrapf(err, "max cap for %s", contract)
+				}
+				maxCaps[i] = types.VirtualStakingMaxCap{Contract: contract, MaxCap: maxCap}
+			}
+			return &types.MsgSetVirtualStakingMaxCaps{Authority: authority, MaxCaps: maxCaps}, nil
+		},
+	)
+	cmd.Args = cobra.MinimumNArgs(1)
+	return cmd
+}
+
+func ProposalSetContractConfigCmd() *cobra.Command {
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	cmd := newSchedulerProposalCmd(
+		"set-contract-config [contract_addr_bech32] [epoch_length] [max_gas_end_blocker] --title [text] --summary [text] --authority [address]",
+		"Submit a set contract config proposal",
+		fmt.Sprintf(`Submit a proposal to override the epoch length and the max sudo gas of the module params for the given virtual staking contract.
+A value of 0 falls back to the module params. The other settings of the current contract config are kept unless set by flag.
+
+Example:
+$ %s tx meshsecurity submit-proposal set-contract-config %s1l94ptufswr6v7qntax4m7nvn3jgf6k4gn2rknq 100 0 --title "a title" --summary "a summary" --authority %s
+`, version.AppName, bech32Prefix, DefaultGovAuthority.String()),
+		3,
+		func(cmd *cobra.Command, args []string, authority string) (sdk.Msg, error) {
+			epochLength, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "epoch length")
+			}
+			maxGas, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "max gas end blocker")
+			}
+			// the message replaces the whole config, so the current settings are the base for the flags
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return nil, err
+			}
+			res, err := types.NewQueryClient(clientCtx).VirtualStakingMaxCapLimit(cmd.Context(), &types.QueryVirtualStakingMaxCapLimitRequest{Address: args[0]})
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "query current contract config")
+			}
+			config := res.Config
+			config.EpochLength, config.MaxGasEndBlocker = uint32(epochLength), uint32(maxGas)
+			for flag, target := range map[string]*bool{
+				flagLegacySlashes:    &config.LegacySlashes,
+				flagLegacyTombstones: &config.LegacyTombstones,
+				flagLegacyValidators: &config.LegacyValidators,
+				flagDisableSnapshots: &config.DisableValsetSnapshots,
+			} {
+				if !cmd.Flags().Changed(flag) {
+					continue
+				}
+				if *target, err = cmd.Flags().GetBool(flag); err != nil {
+					return nil, err
+				}
+			}
+			return &types.MsgSetContractConfig{
+				Authority: authority,
+				Contract:  args[0],
+				Config:    config,
+			}, nil
+		},
+	)
+	cmd.Flags().Bool(flagLegacySlashes, false, "Send the slash amounts as bare integer strings in the valset update reports")
+	cmd.Flags().Bool(flagLegacyTombstones, false, "Send the tombstoned validators as bare addresses in the valset update reports")
+	cmd.Flags().Bool(flagLegacyValidators, false, "Omit the validator power changes and the extended validator metadata in the valset update reports")
+	cmd.Flags().Bool(flagDisableSnapshots, false, "Do not send the full validator set snapshots on registration, chain upgrades and governance requests")
+	return cmd
+}
+
+func ProposalScheduleTaskCmd() *cobra.Command {
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	cmd := newSchedulerProposalCmd(
+		"schedule-task [contract_addr_bech32] [task_type] [height] --title [text] --summary [text] --authority [address]",
+		"Submit a schedule task proposal",
+		fmt.Sprintf(`Submit a proposal to schedule a task for the given virtual staking contract.
+The task type is one of %q, %q, %q or %q. With height 0 the task is scheduled for the next epoch.
+
+Example:
+$ %s tx meshsecurity submit-proposal schedule-task %s1l94ptufswr6v7qntax4m7nvn3jgf6k4gn2rknq %s 0 --repeat --title "a title" --summary "a summary" --authority %s
+`, taskTypeHandleEpoch, taskTypeValsetUpdate, taskTypeValsetSnapshot, taskTypeMaxCapEnforcement, version.AppName, bech32Prefix, taskTypeHandleEpoch, DefaultGovAuthority.String()),
+		3,
+		func(cmd *cobra.Command, args []string, authority string) (sdk.Msg, error) {
+			tp, err := parseTaskType(args[1])
+			if err != nil {
+				return nil, err
+			}
+			height, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "height")
+			}
+			repeat, err := cmd.Flags().GetBool(flagRepeat)
+			if err != nil {
+				return nil, err
+			}
+			return &types.MsgScheduleTask{
+				Authority: authority,
+				Contract:  args[0],
+				TaskType:  tp,
+				Height:    height,
+				Repeat:    repeat,
+			}, nil
+		},
+	)
+	cmd.Flags().Bool(flagRepeat, false, "Re-schedule the task after execution")
+	return cmd
+}
+
+func ProposalRescheduleTaskCmd() *cobra.Command {
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	return newSchedulerProposalCmd(
+		"reschedule-task [contract_addr_bech32] [task_type] [height] [new_height] --title [text] --summary [text] --authority [address]",
+		"Submit a reschedule task proposal",
+		fmt.Sprintf(`Submit a proposal to move a scheduled task of the given virtual staking contract to a new height.
+
+Example:
+$ %s tx meshsecurity submit-proposal reschedule-task %s1l94ptufswr6v7qntax4m7nvn3jgf6k4gn2rknq %s 100 200 --title "a title" --summary "a summary" --authority %s
+`, version.AppName, bech32Prefix, taskTypeHandleEpoch, DefaultGovAuthority.String()),
+		4,
+		func(_ *cobra.Command, args []string, authority string) (sdk.Msg, error) {
+			tp, err := parseTaskType(args[1])
+			if err != nil {
+				return nil, err
+			}
+			height, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "height")
+			}
+			newHeight, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "new height")
+			}
+			return &types.MsgRescheduleTask{
+				Authority: authority,
+				Contract:  args[0],
+				TaskType:  tp,
+				Height:    height,
+				NewHeight: newHeight,
+			}, nil
+		},
+	)
+}
+
+func ProposalPauseScheduledTasksCmd() *cobra.Command {
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	return newSchedulerProposalCmd(
+		"pause-scheduled-tasks [contract_addr_bech32] [task_type] --title [text] --summary [text] --authority [address]",
+		"Submit a pause scheduled tasks proposal",
+		fmt.Sprintf(`Submit a proposal to pause the execution of the scheduled tasks of the given virtual staking contract.
+
+Example:
+$ %s tx meshsecurity submit-proposal pause-scheduled-tasks %s1l94ptufswr6v7qntax4m7nvn3jgf6k4gn2rknq %s --title "a title" --summary "a summary" --authority %s
+`, version.AppName, bech32Prefix, taskTypeHandleEpoch, DefaultGovAuthority.String()),
+		2,
+		func(_ *cobra.Command, args []string, authority string) (sdk.Msg, error) {
+			tp, err := parseTaskType(args[1])
+			if err != nil {
+				return nil, err
+			}
+			return &types.MsgPauseScheduledTasks{Authority: authority, Contract: args[0], TaskType: tp}, nil
+		},
+	)
+}
+
+func ProposalResumeScheduledTasksCmd() *cobra.Command {
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	return newSchedulerProposalCmd(
+		"resume-scheduled-tasks [contract_addr_bech32] [task_type] --title [text] --summary [text] --authority [address]",
+		"Submit a resume scheduled tasks proposal",
+		fmt.Sprintf(`Submit a proposal to resume the execution of the paused tasks of the given virtual staking contract.
+
+Example:
+$ %s tx meshsecurity submit-proposal resume-scheduled-tasks %s1l94ptufswr6v7qntax4m7nvn3jgf6k4gn2rknq %s --title "a title" --summary "a summary" --authority %s
+`, version.AppName, bech32Prefix, taskTypeHandleEpoch, DefaultGovAuthority.String()),
+		2,
+		func(_ *cobra.Command, args []string, authority string) (sdk.Msg, error) {
+			tp, err := parseTaskType(args[1])
+			if err != nil {
+				return nil, err
+			}
+			return &types.MsgResumeScheduledTasks{Authority: authority, Contract: args[0], TaskType: tp}, nil
+		},
+	)
+}
+
+func ProposalDeleteScheduledTasksCmd() *cobra.Command {
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	cmd := newSchedulerProposalCmd(
+		"delete-scheduled-tasks [contract_addr_bech32] [task_type] --title [text] --summary [text] --authority [address]",
+		"Submit a delete scheduled tasks proposal",
+		fmt.Sprintf(`Submit a proposal to delete the scheduled tasks of the given virtual staking contract.
+All tasks of the type are deleted unless a height is set.
+
+Example:
+$ %s tx meshsecurity submit-proposal delete-scheduled-tasks %s1l94ptufswr6v7qntax4m7nvn3jgf6k4gn2rknq %s --height 100 --title "a title" --summary "a summary" --authority %s
+`, version.AppName, bech32Prefix, taskTypeHandleEpoch, DefaultGovAuthority.String()),
+		2,
+		func(cmd *cobra.Command, args []string, authority string) (sdk.Msg, error) {
+			tp, err := parseTaskType(args[1])
+			if err != nil {
+				return nil, err
+			}
+			height, err := cmd.Flags().GetUint64(flagHeight)
+			if err != nil {
+				return nil, err
+			}
+			return &types.MsgDeleteScheduledTasks{Authority: authority, Contract: args[0], TaskType: tp, Height: height}, nil
+		},
+	)
+	cmd.Flags().Uint64(flagHeight, 0, "Height of the task to delete. All tasks of the type are deleted when not set")
+	return cmd
+}
+
+func ProposalDeleteDeadLetterTasksCmd() *cobra.Command {
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	cmd := newSchedulerProposalCmd(
+		"delete-dead-letter-tasks [contract_addr_bech32] [task_type] --title [text] --summary [text] --authority [address]",
+		"Submit a delete dead letter tasks proposal",
+		fmt.Sprintf(`Submit a proposal to delete the dead letter tasks of the given virtual staking contract.
+With reschedule, a task of the type is scheduled for execution in the block of the proposal execution.
+
+Example:
+$ %s tx meshsecurity submit-proposal delete-dead-letter-tasks %s1l94ptufswr6v7qntax4m7nvn3jgf6k4gn2rknq %s --reschedule --title "a title" --summary "a summary" --authority %s
+`, version.AppName, bech32Prefix, taskTypeValsetUpdate, DefaultGovAuthority.String()),
+		2,
+		func(cmd *cobra.Command, args []string, authority string) (sdk.Msg, error) {
+			tp, err := parseTaskType(args[1])
+			if err != nil {
+				return nil, err
+			}
+			reschedule, err := cmd.Flags().GetBool(flagReschedule)
+			if err != nil {
+				return nil, err
+			}
+			return &types.MsgDeleteDeadLetterTasks{Authority: authority, Contract: args[0], TaskType: tp, Reschedule: reschedule}, nil
+		},
+	)
+	cmd.Flags().Bool(flagReschedule, false, "Schedule the task type again for the contract")
+	return cmd
+}
+
+func ProposalUpdateParamsCmd() *cobra.Command {
+	return newSchedulerProposalCmd(
+		"update-params [total_contracts_max_cap] [epoch_length] [max_gas_end_blocker] [max_gas_scheduler_per_block] [max_retry_attempts] [retry_backoff_blocks] [max_cap_grace_period] --title [text] --summary [text] --authority [address]",
+		"Submit an update params proposal",
+		fmt.Sprintf(`Submit a proposal to update the module params. All params must be set.
+A new epoch length applies to the scheduled rebalance tasks of the contracts without an epoch length override.
+A max cap grace period of 0 disables the enforcement of lowered max cap limits.
+
+Example:
+$ %s tx meshsecurity submit-proposal update-params 10000000000stake 1000 500000 10000000 5 10 100 --title "a title" --summary "a summary" --authority %s
+`, version.AppName, DefaultGovAuthority.String()),
+		7,
+		func(cmd *cobra.Command, args []string, authority string) (sdk.Msg, error) {
+			totalMaxCap, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "total contracts max cap")
+			}
+			names := []string{"epoch length", "max gas end blocker", "max gas scheduler per block", "max retry attempts", "retry backoff blocks", "max cap grace period"}
+			values := make([]uint32, len(names))
+			for i, name := range names {
+				v, err := strconv.ParseUint(args[i+1], 10, 32)
+				if err != nil {
+					return nil, errorsmod.Wrap(err, name)
+				}
+				values[i] = uint32(v)
+			}
+			return &types.MsgUpdateParams{
+				Authority: authority,
+				Params: types.Params{
+					TotalContractsMaxCap:    totalMaxCap,
+					EpochLength:             values[0],
+					MaxGasEndBlocker:        values[1],
+					MaxGasSchedulerPerBlock: values[2],
+					MaxRetryAttempts:        values[3],
+					RetryBackoffBlocks:      values[4],
+					MaxCapGracePeriod:       values[5],
+				},
+			}, nil
+		},
+	)
+}
+
+func ProposalRemoveVirtualStakingContractCmd() *cobra.Command {
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	return newSchedulerProposalCmd(
+		"remove-virtual-staking-contract [contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		"Submit a remove virtual staking contract proposal",
+		fmt.Sprintf(`Submit a proposal to retire the given virtual staking contract.
+All remaining virtual stake is unbonded and burned and all contract data is deleted from the module state.
+
+Example:
+$ %s tx meshsecurity submit-proposal remove-virtual-staking-contract %s1l94ptufswr6v7qntax4m7nvn3jgf6k4gn2rknq --title "a title" --summary "a summary" --authority %s
+`, version.AppName, bech32Prefix, DefaultGovAuthority.String()),
+		1,
+		func(cmd *cobra.Command, args []string, authority string) (sdk.Msg, error) {
+			return &types.MsgRemoveVirtualStakingContract{Authority: authority, Contract: args[0]}, nil
+		},
+	)
+}
+
+func ProposalMigrateVirtualStakingContractCmd() *cobra.Command {
+	bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	return newSchedulerProposalCmd(
+		"migrate-virtual-staking-contract [contract_addr_bech32] [new_contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		"Submit a migrate virtual staking contract proposal",
+		fmt.Sprintf(`Submit a proposal to move the virtual staking setup of a contract to a new contract address.
+The max cap limit, delegated total, delegations and scheduled tasks are moved without unbonding.
+
+Example:
+$ %s tx meshsecurity submit-proposal migrate-virtual-staking-contract %s1l94ptufswr6v7qntax4m7nvn3jgf6k4gn2rknq %s1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r --title "a title" --summary "a summary" --authority %s
+`, version.AppName, bech32Prefix, bech32Prefix, DefaultGovAuthority.String()),
+		2,
+		func(cmd *cobra.Command, args []string, authority string) (sdk.Msg, error) {
+			return &types.MsgMigrateVirtualStakingContract{Authority: authority, Contract: args[0], NewContract: args[1]}, nil
+		},
+	)
+}
+
+// newSchedulerProposalCmd builds a submit proposal command for a single message returned by the given builder
+func newSchedulerProposalCmd(use, short, long string, nArgs int, build func(cmd *cobra.Command, args []string, authority string) (sdk.Msg, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(nArgs),
+		Long:  strings.TrimSpace(long),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, metadata, deposit, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			src, err := build(cmd, args, authority)
+			if err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{src}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary)
+			if err != nil {
+				return err
+			}
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+const (
+	taskTypeHandleEpoch       = "handle-epoch"
+	taskTypeValsetUpdate      = "valset-update"
+	taskTypeValsetSnapshot    = "valset-snapshot"
+	taskTypeMaxCapEnforcement = "max-cap-enforcement"
+)
+
+// parseTaskType converts the task type name into the scheduler task type
+func parseTaskType(s string) (uint32, error) {
+	switch s {
+	case taskTypeHandleEpoch:
+		return types.SchedulerTaskHandleEpoch, nil
+	case taskTypeValsetUpdate:
+		return types.SchedulerTaskValsetUpdate, nil
+	case taskTypeValsetSnapshot:
+		return types.SchedulerTaskValsetSnapshot, nil
+	case taskTypeMaxCapEnforcement:
+		return types.SchedulerTaskMaxCapEnforcement, nil
+	default:
+		return 0, fmt.Errorf("unknown task type %q, expected %q, %q, %q or %q", s, taskTypeHandleEpoch, taskTypeValsetUpdate, taskTypeValsetSnapshot, taskTypeMaxCapEnforcement)
+	}
+}
+
 func addCommonProposalFlags(cmd *cobra.Command) {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
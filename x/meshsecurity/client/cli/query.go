@@ -14,6 +14,15 @@ import (
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
 )
 
+const (
+	flagOnlyNonZeroCap      = "only-non-zero-cap"
+	flagOnlyWithDelegations = "only-with-delegations"
+	flagTaskType            = "type"
+	flagContract            = "contract"
+	flagMinHeight           = "min-height"
+	flagMaxHeight           = "max-height"
+)
+
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -26,6 +35,10 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryMaxCapLimit(),
 		GetCmdQueryMaxCapLimits(),
+		GetCmdQueryDelegations(),
+		GetCmdQueryScheduledTasks(),
+		GetCmdQueryDeadLetterTasks(),
+		GetCmdQueryValsetOutbox(),
 		GetCmdQueryParams(),
 	)
 	return queryCmd
@@ -66,7 +79,7 @@ func GetCmdQueryMaxCapLimit() *cobra.Command {
 	return cmd
 }
 
-// GetCmdQueryMaxCapLimit implements a command to return the current
+// GetCmdQueryMaxCapLimits implements a command to return the current
 // max cap limit for each contract.
 func GetCmdQueryMaxCapLimits() *cobra.Command {
 	cmd := &cobra.Command{
@@ -78,8 +91,24 @@ func GetCmdQueryMaxCapLimits() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			onlyNonZeroCap, err := cmd.Flags().GetBool(flagOnlyNonZeroCap)
+			if err != nil {
+				return err
+			}
+			onlyWithDelegations, err := cmd.Flags().GetBool(flagOnlyWithDelegations)
+			if err != nil {
+				return err
+			}
 
-			req := &types.QueryVirtualStakingMaxCapLimitsRequest{}
+			req := &types.QueryVirtualStakingMaxCapLimitsRequest{
+				Pagination:          pageReq,
+				OnlyNonZeroCap:      onlyNonZeroCap,
+				OnlyWithDelegations: onlyWithDelegations,
+			}
 
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.VirtualStakingMaxCapLimits(cmd.Context(), req)
@@ -91,7 +120,208 @@ func GetCmdQueryMaxCapLimits() *cobra.Command {
 		},
 		SilenceUsage: true,
 	}
+	cmd.Flags().Bool(flagOnlyNonZeroCap, false, "Only return contracts with a non-zero max cap")
+	cmd.Flags().Bool(flagOnlyWithDelegations, false, "Only return contracts with delegated amount")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "max-cap-limits")
+	return cmd
+}
+
+// GetCmdQueryDelegations implements a command to return the
+// delegations per validator of the given contract.
+func GetCmdQueryDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations [address]",
+		Short: "Query the delegations per validator of the given contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryVirtualStakingDelegationsRequest{
+				Address: args[0],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VirtualStakingDelegations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryScheduledTasks implements a command to return the
+// scheduled tasks filtered by type, contract and height range.
+func GetCmdQueryScheduledTasks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-tasks",
+		Short: "Query the scheduled tasks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the scheduled tasks with their repeat flag and next run height.
+Tasks can be filtered by type (%d: handle epoch, %d: valset update, %d: valset snapshot, %d: max cap enforcement), contract and height range.
+
+Example:
+$ %s query meshsecurity scheduled-tasks --type=%d --contract=<address> --min-height=100
+`,
+				types.SchedulerTaskHandleEpoch, types.SchedulerTaskValsetUpdate, types.SchedulerTaskValsetSnapshot, types.SchedulerTaskMaxCapEnforcement, version.AppName, types.SchedulerTaskHandleEpoch,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			tp, err := cmd.Flags().GetUint32(flagTaskType)
+			if err != nil {
+				return err
+			}
+			contract, err := cmd.Flags().GetString(flagContract)
+			if err != nil {
+				return err
+			}
+			if contract != "" {
+				if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+					return err
+				}
+			}
+			minHeight, err := cmd.Flags().GetUint64(flagMinHeight)
+			if err != nil {
+				return err
+			}
+			maxHeight, err := cmd.Flags().GetUint64(flagMaxHeight)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryScheduledTasksRequest{
+				Type:       tp,
+				Contract:   contract,
+				MinHeight:  minHeight,
+				MaxHeight:  maxHeight,
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledTasks(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Uint32(flagTaskType, 0, "Only return tasks of the given type")
+	cmd.Flags().String(flagContract, "", "Only return tasks of the given contract")
+	cmd.Flags().Uint64(flagMinHeight, 0, "Only return tasks executed at or after the given height")
+	cmd.Flags().Uint64(flagMaxHeight, 0, "Only return tasks executed at or before the given height")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled-tasks")
+	return cmd
+}
+
+// GetCmdQueryDeadLetterTasks implements a command to return the
+// failed tasks that exceeded the max retry attempts.
+func GetCmdQueryDeadLetterTasks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dead-letter-tasks",
+		Short: "Query the failed tasks that exceeded the max retry attempts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			contract, err := cmd.Flags().GetString(flagContract)
+			if err != nil {
+				return err
+			}
+			if contract != "" {
+				if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+					return err
+				}
+			}
+
+			req := &types.QueryDeadLetterTasksRequest{
+				Contract:   contract,
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.DeadLetterTasks(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagContract, "", "Only return tasks of the given contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "dead-letter-tasks")
+	return cmd
+}
+
+// GetCmdQueryValsetOutbox implements a command to return the
+// validator set operations pending for delivery to the given contract.
+func GetCmdQueryValsetOutbox() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-outbox [address]",
+		Short: "Query the validator set operations pending for delivery to the given contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValsetOutboxRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ValsetOutbox(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "valset-outbox")
 	return cmd
 }
 
@@ -8,7 +8,16 @@ import (
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
 )
 
-const flagAuthority = "authority"
+const (
+	flagAuthority        = "authority"
+	flagRepeat           = "repeat"
+	flagLegacySlashes    = "legacy-slashes"
+	flagLegacyTombstones = "legacy-tombstones"
+	flagLegacyValidators = "legacy-validators"
+	flagDisableSnapshots = "disable-valset-snapshots"
+	flagHeight           = "height"
+	flagReschedule       = "reschedule"
+)
 
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
@@ -6,8 +6,11 @@ import (
 
 type (
 	SudoMsg struct {
-		HandleEpoch  *struct{}     `json:"handle_epoch,omitempty"`
-		ValsetUpdate *ValsetUpdate `json:"valset_update,omitempty"`
+		HandleEpoch     *struct{}        `json:"handle_epoch,omitempty"`
+		ValsetUpdate    *ValsetUpdate    `json:"valset_update,omitempty"`
+		ValsetSnapshot  *ValsetSnapshot  `json:"valset_snapshot,omitempty"`
+		MaxCapEnforced  *MaxCapEnforced  `json:"max_cap_enforced,omitempty"`
+		ContractRemoved *ContractRemoved `json:"contract_removed,omitempty"`
 	}
 
 	// Validator alias to wasmVM type
@@ -16,6 +19,24 @@ type (
 	ValidatorAddr = string
 
 	ValidatorSlash struct {
+		ValidatorAddr    string `json:"address"`
+		Height           int64  `json:"height"`
+		Time             int64  `json:"time"`
+		InfractionHeight int64  `json:"infraction_height"`
+		// InfractionTime is the block time of the infraction height. It is omitted when the block time is not
+		// available anymore, for example because it is older than the stored historical entries.
+		InfractionTime *int64 `json:"infraction_time,omitempty"`
+		Power          int64  `json:"power"`
+		// SlashAmount is the amount slashed from the delegation of the contract
+		SlashAmount wasmvmtypes.Coin `json:"slash_amount"`
+		// DelegatedAmount is the amount delegated by the contract before the slash
+		DelegatedAmount wasmvmtypes.Coin `json:"delegated_amount"`
+		SlashRatio      string           `json:"slash_ratio"`
+	}
+
+	// LegacyValidatorSlash slash format with the amount as bare integer string for contracts
+	// without denominated slashes support. The infraction time is required in this format and 0 when unknown.
+	LegacyValidatorSlash struct {
 		ValidatorAddr    string `json:"address"`
 		Height           int64  `json:"height"`
 		Time             int64  `json:"time"`
@@ -26,14 +47,173 @@ type (
 		SlashRatio       string `json:"slash_ratio"`
 	}
 
+	// ValidatorInfo validator with the extended metadata
+	ValidatorInfo struct {
+		Validator
+		Moniker string `json:"moniker"`
+		// ConsPubKey is the consensus public key of the validator
+		ConsPubKey []byte `json:"cons_pubkey"`
+	}
+
+	// ValidatorPowerChange voting power of an active validator that changed since the previous epoch
+	ValidatorPowerChange struct {
+		ValidatorAddr string           `json:"address"`
+		Power         int64            `json:"power"`
+		PreviousPower int64            `json:"previous_power"`
+		Tokens        wasmvmtypes.Coin `json:"tokens"`
+	}
+
+	// ValidatorTombstone tombstoned validator with the evidence details
+	ValidatorTombstone struct {
+		ValidatorAddr string `json:"address"`
+		ConsAddress   string `json:"cons_address"`
+		// EvidenceType is the infraction type of the evidence, e.g. double_sign
+		EvidenceType     string `json:"evidence_type"`
+		Height           int64  `json:"height"`
+		Time             int64  `json:"time"`
+		InfractionHeight int64  `json:"infraction_height"`
+		// InfractionTime is the block time of the infraction height. It is omitted when unknown.
+		InfractionTime *int64 `json:"infraction_time,omitempty"`
+	}
+
 	// ValsetUpdate updates to the active validator set
 	ValsetUpdate struct {
-		Additions  []Validator      `json:"additions"`
-		Removals   []ValidatorAddr  `json:"removals"`
-		Updated    []Validator      `json:"updated"`
-		Jailed     []ValidatorAddr  `json:"jailed"`
-		Unjailed   []ValidatorAddr  `json:"unjailed"`
-		Tombstoned []ValidatorAddr  `json:"tombstoned"`
-		Slashed    []ValidatorSlash `json:"slashed"`
+		Additions    []ValidatorInfo        `json:"additions"`
+		Removals     []ValidatorAddr        `json:"removals"`
+		Updated      []ValidatorInfo        `json:"updated"`
+		Jailed       []ValidatorAddr        `json:"jailed"`
+		Unjailed     []ValidatorAddr        `json:"unjailed"`
+		Tombstoned   []ValidatorTombstone   `json:"tombstoned"`
+		Slashed      []ValidatorSlash       `json:"slashed"`
+		PowerChanges []ValidatorPowerChange `json:"power_changes"`
+	}
+
+	// ValidatorState validator with the current status in the valset snapshot
+	ValidatorState struct {
+		Validator
+		// Status is the lower case bond status, e.g. bonded
+		Status     string `json:"status"`
+		Jailed     bool   `json:"jailed"`
+		Tombstoned bool   `json:"tombstoned"`
+	}
+
+	// ValsetSnapshot the full active validator set including jailed and tombstoned validators, to resync the
+	// contract view from scratch
+	ValsetSnapshot struct {
+		Height     int64            `json:"height"`
+		Time       int64            `json:"time"`
+		Validators []ValidatorState `json:"validators"`
+	}
+
+	// Undelegation amount undelegated from a validator
+	Undelegation struct {
+		ValidatorAddr string           `json:"validator"`
+		Amount        wasmvmtypes.Coin `json:"amount"`
+	}
+
+	// MaxCapEnforced the undelegations executed by the module because the contract exceeded a lowered max cap
+	// limit after the grace period
+	MaxCapEnforced struct {
+		Height        int64            `json:"height"`
+		Time          int64            `json:"time"`
+		MaxCap        wasmvmtypes.Coin `json:"max_cap"`
+		Undelegations []Undelegation   `json:"undelegations"`
+	}
+
+	// ContractRemoved the final notification to a removed virtual staking contract with the undelegations of the
+	// remaining virtual stake
+	ContractRemoved struct {
+		Height        int64          `json:"height"`
+		Time          int64          `json:"time"`
+		Undelegations []Undelegation `json:"undelegations"`
+	}
+
+	// ValsetUpdateFormat the optional valset update features supported by the contract
+	ValsetUpdateFormat struct {
+		DenominatedSlashes   bool
+		StructuredTombstones bool
+		ExtendedValidators   bool
+	}
+
+	// LegacyValsetUpdate valset update format for contracts without support for all features. The fields overwrite
+	// the ones of the embedded type with either the legacy or the current format.
+	LegacyValsetUpdate struct {
+		ValsetUpdate
+		// Additions is a list of ValidatorInfo or Validator
+		Additions any `json:"additions"`
+		// Updated is a list of ValidatorInfo or Validator
+		Updated any `json:"updated"`
+		// Slashed is a list of ValidatorSlash or LegacyValidatorSlash
+		Slashed any `json:"slashed"`
+		// Tombstoned is a list of ValidatorTombstone or ValidatorAddr
+		Tombstoned any `json:"tombstoned"`
+		// PowerChanges is omitted without extended validators support
+		PowerChanges any `json:"power_changes,omitempty"`
+	}
+
+	// LegacySudoMsg sudo message with the legacy valset update format
+	LegacySudoMsg struct {
+		ValsetUpdate *LegacyValsetUpdate `json:"valset_update,omitempty"`
 	}
 )
+
+// NewLegacyValsetUpdate converts the valset update to the legacy format. The optional features are kept when
+// supported by the contract.
+func NewLegacyValsetUpdate(v ValsetUpdate, f ValsetUpdateFormat) LegacyValsetUpdate {
+	r := LegacyValsetUpdate{
+		ValsetUpdate: v,
+		Additions:    v.Additions,
+		Updated:      v.Updated,
+		Slashed:      v.Slashed,
+		Tombstoned:   v.Tombstoned,
+		PowerChanges: v.PowerChanges,
+	}
+	if !f.DenominatedSlashes {
+		slashed := make([]LegacyValidatorSlash, len(v.Slashed))
+		for i, s := range v.Slashed {
+			slashed[i] = LegacyValidatorSlash{
+				ValidatorAddr:    s.ValidatorAddr,
+				Height:           s.Height,
+				Time:             s.Time,
+				InfractionHeight: s.InfractionHeight,
+				InfractionTime:   legacyInfractionTime(s.InfractionTime),
+				Power:            s.Power,
+				SlashAmount:      s.SlashAmount.Amount,
+				SlashRatio:       s.SlashRatio,
+			}
+		}
+		r.Slashed = slashed
+	}
+	if !f.StructuredTombstones && v.Tombstoned != nil {
+		tombstoned := make([]ValidatorAddr, len(v.Tombstoned))
+		for i, t := range v.Tombstoned {
+			tombstoned[i] = t.ValidatorAddr
+		}
+		r.Tombstoned = tombstoned
+	}
+	if !f.ExtendedValidators {
+		r.Additions, r.Updated = toValidators(v.Additions), toValidators(v.Updated)
+		r.PowerChanges = nil
+	}
+	return r
+}
+
+// toValidators drops the extended metadata
+func toValidators(src []ValidatorInfo) []Validator {
+	if src == nil {
+		return nil
+	}
+	r := make([]Validator, len(src))
+	for i, v := range src {
+		r[i] = v.Validator
+	}
+	return r
+}
+
+// legacyInfractionTime returns the infraction time or 0 when unknown
+func legacyInfractionTime(t *int64) int64 {
+	if t == nil {
+		return 0
+	}
+	return *t
+}
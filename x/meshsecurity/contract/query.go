@@ -9,6 +9,12 @@ type (
 	VirtualStakeQuery struct {
 		BondStatus *BondStatusQuery `json:"bond_status,omitempty"`
 		SlashRatio *struct{}        `json:"slash_ratio,omitempty"`
+		Validator  *ValidatorQuery  `json:"validator,omitempty"`
+		Validators *ValidatorsQuery `json:"validators,omitempty"`
+		// Delegations lists the delegations of the calling contract
+		Delegations *struct{} `json:"delegations,omitempty"`
+		// Rewards lists the accumulated staking rewards of the calling contract
+		Rewards *struct{} `json:"rewards,omitempty"`
 	}
 	BondStatusQuery struct {
 		Contract string `json:"contract"`
@@ -19,6 +25,52 @@ type (
 		// Delegated is the used amount of the max cap
 		Delegated wasmvmtypes.Coin `json:"delegated"`
 	}
+	ValidatorQuery struct {
+		// Address is the Bech32 operator address of the validator
+		Address string `json:"address"`
+	}
+	// ValidatorsQuery lists the active validators ordered by operator address
+	ValidatorsQuery struct {
+		// StartAfter is the operator address of the last validator of the previous page
+		StartAfter string `json:"start_after,omitempty"`
+		// Limit is the max number of validators returned. Falls back to a default when 0
+		Limit uint32 `json:"limit,omitempty"`
+	}
+	// ValidatorStatus validator with the current bond status
+	ValidatorStatus struct {
+		Validator
+		// Status is the lower case bond status, e.g. bonded
+		Status     string           `json:"status"`
+		Jailed     bool             `json:"jailed"`
+		Tombstoned bool             `json:"tombstoned"`
+		Tokens     wasmvmtypes.Coin `json:"tokens"`
+	}
+	ValidatorResponse struct {
+		// Validator is null when the validator does not exist
+		Validator *ValidatorStatus `json:"validator"`
+	}
+	ValidatorsResponse struct {
+		Validators []ValidatorStatus `json:"validators"`
+	}
+	Delegation struct {
+		// Validator is the Bech32 operator address of the validator
+		Validator string           `json:"validator"`
+		Amount    wasmvmtypes.Coin `json:"amount"`
+		Shares    string           `json:"shares"`
+	}
+	DelegationsResponse struct {
+		Delegations []Delegation `json:"delegations"`
+	}
+	DelegationReward struct {
+		// Validator is the Bech32 operator address of the validator
+		Validator string            `json:"validator"`
+		Reward    wasmvmtypes.Coins `json:"reward"`
+	}
+	RewardsResponse struct {
+		Rewards []DelegationReward `json:"rewards"`
+		// Total is the sum of the rewards of all delegations
+		Total wasmvmtypes.Coins `json:"total"`
+	}
 	SlashRatioResponse struct {
 		SlashFractionDowntime   string `json:"slash_fraction_downtime"`
 		SlashFractionDoubleSign string `json:"slash_fraction_double_sign"`
@@ -87,13 +87,42 @@ func CaptureTombstoneDecorator(k *Keeper, slashingKeeper evidencetypes.SlashingK
 	return &SlashingKeeperDecorator{SlashingKeeper: slashingKeeper, stakingKeeper: stakingKeeper, k: k}
 }
 
-// Tombstone is executed in the end-blocker by the evidence module
+// SlashWithInfractionReason captures the evidence details for the tombstone and calls the decorated slashing keeper
+// slash method. The evidence module slashes a double-signing validator before it is tombstoned.
+func (e SlashingKeeperDecorator) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64, infraction stakingtypes.Infraction) {
+	if infraction == stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN {
+		infractionHeight := InfractionHeight(distributionHeight, infraction)
+		info := types.TombstoneInfo{
+			ConsAddress:      consAddr.String(),
+			EvidenceType:     InfractionType(infraction),
+			InfractionHeight: infractionHeight,
+		}
+		if t := e.k.infractionTime(ctx, infractionHeight); t != nil {
+			info.InfractionTime, info.InfractionTimeKnown = *t, true
+		}
+		e.k.CaptureTombstoneEvidence(ctx, consAddr, info)
+	}
+	e.SlashingKeeper.SlashWithInfractionReason(ctx, consAddr, fraction, power, distributionHeight, infraction)
+}
+
+// Tombstone is executed in the end-blocker by the evidence module. The evidence details captured in the
+// same block are forwarded. Without them, the current block is reported as infraction.
 func (e SlashingKeeperDecorator) Tombstone(ctx sdk.Context, address sdk.ConsAddress) {
+	info := e.k.takeTombstoneEvidence(ctx, address)
+	if info == nil {
+		info = &types.TombstoneInfo{
+			ConsAddress:         address.String(),
+			EvidenceType:        InfractionType(stakingtypes.Infraction_INFRACTION_UNSPECIFIED),
+			InfractionHeight:    ctx.BlockHeight(),
+			InfractionTime:      ctx.BlockTime().Unix(),
+			InfractionTimeKnown: true,
+		}
+	}
 	v, ok := e.stakingKeeper.GetValidatorByConsAddr(ctx, address)
 	if !ok {
 		ModuleLogger(ctx).
 			Error("can not propagate tompstone: validator not found", "validator", address.String())
-	} else if err := e.k.ScheduleTombstoned(ctx, v.GetOperator()); err != nil {
+	} else if err := e.k.ScheduleTombstoned(ctx, v.GetOperator(), info); err != nil {
 		ModuleLogger(ctx).
 			Error("can not propagate tompstone: scheduler",
 				"cause", err,
@@ -114,13 +143,28 @@ func NewStakingDecorator(stakingKeeper slashingtypes.StakingKeeper, k *Keeper) *
 }
 
 // Slash captures the slash event and calls the decorated staking keeper slash method
-func (s StakingDecorator) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, power int64, height int64, slashRatio sdk.Dec) math.Int {
+func (s StakingDecorator) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashRatio sdk.Dec) math.Int {
+	return s.SlashWithInfractionReason(ctx, consAddr, infractionHeight, power, slashRatio, stakingtypes.Infraction_INFRACTION_UNSPECIFIED)
+}
+
+// SlashWithInfractionReason captures the slash event and calls the decorated staking keeper slash method.
+// The infraction reason is used to resolve the infraction height from the distribution height, see InfractionHeight.
+// It is required by Interchain Security as well. The infraction time is omitted in the report
+// when no historical info is stored for the infraction height.
+func (s StakingDecorator) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddress, distributionHeight int64, power int64, slashFactor sdk.Dec, infraction stakingtypes.Infraction) math.Int {
 	val := s.StakingKeeper.ValidatorByConsAddr(ctx, consAddr)
-	totalSlashAmount := s.StakingKeeper.Slash(ctx, consAddr, power, height, slashRatio)
 	if val == nil {
 		ModuleLogger(ctx).
 			Error("can not propagate slash: validator not found", "validator", consAddr.String())
-	} else if err := s.k.ScheduleSlashed(ctx, val.GetOperator(), power, height, totalSlashAmount, slashRatio); err != nil {
+		return s.StakingKeeper.Slash(ctx, consAddr, distributionHeight, power, slashFactor)
+	}
+	// the contract exposure is captured before the slash so that later delegation changes do not affect the share
+	preSlashDelegations := s.k.SnapshotContractDelegations(ctx, val.GetOperator())
+	totalSlashAmount := s.StakingKeeper.Slash(ctx, consAddr, distributionHeight, power, slashFactor)
+	s.k.CaptureSlashedAmounts(ctx, val.GetOperator(), preSlashDelegations)
+	infractionHeight := InfractionHeight(distributionHeight, infraction)
+	infractionTime := s.k.infractionTime(ctx, infractionHeight)
+	if err := s.k.ScheduleSlashed(ctx, val.GetOperator(), power, infractionHeight, infractionTime, totalSlashAmount, slashFactor, preSlashDelegations); err != nil {
 		ModuleLogger(ctx).
 			Error("can not propagate slash: schedule event",
 				"cause", err,
@@ -129,11 +173,6 @@ func (s StakingDecorator) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, power
 	return totalSlashAmount
 }
 
-// SlashWithInfractionReason implementation doesn't require the infraction (types.Infraction) to work but is required by Interchain Security.
-func (s StakingDecorator) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec, _ stakingtypes.Infraction) math.Int {
-	return s.Slash(ctx, consAddr, infractionHeight, power, slashFactor)
-}
-
 // Jail captures the jail event and calls the decorated staking keeper jail method
 func (s StakingDecorator) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	val := s.StakingKeeper.ValidatorByConsAddr(ctx, consAddr)
@@ -2,20 +2,28 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/rand"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
 )
 
 func TestCaptureTombstone(t *testing.T) {
 	pCtx, keepers := CreateDefaultTestInput(t)
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	require.NoError(t, keepers.MeshKeeper.SetMaxCapLimit(pCtx, myContractAddr, sdk.NewInt64Coin("stake", 100_000_000)))
 
 	val := MinValidatorFixture(t)
 	myConsAddress, err := val.GetConsAddr()
@@ -25,15 +33,51 @@ func TestCaptureTombstone(t *testing.T) {
 	skMock, capturedTombstones := NewMockEvidenceSlashingKeeper()
 	decorator := CaptureTombstoneDecorator(keepers.MeshKeeper, skMock, keepers.StakingKeeper)
 	otherConsAddress := rand.Bytes(address.Len)
+	evidenceHeight := pCtx.BlockHeight() - 10
+	evidenceTime := pCtx.BlockTime().Add(-time.Minute)
+	keepers.StakingKeeper.SetHistoricalInfo(pCtx, evidenceHeight, &stakingtypes.HistoricalInfo{Header: tmproto.Header{Time: evidenceTime}})
 	specs := map[string]struct {
-		addr      sdk.ConsAddress
-		expPassed []sdk.ConsAddress
-		expStored []types.PipedValsetOperation
+		addr             sdk.ConsAddress
+		doubleSignHeight int64
+		expPassed        []sdk.ConsAddress
+		expStored        []types.PipedValsetOperation
+		expInfo          *types.TombstoneInfo
 	}{
 		"with existing validator": {
 			addr:      myConsAddress,
 			expPassed: []sdk.ConsAddress{myConsAddress},
 			expStored: []types.PipedValsetOperation{types.ValidatorTombstoned},
+			expInfo: &types.TombstoneInfo{
+				ConsAddress:         myConsAddress.String(),
+				EvidenceType:        "unspecified",
+				InfractionHeight:    pCtx.BlockHeight(),
+				InfractionTime:      pCtx.BlockTime().Unix(),
+				InfractionTimeKnown: true,
+			},
+		},
+		"with double sign evidence": {
+			addr:             myConsAddress,
+			doubleSignHeight: evidenceHeight,
+			expPassed:        []sdk.ConsAddress{myConsAddress},
+			expStored:        []types.PipedValsetOperation{types.ValidatorTombstoned},
+			expInfo: &types.TombstoneInfo{
+				ConsAddress:         myConsAddress.String(),
+				EvidenceType:        "double_sign",
+				InfractionHeight:    evidenceHeight,
+				InfractionTime:      evidenceTime.Unix(),
+				InfractionTimeKnown: true,
+			},
+		},
+		"with double sign evidence without historical info": {
+			addr:             myConsAddress,
+			doubleSignHeight: evidenceHeight - 1,
+			expPassed:        []sdk.ConsAddress{myConsAddress},
+			expStored:        []types.PipedValsetOperation{types.ValidatorTombstoned},
+			expInfo: &types.TombstoneInfo{
+				ConsAddress:      myConsAddress.String(),
+				EvidenceType:     "double_sign",
+				InfractionHeight: evidenceHeight - 1,
+			},
 		},
 		"unknown consensus address": {
 			addr:      otherConsAddress,
@@ -44,20 +88,38 @@ func TestCaptureTombstone(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctx, _ := pCtx.CacheContext()
 			*capturedTombstones = make([]sdk.ConsAddress, 0, 1)
+			if spec.doubleSignHeight != 0 { // the evidence module passes the distribution height
+				decorator.SlashWithInfractionReason(ctx, spec.addr, sdk.NewDecWithPrec(5, 2), 100, spec.doubleSignHeight-sdk.ValidatorUpdateDelay, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+			}
 			// when
 			decorator.Tombstone(ctx, spec.addr)
 
 			// then
 			assert.Equal(t, spec.expPassed, *capturedTombstones)
 			// and stored for async propagation
-			appStoredOps := FetchAllStoredOperations(t, ctx, keepers.MeshKeeper)
+			appStoredOps := FetchAllStoredOperations(t, ctx, keepers.MeshKeeper, myContractAddr)
 			assert.Equal(t, spec.expStored, appStoredOps[val.OperatorAddress])
+			var entries []types.ValsetOutboxEntry
+			keepers.MeshKeeper.IterateValsetOutbox(ctx, myContractAddr, func(entry types.ValsetOutboxEntry) bool {
+				entries = append(entries, entry)
+				return false
+			})
+			if spec.expInfo == nil {
+				assert.Empty(t, entries)
+				return
+			}
+			require.Len(t, entries, 1)
+			assert.Equal(t, spec.expInfo, entries[0].TombstoneInfo)
+			// and the captured evidence is consumed
+			assert.Nil(t, keepers.MeshKeeper.takeTombstoneEvidence(ctx, spec.addr))
 		})
 	}
 }
 
 func TestCaptureStakingEvents(t *testing.T) {
 	pCtx, keepers := CreateDefaultTestInput(t)
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	require.NoError(t, keepers.MeshKeeper.SetMaxCapLimit(pCtx, myContractAddr, sdk.NewInt64Coin("stake", 100_000_000)))
 
 	val := MinValidatorFixture(t)
 	myConsAddress, err := val.GetConsAddr()
@@ -102,12 +164,111 @@ func TestCaptureStakingEvents(t *testing.T) {
 			loadedVal := keepers.StakingKeeper.ValidatorByConsAddr(ctx, spec.consAddr)
 			assert.Equal(t, spec.expJailed, loadedVal.IsJailed())
 			// and stored for async propagation
-			allStoredOps := FetchAllStoredOperations(t, ctx, keepers.MeshKeeper)
+			allStoredOps := FetchAllStoredOperations(t, ctx, keepers.MeshKeeper, myContractAddr)
 			assert.Equal(t, spec.expStored, allStoredOps[loadedVal.GetOperator().String()])
 		})
 	}
 }
 
+func TestCaptureSlash(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	require.NoError(t, keepers.MeshKeeper.SetMaxCapLimit(pCtx, myContractAddr, sdk.NewInt64Coin("stake", 100_000_000)))
+
+	val := MinValidatorFixture(t)
+	myConsAddress, err := val.GetConsAddr()
+	require.NoError(t, err)
+	keepers.StakingKeeper.SetValidatorByConsAddr(pCtx, val)
+	keepers.StakingKeeper.SetValidator(pCtx, val)
+
+	historicalHeight := pCtx.BlockHeight() - 10
+	historicalTime := pCtx.BlockTime().Add(-time.Minute)
+	keepers.StakingKeeper.SetHistoricalInfo(pCtx, historicalHeight, &stakingtypes.HistoricalInfo{Header: tmproto.Header{Time: historicalTime}})
+	keepers.StakingKeeper.SetHistoricalInfo(pCtx, historicalHeight+sdk.ValidatorUpdateDelay, &stakingtypes.HistoricalInfo{Header: tmproto.Header{Time: historicalTime.Add(time.Second)}})
+	keepers.StakingKeeper.SetHistoricalInfo(pCtx, historicalHeight+sdk.ValidatorUpdateDelay+1, &stakingtypes.HistoricalInfo{Header: tmproto.Header{Time: historicalTime.Add(2 * time.Second)}})
+
+	decorator := NewStakingDecorator(MockSlashStakingKeeper{StakingKeeper: keepers.StakingKeeper}, keepers.MeshKeeper)
+	specs := map[string]struct {
+		infraction          stakingtypes.Infraction
+		distributionHeight  int64
+		expInfractionHeight int64
+		expInfractionTime   *int64
+	}{
+		"downtime at block height": {
+			infraction:          stakingtypes.Infraction_INFRACTION_DOWNTIME,
+			distributionHeight:  pCtx.BlockHeight() - sdk.ValidatorUpdateDelay - 1,
+			expInfractionHeight: pCtx.BlockHeight(),
+			expInfractionTime:   unixTime(pCtx.BlockTime()),
+		},
+		"downtime at infraction height": {
+			infraction:          stakingtypes.Infraction_INFRACTION_DOWNTIME,
+			distributionHeight:  historicalHeight,
+			expInfractionHeight: historicalHeight + sdk.ValidatorUpdateDelay + 1,
+			expInfractionTime:   unixTime(historicalTime.Add(2 * time.Second)),
+		},
+		"double sign at evidence height": {
+			infraction:          stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+			distributionHeight:  historicalHeight,
+			expInfractionHeight: historicalHeight + sdk.ValidatorUpdateDelay,
+			expInfractionTime:   unixTime(historicalTime.Add(time.Second)),
+		},
+		"unspecified": {
+			infraction:          stakingtypes.Infraction_INFRACTION_UNSPECIFIED,
+			distributionHeight:  historicalHeight,
+			expInfractionHeight: historicalHeight,
+			expInfractionTime:   unixTime(historicalTime),
+		},
+		"no historical info": {
+			infraction:          stakingtypes.Infraction_INFRACTION_UNSPECIFIED,
+			distributionHeight:  historicalHeight - 1,
+			expInfractionHeight: historicalHeight - 1,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			// when
+			gotAmount := decorator.SlashWithInfractionReason(ctx, myConsAddress, spec.distributionHeight, 100, sdk.NewDecWithPrec(1, 1), spec.infraction)
+			// then
+			assert.Equal(t, sdkmath.NewInt(1_000), gotAmount)
+			// and stored for async propagation
+			var entries []types.ValsetOutboxEntry
+			keepers.MeshKeeper.IterateValsetOutbox(ctx, myContractAddr, func(entry types.ValsetOutboxEntry) bool {
+				entries = append(entries, entry)
+				return false
+			})
+			require.Len(t, entries, 1)
+			exp := &types.SlashInfo{
+				InfractionHeight: spec.expInfractionHeight,
+				Power:            100,
+				TotalSlashAmount: "1000",
+				SlashFraction:    "0.100000000000000000",
+				DelegatedAmount:  "0",
+				SlashAmount:      "0",
+			}
+			if spec.expInfractionTime != nil {
+				exp.InfractionTime, exp.InfractionTimeKnown = *spec.expInfractionTime, true
+			}
+			assert.Equal(t, exp, entries[0].SlashInfo)
+		})
+	}
+}
+
+// unixTime returns a pointer to the unix timestamp
+func unixTime(t time.Time) *int64 {
+	r := t.Unix()
+	return &r
+}
+
+// MockSlashStakingKeeper mock that returns a fixed slash amount
+type MockSlashStakingKeeper struct {
+	slashingtypes.StakingKeeper
+}
+
+func (m MockSlashStakingKeeper) Slash(_ sdk.Context, _ sdk.ConsAddress, _ int64, _ int64, _ sdk.Dec) sdkmath.Int {
+	return sdkmath.NewInt(1_000)
+}
+
 type MockEvidenceSlashingKeeper struct {
 	evidencetypes.SlashingKeeper
 	tombstoned []sdk.ConsAddress
@@ -121,3 +282,6 @@ func NewMockEvidenceSlashingKeeper() (*MockEvidenceSlashingKeeper, *[]sdk.ConsAd
 func (e *MockEvidenceSlashingKeeper) Tombstone(ctx sdk.Context, address sdk.ConsAddress) {
 	e.tombstoned = append(e.tombstoned, address)
 }
+
+func (e *MockEvidenceSlashingKeeper) SlashWithInfractionReason(_ sdk.Context, _ sdk.ConsAddress, _ sdk.Dec, _, _ int64, _ stakingtypes.Infraction) {
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
+)
+
+// ContractDelegations returns the delegations of the contract with the token amounts truncated to integers
+func (k Keeper) ContractDelegations(ctx sdk.Context, contractAddr sdk.AccAddress) []contract.Delegation {
+	bondDenom := k.Staking.BondDenom(ctx)
+	r := make([]contract.Delegation, 0)
+	k.Staking.IterateDelegations(ctx, contractAddr, func(_ int64, del stakingtypes.DelegationI) bool {
+		val, found := k.Staking.GetValidator(ctx, del.GetValidatorAddr())
+		if !found {
+			return false
+		}
+		r = append(r, contract.Delegation{
+			Validator: del.GetValidatorAddr().String(),
+			Amount:    wasmkeeper.ConvertSdkCoinToWasmCoin(sdk.NewCoin(bondDenom, val.TokensFromShares(del.GetShares()).TruncateInt())),
+			Shares:    del.GetShares().String(),
+		})
+		return false
+	})
+	return r
+}
+
+// ContractRewards returns the accumulated staking rewards of the delegations of the contract, truncated to integers.
+// The rewards are calculated like in the distribution module queries, the state changes are discarded.
+// Requires a distribution keeper, see WithDistributionKeeper.
+func (k Keeper) ContractRewards(pCtx sdk.Context, contractAddr sdk.AccAddress) (contract.RewardsResponse, error) {
+	if k.distribution == nil {
+		return contract.RewardsResponse{}, wasmvmtypes.UnsupportedRequest{Kind: "virtual_stake rewards query without distribution keeper"}
+	}
+	// the validator period is incremented to calculate the rewards
+	ctx, _ := pCtx.CacheContext()
+	r := contract.RewardsResponse{Rewards: make([]contract.DelegationReward, 0)}
+	total := sdk.NewDecCoins()
+	k.Staking.IterateDelegations(ctx, contractAddr, func(_ int64, del stakingtypes.DelegationI) bool {
+		val, found := k.Staking.GetValidator(ctx, del.GetValidatorAddr())
+		if !found {
+			return false
+		}
+		endingPeriod := k.distribution.IncrementValidatorPeriod(ctx, val)
+		rewards := k.distribution.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+		total = total.Add(rewards...)
+		coins, _ := rewards.TruncateDecimal()
+		r.Rewards = append(r.Rewards, contract.DelegationReward{
+			Validator: del.GetValidatorAddr().String(),
+			Reward:    wasmkeeper.ConvertSdkCoinsToWasmCoins(coins),
+		})
+		return false
+	})
+	totalCoins, _ := total.TruncateDecimal()
+	r.Total = wasmkeeper.ConvertSdkCoinsToWasmCoins(totalCoins)
+	return r, nil
+}
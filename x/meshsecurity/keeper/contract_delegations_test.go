@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
+)
+
+func TestContractDelegationsAndRewards(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin("stake", 100_000_000)))
+
+	keepers.Faucet.Fund(ctx, keepers.AccountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName), sdk.NewInt64Coin("stake", 17_000_000))
+	vals := addBondedValidators(t, ctx, keepers.StakingKeeper, math.NewInt(9_000_000), math.NewInt(8_000_000))
+	distrHooks := keepers.DistKeeper.Hooks()
+	for i, amount := range []int64{1_000_000, 2_000_000} {
+		valAddr := vals[i].GetOperator()
+		require.NoError(t, distrHooks.AfterValidatorCreated(ctx, valAddr))
+		require.NoError(t, distrHooks.BeforeDelegationCreated(ctx, myContractAddr, valAddr))
+		_, err := k.Delegate(ctx, myContractAddr, valAddr, sdk.NewInt64Coin("stake", amount))
+		require.NoError(t, err)
+		require.NoError(t, distrHooks.AfterDelegationModified(ctx, myContractAddr, valAddr))
+	}
+
+	// when
+	gotDelegations := k.ContractDelegations(ctx, myContractAddr)
+	// then
+	expDelegations := []contract.Delegation{
+		{Validator: vals[0].GetOperator().String(), Amount: wasmvmtypes.NewCoin(1_000_000, "stake"), Shares: "1000000.000000000000000000"},
+		{Validator: vals[1].GetOperator().String(), Amount: wasmvmtypes.NewCoin(2_000_000, "stake"), Shares: "2000000.000000000000000000"},
+	}
+	assert.ElementsMatch(t, expDelegations, gotDelegations)
+
+	// and no delegations for other contracts
+	assert.Empty(t, k.ContractDelegations(ctx, sdk.AccAddress(rand.Bytes(address.Len))))
+
+	// when rewards are allocated to the validators in the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	for _, valAddr := range []sdk.ValAddress{vals[0].GetOperator(), vals[1].GetOperator()} {
+		val, found := keepers.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		keepers.DistKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1_000)))
+	}
+	gotRewards, err := k.ContractRewards(ctx, myContractAddr)
+	// then the contract share is reported
+	require.NoError(t, err)
+	expRewards := contract.RewardsResponse{
+		Rewards: []contract.DelegationReward{
+			{Validator: vals[0].GetOperator().String(), Reward: wasmvmtypes.Coins{wasmvmtypes.NewCoin(100, "stake")}},
+			{Validator: vals[1].GetOperator().String(), Reward: wasmvmtypes.Coins{wasmvmtypes.NewCoin(200, "stake")}},
+		},
+		Total: wasmvmtypes.Coins{wasmvmtypes.NewCoin(300, "stake")},
+	}
+	assert.ElementsMatch(t, expRewards.Rewards, gotRewards.Rewards)
+	assert.Equal(t, expRewards.Total, gotRewards.Total)
+
+	// and without a distribution keeper
+	noDistrKeeper := *k
+	noDistrKeeper.distribution = nil
+	_, err = noDistrKeeper.ContractRewards(ctx, myContractAddr)
+	assert.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+}
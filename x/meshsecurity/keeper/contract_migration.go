@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+// MigrateVirtualStakingContract moves the virtual staking setup from the old contract address to the new one: max cap
+// limit, delegated total, slashed amount, config, scheduled, paused and failed tasks, valset outbox, validator powers and all
+// delegations. The delegation shares are transferred without unbonding so that the validator tokens and power are not
+// modified. The new contract must exist and must not be set up for virtual staking already.
+func (k Keeper) MigrateVirtualStakingContract(ctx sdk.Context, from, to sdk.AccAddress) error {
+	if !k.HasMaxCapLimit(ctx, from) {
+		return types.ErrUnknown.Wrapf("max cap limit for contract: %s", from)
+	}
+	if !k.wasm.HasContractInfo(ctx, to) {
+		return types.ErrUnknown.Wrapf("contract: %s", to)
+	}
+	if k.HasMaxCapLimit(ctx, to) {
+		return types.ErrInvalid.Wrapf("max cap limit exists for new contract: %s", to)
+	}
+	var hasDelegations bool
+	k.Staking.IterateDelegations(ctx, to, func(_ int64, _ stakingtypes.DelegationI) bool {
+		hasDelegations = true
+		return true
+	})
+	if hasDelegations {
+		return types.ErrInvalid.Wrapf("delegations exist for new contract: %s", to)
+	}
+
+	if err := k.transferDelegations(ctx, from, to); err != nil {
+		return err
+	}
+	if err := k.copyContractState(ctx, from, to); err != nil {
+		return err
+	}
+	if err := k.deleteContractState(ctx, from); err != nil {
+		return err
+	}
+	types.EmitContractMigratedEvent(ctx, from, to)
+	return nil
+}
+
+// transferDelegations moves all delegation shares from the old to the new contract address. The staking hooks are
+// called as for an unbond of the old and a delegation of the new delegator so that the distribution module settles
+// the pending rewards and tracks the new delegation.
+func (k Keeper) transferDelegations(ctx sdk.Context, from, to sdk.AccAddress) error {
+	var delegations []stakingtypes.Delegation
+	k.Staking.IterateDelegations(ctx, from, func(_ int64, del stakingtypes.DelegationI) bool {
+		delegations = append(delegations, stakingtypes.NewDelegation(from, del.GetValidatorAddr(), del.GetShares()))
+		return false
+	})
+	hooks := k.Staking.Hooks()
+	for _, del := range delegations {
+		valAddr := del.GetValidatorAddr()
+		if err := hooks.BeforeDelegationSharesModified(ctx, from, valAddr); err != nil {
+			return errorsmod.Wrapf(err, "withdraw rewards from %s", valAddr)
+		}
+		if err := k.Staking.RemoveDelegation(ctx, del); err != nil {
+			return errorsmod.Wrapf(err, "remove delegation to %s", valAddr)
+		}
+		if err := hooks.BeforeDelegationCreated(ctx, to, valAddr); err != nil {
+			return errorsmod.Wrapf(err, "create delegation to %s", valAddr)
+		}
+		k.Staking.SetDelegation(ctx, stakingtypes.NewDelegation(to, valAddr, del.Shares))
+		if err := hooks.AfterDelegationModified(ctx, to, valAddr); err != nil {
+			return errorsmod.Wrapf(err, "create delegation to %s", valAddr)
+		}
+	}
+	return nil
+}
+
+// copyContractState copies all data stored for the old contract to the new contract address. Scheduled tasks are
+// copied with their heights and repeat settings.
+func (k Keeper) copyContractState(ctx sdk.Context, from, to sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	k.setMaxCapLimit(ctx, to, k.GetMaxCapLimit(ctx, from))
+	k.setTotalDelegated(ctx, to, k.GetTotalDelegated(ctx, from))
+	if slashed := k.GetSlashedAmount(ctx, from); slashed.IsPositive() {
+		k.setSlashedAmount(ctx, to, slashed)
+	}
+	if err := k.SetContractConfig(ctx, to, k.GetContractConfig(ctx, from)); err != nil {
+		return err
+	}
+
+	for _, tp := range types.SchedulerTaskTypes {
+		var heights []uint64
+		var repeats []bool
+		err := k.iterateScheduledContractTasks(ctx, tp, from, math.MaxUint, func(height uint64, repeat bool) bool {
+			heights = append(heights, height)
+			repeats = append(repeats, repeat)
+			return false
+		})
+		if err != nil {
+			return err
+		}
+		for i, height := range heights {
+			storeKey, err := types.BuildSchedulerContractKey(tp, height, to)
+			if err != nil {
+				return err
+			}
+			store.Set(storeKey, []byte{toByte(repeats[i])})
+		}
+		fromPausedKey, err := types.BuildPausedTasksKey(tp, from)
+		if err != nil {
+			return err
+		}
+		if !store.Has(fromPausedKey) {
+			continue
+		}
+		toPausedKey, err := types.BuildPausedTasksKey(tp, to)
+		if err != nil {
+			return err
+		}
+		store.Set(toPausedKey, store.Get(fromPausedKey))
+	}
+
+	type failedTask struct {
+		key  []byte
+		task types.FailedTask
+	}
+	var failedTasks []failedTask
+	k.IterateRetryTasks(ctx, math.MaxUint64, func(task types.FailedTask) bool {
+		if task.Contract == from.String() {
+			failedTasks = append(failedTasks, failedTask{key: types.BuildRetryTaskKey(task.Height, task.ID), task: task})
+		}
+		return false
+	})
+	k.IterateDeadLetterTasks(ctx, func(task types.FailedTask) bool {
+		if task.Contract == from.String() {
+			failedTasks = append(failedTasks, failedTask{key: types.BuildDeadLetterTaskKey(task.ID), task: task})
+		}
+		return false
+	})
+	for _, t := range failedTasks {
+		t.task.Contract = to.String()
+		if err := k.setFailedTask(ctx, t.key, t.task); err != nil {
+			return err
+		}
+	}
+
+	var outbox []types.ValsetOutboxEntry
+	k.IterateValsetOutbox(ctx, from, func(entry types.ValsetOutboxEntry) bool {
+		outbox = append(outbox, entry)
+		return false
+	})
+	for _, entry := range outbox {
+		entry.Contract = to.String()
+		if err := k.setValsetOutboxEntry(ctx, entry); err != nil {
+			return err
+		}
+	}
+	if seq := k.getLastValsetOutboxSeq(ctx, from); seq != 0 {
+		store.Set(types.BuildValsetOutboxSeqKey(to), sdk.Uint64ToBigEndian(seq))
+	}
+
+	for valAddr, power := range k.getValidatorPowers(ctx, from) {
+		k.setValidatorPower(ctx, to, sdk.ValAddress(valAddr), power)
+	}
+	return nil
+}
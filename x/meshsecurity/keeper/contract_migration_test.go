@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateVirtualStakingContractState(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	var (
+		myContractAddr    = sdk.AccAddress(rand.Bytes(address.Len))
+		newContractAddr   = sdk.AccAddress(rand.Bytes(address.Len))
+		otherContractAddr = sdk.AccAddress(rand.Bytes(address.Len))
+	)
+	k.wasm = MockWasmKeeper{HasContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) bool { return true }}
+	vals := setupVirtualStakingContracts(t, pCtx, keepers, myContractAddr, otherContractAddr)
+	myContractState := moduleStateOf(t, pCtx, keepers, myContractAddr)
+	require.NotEmpty(t, myContractState)
+	otherContractState := moduleStateOf(t, pCtx, keepers, otherContractAddr)
+	myDelegations := k.ContractDelegations(pCtx, myContractAddr)
+	require.Len(t, myDelegations, 2)
+	valsBefore := make([]stakingtypes.Validator, len(vals))
+	for i, v := range vals {
+		valsBefore[i], _ = keepers.StakingKeeper.GetValidator(pCtx, v.GetOperator())
+	}
+
+	specs := map[string]struct {
+		from, to      sdk.AccAddress
+		setup         func(ctx sdk.Context)
+		hasContractFn func(ctx sdk.Context, contractAddress sdk.AccAddress) bool
+		expErr        bool
+	}{
+		"contract migrated": {
+			from: myContractAddr,
+			to:   newContractAddr,
+		},
+		"unknown contract": {
+			from:   sdk.AccAddress(rand.Bytes(address.Len)),
+			to:     newContractAddr,
+			expErr: true,
+		},
+		"new contract not instantiated": {
+			from: myContractAddr,
+			to:   newContractAddr,
+			hasContractFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+				return !contractAddress.Equals(newContractAddr)
+			},
+			expErr: true,
+		},
+		"new contract with max cap": {
+			from:   myContractAddr,
+			to:     otherContractAddr,
+			expErr: true,
+		},
+		"new contract with delegations": {
+			from: myContractAddr,
+			to:   newContractAddr,
+			setup: func(ctx sdk.Context) {
+				keepers.StakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(newContractAddr, vals[0].GetOperator(), sdk.OneDec()))
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			k.wasm = MockWasmKeeper{HasContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) bool { return true }}
+			if spec.hasContractFn != nil {
+				k.wasm = MockWasmKeeper{HasContractInfoFn: spec.hasContractFn}
+			}
+			if spec.setup != nil {
+				spec.setup(ctx)
+			}
+			totalSupply := keepers.BankKeeper.GetSupply(ctx, "stake")
+
+			// when
+			gotErr := k.MigrateVirtualStakingContract(ctx, spec.from, spec.to)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Equal(t, myContractState, moduleStateOf(t, ctx, keepers, myContractAddr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Empty(t, moduleStateOf(t, ctx, keepers, spec.from))
+			assert.Empty(t, k.ContractDelegations(ctx, spec.from))
+			// and all state moved to the new address
+			expState := make(map[string][]byte, len(myContractState))
+			for key, value := range myContractState {
+				expKey := bytes.ReplaceAll([]byte(key), spec.from, spec.to)
+				expValue := bytes.ReplaceAll(value, []byte(spec.from.String()), []byte(spec.to.String()))
+				expState[string(expKey)] = append([]byte{}, expValue...) // normalize empty values
+			}
+			assert.Equal(t, expState, moduleStateOf(t, ctx, keepers, spec.to))
+			assert.Equal(t, myDelegations, k.ContractDelegations(ctx, spec.to))
+			// and validators not modified
+			for _, v := range valsBefore {
+				gotVal, found := keepers.StakingKeeper.GetValidator(ctx, v.GetOperator())
+				require.True(t, found)
+				assert.Equal(t, v.GetTokens().String(), gotVal.GetTokens().String())
+				assert.Equal(t, v.GetDelegatorShares().String(), gotVal.GetDelegatorShares().String())
+			}
+			// and no virtual stake burned
+			assert.Equal(t, totalSupply, keepers.BankKeeper.GetSupply(ctx, "stake"))
+			// and other contracts not affected
+			assert.Equal(t, otherContractState, moduleStateOf(t, ctx, keepers, otherContractAddr))
+		})
+	}
+}
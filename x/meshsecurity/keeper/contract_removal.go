@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+// RemoveVirtualStakingContract unbonds and burns all remaining virtual stake of the contract and deletes all contract
+// data from the module state: max cap limit, delegated total, slashed amount, config, scheduled, paused and failed tasks, valset outbox
+// and validator powers. The contract is notified with a final sudo message; a failure in the contract is logged only.
+func (k Keeper) RemoveVirtualStakingContract(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	if !k.HasMaxCapLimit(ctx, contractAddr) {
+		return types.ErrUnknown.Wrapf("max cap limit for contract: %s", contractAddr)
+	}
+	undelegations, unbonded, err := k.undelegateAll(ctx, contractAddr)
+	if err != nil {
+		return err
+	}
+	// the delegated total that remains after all shares were burned is the slashed amount. It was removed from the
+	// supply offset on delegation but is not burned anymore.
+	if remaining := k.GetTotalDelegated(ctx, contractAddr); remaining.IsPositive() {
+		k.bank.AddSupplyOffset(ctx, remaining.Denom, remaining.Amount)
+	}
+	if err := k.deleteContractState(ctx, contractAddr); err != nil {
+		return err
+	}
+	types.EmitContractRemovedEvent(ctx, contractAddr, unbonded)
+
+	msg := contract.SudoMsg{ContractRemoved: &contract.ContractRemoved{
+		Height:        ctx.BlockHeight(),
+		Time:          ctx.BlockTime().Unix(),
+		Undelegations: undelegations,
+	}}
+	if err := k.doIsolatedSudoCall(ctx, contractAddr, msg); err != nil {
+		ModuleLogger(ctx).Error("failed to notify removed contract", "contract", contractAddr.String(), "cause", err)
+	}
+	return nil
+}
+
+// undelegateAll unbonds and burns all shares delegated by the contract. Returns the undelegations and the total amount
+// unbonded.
+func (k Keeper) undelegateAll(ctx sdk.Context, contractAddr sdk.AccAddress) ([]contract.Undelegation, sdk.Coin, error) {
+	var delegations []stakingtypes.DelegationI
+	k.Staking.IterateDelegations(ctx, contractAddr, func(_ int64, del stakingtypes.DelegationI) bool {
+		delegations = append(delegations, del)
+		return false
+	})
+	total := sdk.NewCoin(k.Staking.BondDenom(ctx), sdkmath.ZeroInt())
+	r := make([]contract.Undelegation, 0, len(delegations))
+	for _, del := range delegations {
+		amount, err := k.undelegateShares(ctx, contractAddr, del.GetValidatorAddr(), del.GetShares())
+		if err != nil {
+			return nil, total, errorsmod.Wrapf(err, "undelegate from %s", del.GetValidatorAddr())
+		}
+		total = total.Add(amount)
+		r = append(r, contract.Undelegation{
+			ValidatorAddr: del.GetValidatorAddr().String(),
+			Amount:        wasmkeeper.ConvertSdkCoinToWasmCoin(amount),
+		})
+	}
+	return r, total, nil
+}
+
+// deleteContractState deletes all data stored for the contract
+func (k Keeper) deleteContractState(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BuildMaxCapLimitKey(contractAddr))
+	store.Delete(types.BuildTotalDelegatedAmountKey(contractAddr))
+	store.Delete(types.BuildSlashedAmountKey(contractAddr))
+	store.Delete(types.BuildContractConfigKey(contractAddr))
+
+	for _, tp := range types.SchedulerTaskTypes {
+		if err := k.DeleteAllScheduledTasks(ctx, tp, contractAddr); err != nil {
+			return err
+		}
+		pausedKey, err := types.BuildPausedTasksKey(tp, contractAddr)
+		if err != nil {
+			return err
+		}
+		store.Delete(pausedKey)
+	}
+
+	var failedTaskKeys [][]byte
+	k.IterateRetryTasks(ctx, math.MaxUint64, func(task types.FailedTask) bool {
+		if task.Contract == contractAddr.String() {
+			failedTaskKeys = append(failedTaskKeys, types.BuildRetryTaskKey(task.Height, task.ID))
+		}
+		return false
+	})
+	k.IterateDeadLetterTasks(ctx, func(task types.FailedTask) bool {
+		if task.Contract == contractAddr.String() {
+			failedTaskKeys = append(failedTaskKeys, types.BuildDeadLetterTaskKey(task.ID))
+		}
+		return false
+	})
+	for _, key := range failedTaskKeys {
+		store.Delete(key)
+	}
+
+	store.Delete(types.BuildValsetOutboxSeqKey(contractAddr))
+	for _, keyPrefix := range [][]byte{
+		types.BuildValsetOutboxContractPrefix(contractAddr),
+		types.BuildValidatorPowerContractPrefix(contractAddr),
+	} {
+		deletePrefix(prefix.NewStore(store, keyPrefix))
+	}
+	return nil
+}
+
+// deletePrefix deletes all entries of the prefix store
+func deletePrefix(pStore prefix.Store) {
+	var keys [][]byte
+	iter := pStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	_ = iter.Close()
+	for _, key := range keys {
+		pStore.Delete(key)
+	}
+}
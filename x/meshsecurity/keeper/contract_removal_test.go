@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+func TestRemoveVirtualStakingContractCleanup(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	var (
+		myContractAddr    = sdk.AccAddress(rand.Bytes(address.Len))
+		otherContractAddr = sdk.AccAddress(rand.Bytes(address.Len))
+	)
+	vals := setupVirtualStakingContracts(t, pCtx, keepers, myContractAddr, otherContractAddr)
+	otherContractState := moduleStateOf(t, pCtx, keepers, otherContractAddr)
+	require.NotEmpty(t, otherContractState)
+
+	specs := map[string]struct {
+		contract  sdk.AccAddress
+		sudoErr   error
+		expErr    bool
+		expNotify bool
+	}{
+		"contract removed": {
+			contract:  myContractAddr,
+			expNotify: true,
+		},
+		"contract removed when notification fails": {
+			contract:  myContractAddr,
+			sudoErr:   errors.New("testing"),
+			expNotify: true,
+		},
+		"unknown contract": {
+			contract: sdk.AccAddress(rand.Bytes(address.Len)),
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			var gotNotification *contract.ContractRemoved
+			k.wasm = MockWasmKeeper{SudoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+				require.Equal(t, spec.contract, contractAddress)
+				var got contract.SudoMsg
+				require.NoError(t, json.Unmarshal(msg, &got))
+				gotNotification = got.ContractRemoved
+				return nil, spec.sudoErr
+			}}
+			totalSupply := keepers.BankKeeper.GetSupply(ctx, "stake")
+
+			// when
+			gotErr := k.RemoveVirtualStakingContract(ctx, spec.contract)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Empty(t, moduleStateOf(t, ctx, keepers, spec.contract))
+			assert.False(t, k.HasMaxCapLimit(ctx, spec.contract))
+			assert.Empty(t, k.ContractDelegations(ctx, spec.contract))
+			// and virtual stake burned
+			assert.Equal(t, totalSupply.SubAmount(math.NewInt(3_000_000)), keepers.BankKeeper.GetSupply(ctx, "stake"))
+			// and other contracts not affected
+			assert.Equal(t, otherContractState, moduleStateOf(t, ctx, keepers, otherContractAddr))
+			// and notified
+			require.Equal(t, spec.expNotify, gotNotification != nil)
+			exp := []contract.Undelegation{
+				{ValidatorAddr: vals[0].GetOperator().String(), Amount: wasmvmtypes.NewCoin(1_000_000, "stake")},
+				{ValidatorAddr: vals[1].GetOperator().String(), Amount: wasmvmtypes.NewCoin(2_000_000, "stake")},
+			}
+			assert.ElementsMatch(t, exp, gotNotification.Undelegations)
+		})
+	}
+}
+
+func TestRemoveSlashedVirtualStakingContract(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	captBankKeeper := NewCaptureOffsetBankKeeper(keepers.BankKeeper)
+	k.bank = captBankKeeper
+	k.wasm = MockWasmKeeper{SudoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+		return nil, nil
+	}}
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	vals := setupVirtualStakingContracts(t, ctx, keepers, myContractAddr)
+	// slash 10% of the first validator
+	preSlashDelegations := k.SnapshotContractDelegations(ctx, vals[0].GetOperator())
+	val, found := keepers.StakingKeeper.GetValidator(ctx, vals[0].GetOperator())
+	require.True(t, found)
+	keepers.StakingKeeper.RemoveValidatorTokens(ctx, val, val.GetTokens().QuoRaw(10))
+	k.CaptureSlashedAmounts(ctx, vals[0].GetOperator(), preSlashDelegations)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100_000), k.GetSlashedAmount(ctx, myContractAddr))
+	_, broken := SupplyOffsetInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// when
+	require.NoError(t, k.RemoveVirtualStakingContract(ctx, myContractAddr))
+
+	// then the slashed amount is not kept in the supply offset
+	msg, broken := SupplyOffsetInvariant(k)(ctx)
+	assert.False(t, broken, msg)
+	assert.Equal(t, math.ZeroInt().String(), captBankKeeper.Offset["stake"].String())
+}
+
+// setupVirtualStakingContracts bonds two validators and sets up each contract with delegations to them and with
+// entries in all contract stores: config, scheduled, paused and failed tasks, valset outbox and validator powers
+func setupVirtualStakingContracts(t *testing.T, ctx sdk.Context, keepers TestKeepers, contracts ...sdk.AccAddress) []stakingtypes.Validator {
+	t.Helper()
+	k := keepers.MeshKeeper
+	currentHeight := uint64(ctx.BlockHeight())
+	keepers.Faucet.Fund(ctx, keepers.AccountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName), sdk.NewInt64Coin("stake", 17_000_000))
+	vals := addBondedValidators(t, ctx, keepers.StakingKeeper, math.NewInt(9_000_000), math.NewInt(8_000_000))
+	for _, c := range contracts {
+		require.NoError(t, k.SetMaxCapLimit(ctx, c, sdk.NewInt64Coin("stake", 10_000_000)))
+		require.NoError(t, k.SetContractConfig(ctx, c, types.ContractConfig{EpochLength: 10}))
+		for i, amount := range []int64{1_000_000, 2_000_000} {
+			_, err := k.Delegate(ctx, c, vals[i].GetOperator(), sdk.NewInt64Coin("stake", amount))
+			require.NoError(t, err)
+		}
+		for _, tp := range types.SchedulerTaskTypes {
+			require.NoError(t, k.ScheduleOneShotTask(ctx, tp, c, currentHeight+1))
+		}
+		require.NoError(t, k.ScheduleRepeatingTask(ctx, types.SchedulerTaskHandleEpoch, c, currentHeight+10))
+		require.NoError(t, k.PauseScheduledTasks(ctx, types.SchedulerTaskValsetUpdate, c))
+		_, err := k.handleFailedTask(ctx, types.FailedTask{Type: types.SchedulerTaskValsetUpdate, Contract: c.String()}, types.ErrUnknown)
+		require.NoError(t, err)
+		_, err = k.handleFailedTask(ctx, types.FailedTask{Type: types.SchedulerTaskValsetSnapshot, Contract: c.String(), Attempts: k.GetMaxRetryAttempts(ctx)}, types.ErrUnknown)
+		require.NoError(t, err)
+		require.NoError(t, k.appendValsetOutboxEntry(ctx, c, types.ValidatorBonded, vals[0].GetOperator(), nil))
+		k.setValidatorPower(ctx, c, vals[0].GetOperator(), 9)
+	}
+	return vals
+}
+
+// moduleStateOf returns all entries of the module store with the contract address in the key or the value
+func moduleStateOf(t *testing.T, ctx sdk.Context, keepers TestKeepers, contractAddr sdk.AccAddress) map[string][]byte {
+	t.Helper()
+	r := make(map[string][]byte)
+	iter := ctx.KVStore(keepers.StoreKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if bytes.Contains(iter.Key(), contractAddr) || bytes.Contains(iter.Value(), []byte(contractAddr.String())) {
+			r[string(iter.Key())] = iter.Value()
+		}
+	}
+	return r
+}
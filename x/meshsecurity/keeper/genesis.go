@@ -1,6 +1,11 @@
 package keeper
 
 import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
@@ -10,9 +15,168 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
+	for _, info := range data.VirtualStakingMaxCapInfos {
+		contract := sdk.MustAccAddressFromBech32(info.Contract)
+		if err := k.SetMaxCapLimit(ctx, contract, info.Cap); err != nil {
+			panic(errorsmod.Wrapf(err, "max cap limit for contract %s", info.Contract))
+		}
+		k.setTotalDelegated(ctx, contract, info.Delegated)
+		if err := k.SetContractConfig(ctx, contract, info.Config); err != nil {
+			panic(errorsmod.Wrapf(err, "contract config for contract %s", info.Contract))
+		}
+	}
+	// task heights are relative to the export height and rebased on the current height
+	currentHeight := uint64(ctx.BlockHeight())
+	for _, task := range data.ScheduledTasks {
+		contract := sdk.MustAccAddressFromBech32(task.Contract)
+		tp := types.SchedulerTaskType(task.Type)
+		var err error
+		if task.Repeat {
+			err = k.ScheduleRepeatingTask(ctx, tp, contract, currentHeight+task.Height)
+		} else {
+			err = k.ScheduleOneShotTask(ctx, tp, contract, currentHeight+task.Height)
+		}
+		if err != nil {
+			panic(errorsmod.Wrapf(err, "scheduled task for contract %s", task.Contract))
+		}
+	}
+	for _, p := range data.PausedTasks {
+		contract := sdk.MustAccAddressFromBech32(p.Contract)
+		if err := k.PauseScheduledTasks(ctx, types.SchedulerTaskType(p.Type), contract); err != nil {
+			panic(errorsmod.Wrapf(err, "paused tasks for contract %s", p.Contract))
+		}
+	}
+	for _, task := range data.RetryTasks {
+		task.Height += currentHeight
+		if err := k.setFailedTask(ctx, types.BuildRetryTaskKey(task.Height, task.ID), task); err != nil {
+			panic(errorsmod.Wrapf(err, "retry task %d", task.ID))
+		}
+	}
+	for _, d := range data.DelegatedAmounts {
+		k.setTotalDelegated(ctx, sdk.MustAccAddressFromBech32(d.Contract), d.Amount)
+	}
+	for _, d := range data.SlashedAmounts {
+		k.setSlashedAmount(ctx, sdk.MustAccAddressFromBech32(d.Contract), d.Amount)
+	}
+	for _, task := range data.DeadLetterTasks {
+		// heights of the last attempts are the number of blocks before the export height
+		task.Height = pastHeight(task.Height, currentHeight)
+		if err := k.setFailedTask(ctx, types.BuildDeadLetterTaskKey(task.ID), task); err != nil {
+			panic(errorsmod.Wrapf(err, "dead letter task %d", task.ID))
+		}
+	}
+	for _, entry := range data.ValsetOutbox {
+		if err := k.setValsetOutboxEntry(ctx, entry); err != nil {
+			panic(errorsmod.Wrapf(err, "valset outbox entry %d for contract %s", entry.Sequence, entry.Contract))
+		}
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := k.GetParams(ctx)
-	return types.NewGenesisState(params)
+	bondDenom := k.Staking.BondDenom(ctx)
+
+	maxCapInfos := make([]types.VirtualStakingMaxCapInfo, 0)
+	k.IterateMaxCapLimit(ctx, func(addr sdk.AccAddress, maxCap sdkmath.Int) bool {
+		maxCapInfos = append(maxCapInfos, types.VirtualStakingMaxCapInfo{
+			Contract:  addr.String(),
+			Delegated: k.GetTotalDelegated(ctx, addr),
+			Cap:       sdk.NewCoin(bondDenom, maxCap),
+			Config:    k.GetContractConfig(ctx, addr),
+		})
+		return false
+	})
+
+	// recorded totals of contracts without max cap limit are exported separately
+	delegatedAmounts := make([]types.ContractDelegatedAmount, 0)
+	k.IterateTotalDelegated(ctx, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
+		if !k.HasMaxCapLimit(ctx, addr) {
+			delegatedAmounts = append(delegatedAmounts, types.ContractDelegatedAmount{
+				Contract: addr.String(),
+				Amount:   sdk.NewCoin(bondDenom, amount),
+			})
+		}
+		return false
+	})
+
+	slashedAmounts := make([]types.ContractDelegatedAmount, 0)
+	k.IterateSlashedAmounts(ctx, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
+		slashedAmounts = append(slashedAmounts, types.ContractDelegatedAmount{
+			Contract: addr.String(),
+			Amount:   sdk.NewCoin(bondDenom, amount),
+		})
+		return false
+	})
+
+	// task heights are exported relative to the current height so that they can be rebased on import
+	currentHeight := uint64(ctx.BlockHeight())
+	scheduledTasks := make([]types.ScheduledTask, 0)
+	for _, tp := range types.SchedulerTaskTypes {
+		err := k.IterateScheduledTasks(ctx, tp, math.MaxUint, func(addr sdk.AccAddress, height uint64, repeat bool) bool {
+			scheduledTasks = append(scheduledTasks, types.ScheduledTask{
+				Type:     uint32(tp),
+				Contract: addr.String(),
+				Height:   relativeHeight(height, currentHeight),
+				Repeat:   repeat,
+			})
+			return false
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	pausedTasks := make([]types.PausedTasks, 0)
+	k.IteratePausedTasks(ctx, func(tp types.SchedulerTaskType, addr sdk.AccAddress) bool {
+		pausedTasks = append(pausedTasks, types.PausedTasks{Type: uint32(tp), Contract: addr.String()})
+		return false
+	})
+
+	retryTasks := make([]types.FailedTask, 0)
+	k.IterateRetryTasks(ctx, math.MaxUint64, func(task types.FailedTask) bool {
+		task.Height = relativeHeight(task.Height, currentHeight)
+		retryTasks = append(retryTasks, task)
+		return false
+	})
+
+	deadLetterTasks := make([]types.FailedTask, 0)
+	k.IterateDeadLetterTasks(ctx, func(task types.FailedTask) bool {
+		task.Height = relativePastHeight(task.Height, currentHeight)
+		deadLetterTasks = append(deadLetterTasks, task)
+		return false
+	})
+
+	valsetOutbox := make([]types.ValsetOutboxEntry, 0)
+	k.iterateValsetOutbox(ctx, types.ValsetOutboxKeyPrefix, func(entry types.ValsetOutboxEntry) bool {
+		valsetOutbox = append(valsetOutbox, entry)
+		return false
+	})
+	return types.NewGenesisState(params, maxCapInfos, scheduledTasks, pausedTasks, retryTasks, deadLetterTasks, valsetOutbox, delegatedAmounts, slashedAmounts)
+}
+
+// relativeHeight returns the number of blocks from the current height to the given height. Past heights are due
+// and returned as 0.
+func relativeHeight(height, currentHeight uint64) uint64 {
+	if height < currentHeight {
+		return 0
+	}
+	return height - currentHeight
+}
+
+// relativePastHeight returns the number of blocks from the given height to the current height. Future heights are
+// returned as 0.
+func relativePastHeight(height, currentHeight uint64) uint64 {
+	if height > currentHeight {
+		return 0
+	}
+	return currentHeight - height
+}
+
+// pastHeight returns the height the given number of blocks before the current height. The genesis height is
+// returned for heights before the chain start.
+func pastHeight(blocks, currentHeight uint64) uint64 {
+	if blocks > currentHeight {
+		return 0
+	}
+	return currentHeight - blocks
 }
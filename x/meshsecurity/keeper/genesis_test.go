@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 
+	"github.com/cometbft/cometbft/libs/rand"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -68,3 +69,107 @@ func TestExportGenesis(t *testing.T) {
 	assert.Equal(t, params.EpochLength, exported.Params.EpochLength)
 	assert.Equal(t, params.TotalContractsMaxCap, exported.Params.TotalContractsMaxCap)
 }
+
+func TestGenesisRoundTrip(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	myOtherContract := sdk.AccAddress(rand.Bytes(32))
+	myRemovedContract := sdk.AccAddress(rand.Bytes(32))
+	currentHeight := uint64(pCtx.BlockHeight())
+	require.Greater(t, currentHeight, uint64(5))
+
+	require.NoError(t, k.SetMaxCapLimit(pCtx, myContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+	require.NoError(t, k.SetMaxCapLimit(pCtx, myOtherContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2_000_000)))
+	k.setTotalDelegated(pCtx, myContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500_000))
+	k.setTotalDelegated(pCtx, myRemovedContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300_000))
+	k.setSlashedAmount(pCtx, myContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	require.NoError(t, k.ScheduleRepeatingTask(pCtx, types.SchedulerTaskHandleEpoch, myContract, currentHeight+10))
+	require.NoError(t, k.ScheduleOneShotTask(pCtx, types.SchedulerTaskHandleEpoch, myOtherContract, currentHeight))
+	require.NoError(t, k.ScheduleOneShotTask(pCtx, types.SchedulerTaskValsetUpdate, myContract, currentHeight))
+	require.NoError(t, k.PauseScheduledTasks(pCtx, types.SchedulerTaskValsetUpdate, myContract))
+	myConfig := types.ContractConfig{EpochLength: 20, MaxGasEndBlocker: 1_000}
+	require.NoError(t, k.SetContractConfig(pCtx, myContract, myConfig))
+	myRetryHeight, err := k.handleFailedTask(pCtx, types.FailedTask{Type: types.SchedulerTaskValsetUpdate, Contract: myContract.String(), Payload: []byte(`{}`)}, types.ErrUnknown)
+	require.NoError(t, err)
+	_, err = k.handleFailedTask(pCtx.WithBlockHeight(pCtx.BlockHeight()-5), types.FailedTask{Type: types.SchedulerTaskValsetUpdate, Contract: myOtherContract.String(), Payload: []byte(`{}`), Attempts: k.GetMaxRetryAttempts(pCtx)}, types.ErrUnknown)
+	require.NoError(t, err)
+	myValAddr := sdk.ValAddress(rand.Bytes(20))
+	require.NoError(t, k.ScheduleJailed(pCtx, myValAddr))
+	require.NoError(t, k.ScheduleSlashed(pCtx, myValAddr, 1, 2, unixTime(pCtx.BlockTime()), math.NewInt(3), sdk.NewDecWithPrec(1, 1), nil))
+
+	// when
+	exported := k.ExportGenesis(pCtx)
+	// then
+	require.NoError(t, types.ValidateGenesis(exported))
+	assert.Len(t, exported.VirtualStakingMaxCapInfos, 2)
+	assert.Len(t, exported.ScheduledTasks, 4)
+	assert.Len(t, exported.PausedTasks, 1)
+	assert.Len(t, exported.RetryTasks, 1)
+	assert.Len(t, exported.DeadLetterTasks, 1)
+	assert.Len(t, exported.ValsetOutbox, 4)
+	assert.Equal(t, []types.ContractDelegatedAmount{{Contract: myRemovedContract.String(), Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 300_000)}}, exported.DelegatedAmounts)
+	assert.Equal(t, []types.ContractDelegatedAmount{{Contract: myContract.String(), Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)}}, exported.SlashedAmounts)
+	assert.Equal(t, uint64(5), exported.DeadLetterTasks[0].Height)
+
+	// and import into a new chain
+	newCtx, newKeepers := CreateDefaultTestInput(t)
+	newK := newKeepers.MeshKeeper
+	newK.InitGenesis(newCtx, *exported)
+
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000), newK.GetMaxCapLimit(newCtx, myContract))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2_000_000), newK.GetMaxCapLimit(newCtx, myOtherContract))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500_000), newK.GetTotalDelegated(newCtx, myContract))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), newK.GetTotalDelegated(newCtx, myOtherContract))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300_000), newK.GetTotalDelegated(newCtx, myRemovedContract))
+	assert.False(t, newK.HasMaxCapLimit(newCtx, myRemovedContract))
+	assert.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000), newK.GetSlashedAmount(newCtx, myContract))
+	assert.True(t, newK.HasScheduledTask(newCtx, types.SchedulerTaskHandleEpoch, myContract, true))
+	assert.True(t, newK.HasScheduledTask(newCtx, types.SchedulerTaskHandleEpoch, myOtherContract, false))
+	assert.True(t, newK.HasScheduledTask(newCtx, types.SchedulerTaskValsetUpdate, myContract, false))
+	assert.True(t, newK.IsScheduledTasksPaused(newCtx, types.SchedulerTaskValsetUpdate, myContract))
+	assert.Equal(t, myConfig, newK.GetContractConfig(newCtx, myContract))
+	assert.Equal(t, types.ContractConfig{}, newK.GetContractConfig(newCtx, myOtherContract))
+	var retries []types.FailedTask
+	newK.IterateRetryTasks(newCtx, myRetryHeight, func(task types.FailedTask) bool {
+		retries = append(retries, task)
+		return false
+	})
+	require.Len(t, retries, 1)
+	assert.Equal(t, myRetryHeight, retries[0].Height)
+	assert.Equal(t, myRetryHeight-currentHeight, exported.RetryTasks[0].Height)
+	// and new failed task ids do not collide with imported ones
+	assert.Equal(t, uint64(3), newK.nextFailedTaskID(newCtx))
+	// and new outbox entries do not collide with imported ones
+	assert.Equal(t, uint64(2), newK.getLastValsetOutboxSeq(newCtx, myContract))
+	// and state is equal
+	assert.Equal(t, exported, newK.ExportGenesis(newCtx))
+
+	// and task heights rebased when imported at a different height
+	restartCtx, restartKeepers := CreateDefaultTestInput(t)
+	restartCtx = restartCtx.WithBlockHeight(100)
+	restartK := restartKeepers.MeshKeeper
+	restartK.InitGenesis(restartCtx, *exported)
+	gotHeight, found := restartK.GetNextScheduledTaskHeight(restartCtx, types.SchedulerTaskHandleEpoch, myContract)
+	require.True(t, found)
+	assert.Equal(t, uint64(110), gotHeight)
+	gotHeight, found = restartK.GetNextScheduledTaskHeight(restartCtx, types.SchedulerTaskValsetUpdate, myContract)
+	require.True(t, found)
+	assert.Equal(t, uint64(100), gotHeight)
+	retries = nil
+	expRetryHeight := 100 + myRetryHeight - currentHeight
+	restartK.IterateRetryTasks(restartCtx, expRetryHeight, func(task types.FailedTask) bool {
+		retries = append(retries, task)
+		return false
+	})
+	require.Len(t, retries, 1)
+	assert.Equal(t, expRetryHeight, retries[0].Height)
+	var deadLetters []types.FailedTask
+	restartK.IterateDeadLetterTasks(restartCtx, func(task types.FailedTask) bool {
+		deadLetters = append(deadLetters, task)
+		return false
+	})
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, uint64(95), deadLetters[0].Height)
+	assert.Equal(t, exported, restartK.ExportGenesis(restartCtx))
+}
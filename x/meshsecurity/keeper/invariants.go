@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+// supplyOffsetSource is implemented by bank keepers that track a supply offset, like the Osmosis SDK fork.
+// The vanilla SDK bank keeper does not.
+type supplyOffsetSource interface {
+	GetSupplyOffset(ctx sdk.Context, denom string) sdkmath.Int
+}
+
+// RegisterInvariants registers all mesh-security invariants. The supply offset invariant is registered only
+// when the bank keeper tracks a supply offset.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-contracts-max-cap", TotalContractsMaxCapInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "max-cap-limits", MaxCapLimitsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "delegated-amounts", DelegatedAmountsInvariant(k))
+	if _, ok := k.bank.(supplyOffsetSource); ok {
+		ir.RegisterRoute(types.ModuleName, "supply-offset", SupplyOffsetInvariant(k))
+	}
+}
+
+// AllInvariants runs all invariants of the mesh-security module
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		invariants := []sdk.Invariant{
+			TotalContractsMaxCapInvariant(k),
+			MaxCapLimitsInvariant(k),
+			DelegatedAmountsInvariant(k),
+		}
+		if _, ok := k.bank.(supplyOffsetSource); ok {
+			invariants = append(invariants, SupplyOffsetInvariant(k))
+		}
+		for _, inv := range invariants {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// TotalContractsMaxCapInvariant checks that the sum of all contract max caps does not exceed the total contracts max cap param.
+func TotalContractsMaxCapInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		total := sdkmath.ZeroInt()
+		k.IterateMaxCapLimit(ctx, func(_ sdk.AccAddress, m sdkmath.Int) bool {
+			total = total.Add(m)
+			return false
+		})
+		totalMaxCap := k.GetTotalContractsMaxCap(ctx)
+		if totalMaxCap.Amount.IsNil() { // params not initialized, yet
+			totalMaxCap.Amount = sdkmath.ZeroInt()
+		}
+		broken := total.GT(totalMaxCap.Amount)
+		return sdk.FormatInvariant(types.ModuleName, "total-contracts-max-cap",
+			fmt.Sprintf("\tsum of max caps: %s\n\ttotal contracts max cap: %s\n", total, totalMaxCap.Amount)), broken
+	}
+}
+
+// MaxCapLimitsInvariant checks that the value of the delegations of a contract does not exceed its max cap.
+// Contracts with a pending max cap enforcement, scheduled or retried, are skipped as a lowered cap is
+// enforced with the next execution only.
+func MaxCapLimitsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pendingRetries := make(map[string]struct{})
+		k.IterateRetryTasks(ctx, math.MaxUint64, func(task types.FailedTask) bool {
+			if types.SchedulerTaskType(task.Type) == types.SchedulerTaskMaxCapEnforcement {
+				pendingRetries[task.Contract] = struct{}{}
+			}
+			return false
+		})
+		var (
+			msg    string
+			broken bool
+		)
+		k.IterateMaxCapLimit(ctx, func(contract sdk.AccAddress, maxCap sdkmath.Int) bool {
+			if _, pending := pendingRetries[contract.String()]; pending ||
+				k.HasScheduledTask(ctx, types.SchedulerTaskMaxCapEnforcement, contract, false) {
+				return false
+			}
+			if _, _, delegated := k.delegatedTokens(ctx, contract); delegated.GT(maxCap) {
+				broken = true
+				msg += fmt.Sprintf("\tcontract %s delegations %s exceed max cap %s\n", contract, delegated, maxCap)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "max-cap-limits",
+			fmt.Sprintf("contracts with delegations exceeding the max cap:\n%s", msg)), broken
+	}
+}
+
+// DelegatedAmountsInvariant checks that the staking delegations of a contract match its recorded total delegated
+// amount. Slashing reduces the value of the delegations but not the recorded total, so that the recorded total can
+// exceed the value by the slashed amount of the contract. Rounding of the share conversion is tolerated with one
+// token per delegation.
+func DelegatedAmountsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		contracts := make(map[string]sdk.AccAddress)
+		var addrs []sdk.AccAddress
+		collect := func(contract sdk.AccAddress, _ sdkmath.Int) bool {
+			if _, exists := contracts[string(contract)]; !exists {
+				contracts[string(contract)] = contract
+				addrs = append(addrs, contract)
+			}
+			return false
+		}
+		k.IterateTotalDelegated(ctx, collect)
+		k.IterateMaxCapLimit(ctx, collect)
+
+		var (
+			msg    string
+			broken bool
+		)
+		for _, contract := range addrs {
+			actual, delegations := sdkmath.ZeroInt(), int64(0)
+			k.Staking.IterateDelegations(ctx, contract, func(_ int64, del stakingtypes.DelegationI) bool {
+				val, found := k.Staking.GetValidator(ctx, del.GetValidatorAddr())
+				if !found {
+					broken = true
+					msg += fmt.Sprintf("\tcontract %s delegated to unknown validator %s\n", contract, del.GetValidatorAddr())
+					return false
+				}
+				actual = actual.Add(val.TokensFromShares(del.GetShares()).TruncateInt())
+				delegations++
+				return false
+			})
+			recorded := k.GetTotalDelegated(ctx, contract).Amount
+			if actual.GT(recorded) {
+				broken = true
+				msg += fmt.Sprintf("\tcontract %s delegations %s exceed recorded total %s\n", contract, actual, recorded)
+				continue
+			}
+			slashed := k.GetSlashedAmount(ctx, contract).Amount
+			if shortfall := recorded.Sub(actual); shortfall.GT(slashed.AddRaw(delegations)) {
+				broken = true
+				msg += fmt.Sprintf("\tcontract %s delegations %s below recorded total %s by more than the slashed amount %s\n", contract, actual, recorded, slashed)
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "delegated-amounts",
+			fmt.Sprintf("contracts with delegations not matching the recorded total:\n%s", msg)), broken
+	}
+}
+
+// SupplyOffsetInvariant checks that the bank supply offset of the bond denom equals the negated sum of all
+// virtual delegations. Requires a bank keeper that tracks a supply offset.
+func SupplyOffsetInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		src, ok := k.bank.(supplyOffsetSource)
+		if !ok {
+			return sdk.FormatInvariant(types.ModuleName, "supply-offset", "\tsupply offset not supported by bank keeper\n"), true
+		}
+		total := sdkmath.ZeroInt()
+		k.IterateTotalDelegated(ctx, func(_ sdk.AccAddress, m sdkmath.Int) bool {
+			total = total.Add(m)
+			return false
+		})
+		offset := src.GetSupplyOffset(ctx, k.Staking.BondDenom(ctx))
+		broken := !offset.Equal(total.Neg())
+		return sdk.FormatInvariant(types.ModuleName, "supply-offset",
+			fmt.Sprintf("\tsupply offset: %s\n\tsum of virtual delegations: %s\n", offset, total)), broken
+	}
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+func TestInvariants(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	captBankKeeper := NewCaptureOffsetBankKeeper(keepers.BankKeeper)
+	k.bank = captBankKeeper
+
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	myValAddr := add3Validators(t, pCtx, keepers.StakingKeeper)[0]
+	require.NoError(t, k.SetMaxCapLimit(pCtx, myContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+	_, err := k.Delegate(pCtx, myContract, myValAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500_000))
+	require.NoError(t, err)
+	slash := func(t *testing.T, ctx sdk.Context) {
+		preSlashDelegations := k.SnapshotContractDelegations(ctx, myValAddr)
+		val, found := keepers.StakingKeeper.GetValidator(ctx, myValAddr)
+		require.True(t, found)
+		keepers.StakingKeeper.RemoveValidatorTokens(ctx, val, math.NewInt(50_000))
+		k.CaptureSlashedAmounts(ctx, myValAddr, preSlashDelegations)
+		require.True(t, k.GetSlashedAmount(ctx, myContract).IsPositive())
+	}
+
+	specs := map[string]struct {
+		setup     func(t *testing.T, ctx sdk.Context)
+		expBroken bool
+	}{
+		"all good": {
+			setup: func(t *testing.T, ctx sdk.Context) {},
+		},
+		"delegated exceeds lowered max cap": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 499_999)))
+			},
+			expBroken: true,
+		},
+		"delegated exceeds lowered max cap with scheduled enforcement": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 499_999)))
+				require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskMaxCapEnforcement, myContract, uint64(ctx.BlockHeight())+1))
+			},
+		},
+		"delegated exceeds lowered max cap with enforcement retry": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 499_999)))
+				_, err := k.handleFailedTask(ctx, types.FailedTask{Type: types.SchedulerTaskMaxCapEnforcement, Contract: myContract.String(), Payload: []byte(`{}`)}, types.ErrUnknown)
+				require.NoError(t, err)
+			},
+		},
+		"delegations slashed": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				slash(t, ctx)
+			},
+		},
+		"delegations below recorded total without slash": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				slash(t, ctx)
+				ctx.KVStore(k.storeKey).Delete(types.BuildSlashedAmountKey(myContract))
+			},
+			expBroken: true,
+		},
+		"delegations without recorded total": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				ctx.KVStore(k.storeKey).Delete(types.BuildTotalDelegatedAmountKey(myContract))
+				captBankKeeper.Offset[sdk.DefaultBondDenom] = math.ZeroInt()
+			},
+			expBroken: true,
+		},
+		"sum of max caps exceeds total": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				params := k.GetParams(ctx)
+				params.TotalContractsMaxCap = sdk.NewInt64Coin(sdk.DefaultBondDenom, 999_999)
+				require.NoError(t, k.SetParams(ctx, params))
+			},
+			expBroken: true,
+		},
+		"delegations exceed recorded total": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				k.setTotalDelegated(ctx, myContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 499_999))
+				captBankKeeper.Offset[sdk.DefaultBondDenom] = math.NewInt(-499_999)
+			},
+			expBroken: true,
+		},
+		"supply offset does not match": {
+			setup: func(t *testing.T, ctx sdk.Context) {
+				captBankKeeper.Offset[sdk.DefaultBondDenom] = math.NewInt(-1)
+			},
+			expBroken: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			captBankKeeper.Offset[sdk.DefaultBondDenom] = math.NewInt(-500_000)
+			spec.setup(t, ctx)
+			// when
+			msg, broken := AllInvariants(k)(ctx)
+			// then
+			assert.Equal(t, spec.expBroken, broken, msg)
+		})
+	}
+}
+
+func TestInvariantsBeforeGenesis(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	ctx.KVStore(k.storeKey).Delete(types.ParamsKey)
+
+	// when
+	msg, broken := TotalContractsMaxCapInvariant(k)(ctx)
+	// then
+	assert.False(t, broken, msg)
+}
+
+func TestRegisterInvariants(t *testing.T) {
+	specs := map[string]struct {
+		bank      types.XBankKeeper
+		expRoutes []string
+	}{
+		"bank keeper with supply offset": {
+			bank:      NewCaptureOffsetBankKeeper(nil),
+			expRoutes: []string{"total-contracts-max-cap", "max-cap-limits", "delegated-amounts", "supply-offset"},
+		},
+		"vanilla bank keeper": {
+			bank:      NewBankKeeperAdapter(nil),
+			expRoutes: []string{"total-contracts-max-cap", "max-cap-limits", "delegated-amounts"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			k := &Keeper{bank: spec.bank}
+			var reg captureInvariantRegistry
+			// when
+			RegisterInvariants(&reg, k)
+			// then
+			assert.Equal(t, spec.expRoutes, reg.routes)
+		})
+	}
+}
+
+type captureInvariantRegistry struct {
+	routes []string
+}
+
+func (c *captureInvariantRegistry) RegisterRoute(moduleName, route string, _ sdk.Invariant) {
+	c.routes = append(c.routes, route)
+}
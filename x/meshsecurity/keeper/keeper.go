@@ -29,6 +29,10 @@ type Keeper struct {
 	bank     types.XBankKeeper
 	Staking  types.XStakingKeeper
 	wasm     types.WasmKeeper
+	// optional, used for the tombstoned flag in the valset snapshots
+	slashing types.SlashingKeeper
+	// optional, used for the staking rewards contract query
+	distribution types.DistributionKeeper
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -111,15 +115,84 @@ func (k Keeper) SetMaxCapLimit(ctx sdk.Context, contract sdk.AccAddress, newAmou
 	if total.Add(newAmount.Amount).GT(totalMaxCap.Amount) {
 		return types.ErrInvalid.Wrapf("amount exceeds total available max cap (used %s of %s)", total, totalMaxCap)
 	}
-	// persist
-	store := ctx.KVStore(k.storeKey)
+	k.setMaxCapLimit(ctx, contract, newAmount)
+	return nil
+}
+
+// SetMaxCapLimits stores the max cap limits for a set of contracts at once.
+// The total of the new and the remaining existing limits must not exceed the total contracts max cap.
+func (k Keeper) SetMaxCapLimits(ctx sdk.Context, maxCaps []types.VirtualStakingMaxCap) error {
+	bondDenom := k.Staking.BondDenom(ctx)
+	newAmounts := make(map[string]sdk.Coin, len(maxCaps))
+	contracts := make([]sdk.AccAddress, len(maxCaps))
+	for i, c := range maxCaps {
+		if bondDenom != c.MaxCap.Denom {
+			return sdkerrors.ErrInvalidCoins
+		}
+		contract, err := sdk.AccAddressFromBech32(c.Contract)
+		if err != nil {
+			return errorsmod.Wrap(err, "contract")
+		}
+		contracts[i] = contract
+		newAmounts[string(contract)] = c.MaxCap
+	}
+	// ensure that the total max cap amount for all contracts is not exceeded
+	total := math.ZeroInt()
+	k.IterateMaxCapLimit(ctx, func(addr sdk.AccAddress, m math.Int) bool {
+		if _, ok := newAmounts[string(addr)]; !ok {
+			total = total.Add(m)
+		}
+		return false
+	})
+	for _, c := range maxCaps {
+		total = total.Add(c.MaxCap.Amount)
+	}
+	if totalMaxCap := k.GetTotalContractsMaxCap(ctx); total.GT(totalMaxCap.Amount) {
+		return types.ErrInvalid.Wrapf("amounts exceed total available max cap (%s of %s)", total, totalMaxCap)
+	}
+	for _, contract := range contracts {
+		k.setMaxCapLimit(ctx, contract, newAmounts[string(contract)])
+	}
+	return nil
+}
+
+// setMaxCapLimit persists the max cap limit for the contract without further checks
+func (k Keeper) setMaxCapLimit(ctx sdk.Context, contract sdk.AccAddress, newAmount sdk.Coin) {
 	bz, err := newAmount.Amount.Marshal()
 	if err != nil { // always nil
-		return errorsmod.Wrap(err, "marshal amount")
+		panic(errorsmod.Wrap(err, "marshal amount"))
 	}
-	store.Set(types.BuildMaxCapLimitKey(contract), bz)
+	ctx.KVStore(k.storeKey).Set(types.BuildMaxCapLimitKey(contract), bz)
 
 	types.EmitMaxCapLimitUpdatedEvent(ctx, contract, newAmount)
+}
+
+// GetContractConfig returns the contract specific overrides of the module params.
+// Returns an empty config when none is stored.
+func (k Keeper) GetContractConfig(ctx sdk.Context, contract sdk.AccAddress) types.ContractConfig {
+	var r types.ContractConfig
+	bz := ctx.KVStore(k.storeKey).Get(types.BuildContractConfigKey(contract))
+	if bz == nil {
+		return r
+	}
+	k.cdc.MustUnmarshal(bz, &r)
+	return r
+}
+
+// SetContractConfig stores the contract specific overrides of the module params.
+// An empty config removes the overrides.
+func (k Keeper) SetContractConfig(ctx sdk.Context, contract sdk.AccAddress, config types.ContractConfig) error {
+	store := ctx.KVStore(k.storeKey)
+	if config.Equal(types.ContractConfig{}) {
+		store.Delete(types.BuildContractConfigKey(contract))
+	} else {
+		bz, err := k.cdc.Marshal(&config)
+		if err != nil {
+			return errorsmod.Wrap(err, "marshal config")
+		}
+		store.Set(types.BuildContractConfigKey(contract), bz)
+	}
+	types.EmitContractConfigSetEvent(ctx, contract, config)
 	return nil
 }
 
@@ -147,6 +220,26 @@ func (k Keeper) setTotalDelegated(ctx sdk.Context, actor sdk.AccAddress, newAmou
 	store.Set(types.BuildTotalDelegatedAmountKey(actor), bz)
 }
 
+// GetSlashedAmount returns the amount slashed from the delegations of the given consumer contract.
+// The recorded total delegated amount is not reduced by a slash, so that it can exceed the value of
+// the delegations by up to this amount.
+func (k Keeper) GetSlashedAmount(ctx sdk.Context, actor sdk.AccAddress) sdk.Coin {
+	return sdk.NewCoin(k.Staking.BondDenom(ctx), k.mustLoadInt(ctx, k.storeKey, types.BuildSlashedAmountKey(actor)))
+}
+
+// internal setter. must only be used with bonding token denom or panics
+func (k Keeper) setSlashedAmount(ctx sdk.Context, actor sdk.AccAddress, newAmount sdk.Coin) {
+	if k.Staking.BondDenom(ctx) != newAmount.Denom {
+		panic(sdkerrors.ErrInvalidCoins.Wrapf("not a staking denom: %s", newAmount.Denom))
+	}
+
+	bz, err := newAmount.Amount.Marshal()
+	if err != nil { // always nil
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.BuildSlashedAmountKey(actor), bz)
+}
+
 // helper to deserialize a math.Int from store. Returns zero when key does not exist.
 // Panics when Unmarshal fails
 func (k Keeper) mustLoadInt(ctx sdk.Context, storeKey storetypes.StoreKey, key []byte) math.Int {
@@ -181,6 +274,44 @@ func (k Keeper) IterateMaxCapLimit(ctx sdk.Context, cb func(sdk.AccAddress, math
 	}
 }
 
+// IterateTotalDelegated iterate over contract addresses with total delegated amount set
+// Callback can return true to stop early
+func (k Keeper) IterateTotalDelegated(ctx sdk.Context, cb func(sdk.AccAddress, math.Int) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.TotalDelegatedAmountKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var r math.Int
+		if err := r.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		// cb returns true to stop early
+		if cb(iter.Key(), r) {
+			return
+		}
+	}
+}
+
+// IterateSlashedAmounts iterate over contract addresses with slashed amount set
+// Callback can return true to stop early
+func (k Keeper) IterateSlashedAmounts(ctx sdk.Context, cb func(sdk.AccAddress, math.Int) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashedAmountKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var r math.Int
+		if err := r.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		// cb returns true to stop early
+		if cb(iter.Key(), r) {
+			return
+		}
+	}
+}
+
 // ModuleLogger returns logger with module attribute
 func ModuleLogger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+// ScheduleMaxCapEnforcement schedules the enforcement of the max cap limit at the end of the grace period when the
+// delegations of the contract are worth more than its limit. Nothing is scheduled when the enforcement is disabled
+// in the params.
+func (k Keeper) ScheduleMaxCapEnforcement(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	gracePeriod := k.GetMaxCapGracePeriod(ctx)
+	if gracePeriod == 0 {
+		return nil
+	}
+	if _, _, total := k.delegatedTokens(ctx, contractAddr); !total.GT(k.GetMaxCapLimit(ctx, contractAddr).Amount) {
+		return nil
+	}
+	return k.ScheduleOneShotTask(ctx, types.SchedulerTaskMaxCapEnforcement, contractAddr, uint64(ctx.BlockHeight())+gracePeriod)
+}
+
+// EnforceMaxCap undelegates the amount above the max cap limit of the contract, proportionally to the amounts
+// delegated to the validators. The excess and the split are both based on the current value of the delegations,
+// which is below the recorded total delegated amount after a slash. The recorded total is reduced by the amounts
+// undelegated, like for an undelegation by the contract, so that the delegated amounts and supply offset invariants
+// hold. It can stay above the limit by the slashed amount.
+// Returns the json encoded max_cap_enforced sudo message with the undelegations, or nil when the limit is not
+// exceeded. The message is returned with an error as well, so that the enforcement can be stored for a retry.
+func (k Keeper) EnforceMaxCap(pCtx sdk.Context, contractAddr sdk.AccAddress) ([]byte, error) {
+	maxCap := k.GetMaxCapLimit(pCtx, contractAddr)
+	valAddrs, amounts, total := k.delegatedTokens(pCtx, contractAddr)
+	excess := total.Sub(maxCap.Amount)
+	if !excess.IsPositive() {
+		return nil, nil
+	}
+	bondDenom := k.Staking.BondDenom(pCtx)
+	var (
+		undelegatedVals []sdk.ValAddress
+		coins           []sdk.Coin
+		undelegations   []contract.Undelegation
+	)
+	for i, amount := range splitProportionally(excess, amounts) {
+		if !amount.IsPositive() {
+			continue
+		}
+		coin := sdk.NewCoin(bondDenom, amount)
+		undelegatedVals = append(undelegatedVals, valAddrs[i])
+		coins = append(coins, coin)
+		undelegations = append(undelegations, contract.Undelegation{
+			ValidatorAddr: valAddrs[i].String(),
+			Amount:        wasmkeeper.ConvertSdkCoinToWasmCoin(coin),
+		})
+	}
+	payload, err := json.Marshal(contract.SudoMsg{MaxCapEnforced: &contract.MaxCapEnforced{
+		Height:        pCtx.BlockHeight(),
+		Time:          pCtx.BlockTime().Unix(),
+		MaxCap:        wasmkeeper.ConvertSdkCoinToWasmCoin(maxCap),
+		Undelegations: undelegations,
+	}})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "marshal sudo msg")
+	}
+
+	cacheCtx, done := pCtx.CacheContext()
+	for i, coin := range coins {
+		valAddr := undelegatedVals[i]
+		if err := k.Undelegate(cacheCtx, contractAddr, valAddr, coin); err != nil {
+			return payload, errorsmod.Wrapf(err, "undelegate from %s", valAddr)
+		}
+		types.EmitMaxCapEnforcedEvent(cacheCtx, contractAddr, valAddr, coin, maxCap)
+	}
+	done()
+	return payload, nil
+}
+
+// NotifyMaxCapEnforced sends the max_cap_enforced sudo message returned by EnforceMaxCap to the contract.
+// A failure in the contract is logged only so that it can not revert the enforcement.
+func (k Keeper) NotifyMaxCapEnforced(ctx sdk.Context, contractAddr sdk.AccAddress, payload []byte) {
+	if payload == nil {
+		return
+	}
+	if err := k.doIsolatedRawSudoCall(ctx, contractAddr, payload); err != nil {
+		ModuleLogger(ctx).Error("failed to notify contract about max cap enforcement", "contract", contractAddr.String(), "cause", err)
+	}
+}
+
+// delegatedTokens returns the validators and the current value of the delegations of the contract together with
+// the sum of all values. Delegations without value are skipped.
+func (k Keeper) delegatedTokens(ctx sdk.Context, contractAddr sdk.AccAddress) ([]sdk.ValAddress, []math.Int, math.Int) {
+	var (
+		valAddrs []sdk.ValAddress
+		amounts  []math.Int
+	)
+	total := math.ZeroInt()
+	k.Staking.IterateDelegations(ctx, contractAddr, func(_ int64, del stakingtypes.DelegationI) bool {
+		val, found := k.Staking.GetValidator(ctx, del.GetValidatorAddr())
+		if !found {
+			return false
+		}
+		if amount := val.TokensFromShares(del.GetShares()).TruncateInt(); amount.IsPositive() {
+			valAddrs = append(valAddrs, del.GetValidatorAddr())
+			amounts = append(amounts, amount)
+			total = total.Add(amount)
+		}
+		return false
+	})
+	return valAddrs, amounts, total
+}
+
+// splitProportionally splits the amount by the given weights, rounded down. The remainder is added to the first
+// entries that have capacity left. Each share is limited by its weight.
+func splitProportionally(amount math.Int, weights []math.Int) []math.Int {
+	total := math.ZeroInt()
+	for _, w := range weights {
+		total = total.Add(w)
+	}
+	r := make([]math.Int, len(weights))
+	if amount.GTE(total) {
+		copy(r, weights)
+		return r
+	}
+	remainder := amount
+	for i, w := range weights {
+		r[i] = w.Mul(amount).Quo(total)
+		remainder = remainder.Sub(r[i])
+	}
+	for i, w := range weights {
+		if !remainder.IsPositive() {
+			break
+		}
+		add := math.MinInt(remainder, w.Sub(r[i]))
+		r[i] = r[i].Add(add)
+		remainder = remainder.Sub(add)
+	}
+	return r
+}
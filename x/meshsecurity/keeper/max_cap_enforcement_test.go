@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+func TestScheduleMaxCapEnforcement(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	keepers.Faucet.Fund(pCtx, keepers.AccountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName), sdk.NewInt64Coin("stake", 9_000_000))
+	vals := addBondedValidators(t, pCtx, keepers.StakingKeeper, math.NewInt(9_000_000))
+	require.NoError(t, k.SetMaxCapLimit(pCtx, myContractAddr, sdk.NewInt64Coin("stake", 1_000_000)))
+	_, err := k.Delegate(pCtx, myContractAddr, vals[0].GetOperator(), sdk.NewInt64Coin("stake", 1_000_000))
+	require.NoError(t, err)
+	gracePeriod := uint64(k.GetParams(pCtx).MaxCapGracePeriod)
+	require.NotZero(t, gracePeriod)
+
+	specs := map[string]struct {
+		setup       func(ctx sdk.Context)
+		expSchedule bool
+	}{
+		"limit exceeded": {
+			setup: func(ctx sdk.Context) {
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin("stake", 999_999)))
+			},
+			expSchedule: true,
+		},
+		"limit not exceeded": {
+			setup:       func(ctx sdk.Context) {},
+			expSchedule: false,
+		},
+		"enforcement disabled": {
+			setup: func(ctx sdk.Context) {
+				require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin("stake", 0)))
+				params := k.GetParams(ctx)
+				params.MaxCapGracePeriod = 0
+				require.NoError(t, k.SetParams(ctx, params))
+			},
+			expSchedule: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			spec.setup(ctx)
+
+			// when
+			gotErr := k.ScheduleMaxCapEnforcement(ctx, myContractAddr)
+
+			// then
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expSchedule, k.hasScheduledTaskAt(ctx, types.SchedulerTaskMaxCapEnforcement, myContractAddr, uint64(ctx.BlockHeight())+gracePeriod))
+			assert.Equal(t, spec.expSchedule, k.HasScheduledTask(ctx, types.SchedulerTaskMaxCapEnforcement, myContractAddr, false))
+		})
+	}
+}
+
+func TestEnforceMaxCap(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	keepers.Faucet.Fund(pCtx, keepers.AccountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName), sdk.NewInt64Coin("stake", 17_000_000))
+	vals := addBondedValidators(t, pCtx, keepers.StakingKeeper, math.NewInt(9_000_000), math.NewInt(8_000_000))
+	require.NoError(t, k.SetMaxCapLimit(pCtx, myContractAddr, sdk.NewInt64Coin("stake", 3_000_000)))
+	for i, amount := range []int64{1_000_000, 2_000_000} {
+		_, err := k.Delegate(pCtx, myContractAddr, vals[i].GetOperator(), sdk.NewInt64Coin("stake", amount))
+		require.NoError(t, err)
+	}
+	myVal1, myVal2 := vals[0].GetOperator().String(), vals[1].GetOperator().String()
+
+	specs := map[string]struct {
+		maxCap           int64
+		setup            func(ctx sdk.Context)
+		expUndelegations []contract.Undelegation
+		expDelegations   map[string]int64
+		expTotal         int64
+	}{
+		"limit not exceeded": {
+			maxCap:         3_000_000,
+			expDelegations: map[string]int64{myVal1: 1_000_000, myVal2: 2_000_000},
+			expTotal:       3_000_000,
+		},
+		"proportional undelegations": {
+			maxCap: 1_500_000,
+			expUndelegations: []contract.Undelegation{
+				{ValidatorAddr: myVal1, Amount: wasmvmtypes.NewCoin(500_000, "stake")},
+				{ValidatorAddr: myVal2, Amount: wasmvmtypes.NewCoin(1_000_000, "stake")},
+			},
+			expDelegations: map[string]int64{myVal1: 500_000, myVal2: 1_000_000},
+			expTotal:       1_500_000,
+		},
+		"remainder undelegated": {
+			maxCap: 2_999_999,
+			expUndelegations: []contract.Undelegation{
+				{ValidatorAddr: myVal1, Amount: wasmvmtypes.NewCoin(1, "stake")},
+			},
+			expDelegations: map[string]int64{myVal1: 999_999, myVal2: 2_000_000},
+			expTotal:       2_999_999,
+		},
+		"all undelegated": {
+			maxCap: 0,
+			expUndelegations: []contract.Undelegation{
+				{ValidatorAddr: myVal1, Amount: wasmvmtypes.NewCoin(1_000_000, "stake")},
+				{ValidatorAddr: myVal2, Amount: wasmvmtypes.NewCoin(2_000_000, "stake")},
+			},
+			expDelegations: map[string]int64{},
+			expTotal:       0,
+		},
+		"slashed delegation": {
+			maxCap: 1_500_000,
+			setup: func(ctx sdk.Context) {
+				// delegations to the first validator lose half of their value
+				preSlashDelegations := k.SnapshotContractDelegations(ctx, vals[0].GetOperator())
+				val, found := keepers.StakingKeeper.GetValidator(ctx, vals[0].GetOperator())
+				require.True(t, found)
+				val.Tokens = val.Tokens.QuoRaw(2)
+				keepers.StakingKeeper.SetValidator(ctx, val)
+				k.CaptureSlashedAmounts(ctx, vals[0].GetOperator(), preSlashDelegations)
+			},
+			expUndelegations: []contract.Undelegation{
+				{ValidatorAddr: myVal1, Amount: wasmvmtypes.NewCoin(200_000, "stake")},
+				{ValidatorAddr: myVal2, Amount: wasmvmtypes.NewCoin(800_000, "stake")},
+			},
+			expDelegations: map[string]int64{myVal1: 300_000, myVal2: 1_200_000},
+			// the slashed amount is kept in the recorded total
+			expTotal: 2_000_000,
+		},
+		"slashed delegation within limit": {
+			maxCap: 2_500_000,
+			setup: func(ctx sdk.Context) {
+				preSlashDelegations := k.SnapshotContractDelegations(ctx, vals[0].GetOperator())
+				val, found := keepers.StakingKeeper.GetValidator(ctx, vals[0].GetOperator())
+				require.True(t, found)
+				val.Tokens = val.Tokens.QuoRaw(2)
+				keepers.StakingKeeper.SetValidator(ctx, val)
+				k.CaptureSlashedAmounts(ctx, vals[0].GetOperator(), preSlashDelegations)
+			},
+			expDelegations: map[string]int64{myVal1: 500_000, myVal2: 2_000_000},
+			expTotal:       3_000_000,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin("stake", spec.maxCap)))
+			if spec.setup != nil {
+				spec.setup(ctx)
+			}
+
+			// when
+			gotPayload, gotErr := k.EnforceMaxCap(ctx, myContractAddr)
+
+			// then
+			require.NoError(t, gotErr)
+			if spec.expUndelegations == nil {
+				assert.Nil(t, gotPayload)
+			} else {
+				var got contract.SudoMsg
+				require.NoError(t, json.Unmarshal(gotPayload, &got))
+				require.NotNil(t, got.MaxCapEnforced)
+				assert.Equal(t, wasmvmtypes.NewCoin(uint64(spec.maxCap), "stake"), got.MaxCapEnforced.MaxCap)
+				assert.Equal(t, ctx.BlockHeight(), got.MaxCapEnforced.Height)
+				assert.ElementsMatch(t, spec.expUndelegations, got.MaxCapEnforced.Undelegations)
+			}
+			gotDelegations := make(map[string]int64)
+			for _, d := range k.ContractDelegations(ctx, myContractAddr) {
+				amount, ok := math.NewIntFromString(d.Amount.Amount)
+				require.True(t, ok)
+				gotDelegations[d.Validator] = amount.Int64()
+			}
+			assert.Equal(t, spec.expDelegations, gotDelegations)
+			assert.Equal(t, sdk.NewInt64Coin("stake", spec.expTotal), k.GetTotalDelegated(ctx, myContractAddr))
+			// and the delegations are covered by the recorded total
+			_, broken := DelegatedAmountsInvariant(k)(ctx)
+			assert.False(t, broken)
+			var gotEvents int
+			for _, e := range em.Events() {
+				if e.Type == types.EventTypeMaxCapEnforced {
+					gotEvents++
+				}
+			}
+			assert.Equal(t, len(spec.expUndelegations), gotEvents)
+		})
+	}
+
+	// and nothing is undelegated when an undelegation fails
+	ctx, _ := pCtx.CacheContext()
+	require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin("stake", 1_500_000)))
+	k.setTotalDelegated(ctx, myContractAddr, sdk.NewInt64Coin("stake", 1_000_000))
+	gotPayload, gotErr := k.EnforceMaxCap(ctx, myContractAddr)
+	require.Error(t, gotErr)
+	// but the message is returned for a retry
+	assert.Contains(t, string(gotPayload), `"max_cap_enforced":{`)
+	gotDelegations := k.ContractDelegations(ctx, myContractAddr)
+	require.Len(t, gotDelegations, 2)
+	assert.Equal(t, k.ContractDelegations(pCtx, myContractAddr), gotDelegations)
+	assert.Equal(t, sdk.NewInt64Coin("stake", 1_000_000), k.GetTotalDelegated(ctx, myContractAddr))
+}
+
+func TestNotifyMaxCapEnforced(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	myPayload := []byte(`{"max_cap_enforced":{}}`)
+
+	specs := map[string]struct {
+		payload      []byte
+		sudoErr      error
+		expCalled    bool
+		expPersisted bool
+	}{
+		"notification sent": {
+			payload:      myPayload,
+			expCalled:    true,
+			expPersisted: true,
+		},
+		"contract failure reverts contract state only": {
+			payload:   myPayload,
+			sudoErr:   errors.New("testing"),
+			expCalled: true,
+		},
+		"nothing enforced": {},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			var called bool
+			k.wasm = MockWasmKeeper{SudoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+				called = true
+				require.Equal(t, myContractAddr, contractAddress)
+				assert.Equal(t, spec.payload, msg)
+				ctx.KVStore(keepers.StoreKey).Set([]byte("contract-state"), []byte{1})
+				return nil, spec.sudoErr
+			}}
+
+			// when
+			k.NotifyMaxCapEnforced(ctx, myContractAddr, spec.payload)
+
+			// then
+			assert.Equal(t, spec.expCalled, called)
+			assert.Equal(t, spec.expPersisted, ctx.KVStore(keepers.StoreKey).Has([]byte("contract-state")))
+		})
+	}
+}
+
+func TestSplitProportionally(t *testing.T) {
+	ints := func(v ...int64) []math.Int {
+		r := make([]math.Int, len(v))
+		for i, x := range v {
+			r[i] = math.NewInt(x)
+		}
+		return r
+	}
+	specs := map[string]struct {
+		amount  int64
+		weights []math.Int
+		exp     []math.Int
+	}{
+		"proportional": {
+			amount:  30,
+			weights: ints(10, 20, 30),
+			exp:     ints(5, 10, 15),
+		},
+		"remainder to first entries": {
+			amount:  2,
+			weights: ints(1, 1, 1),
+			exp:     ints(1, 1, 0),
+		},
+		"remainder skips full entries": {
+			amount:  3,
+			weights: ints(1, 5),
+			exp:     ints(1, 2),
+		},
+		"amount exceeds total": {
+			amount:  100,
+			weights: ints(10, 20),
+			exp:     ints(10, 20),
+		},
+		"empty weights": {
+			amount:  10,
+			weights: ints(),
+			exp:     ints(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := splitProportionally(math.NewInt(spec.amount), spec.weights)
+			assert.Equal(t, fmt.Sprint(spec.exp), fmt.Sprint(got))
+		})
+	}
+}
@@ -41,22 +41,246 @@ func (m msgServer) SetVirtualStakingMaxCap(goCtx context.Context, req *types.Msg
 	if err := m.k.SetMaxCapLimit(ctx, acc, req.MaxCap); err != nil {
 		return nil, err
 	}
+	if err := m.scheduleMaxCapTasks(ctx, acc, req.MaxCap); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetVirtualStakingMaxCapResponse{}, nil
+}
+
+// SetVirtualStakingMaxCaps sets new max cap limits for a set of virtual staking contracts at once
+func (m msgServer) SetVirtualStakingMaxCaps(goCtx context.Context, req *types.MsgSetVirtualStakingMaxCaps) (*types.MsgSetVirtualStakingMaxCapsResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if authority := m.k.GetAuthority(); authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.k.SetMaxCapLimits(ctx, req.MaxCaps); err != nil {
+		return nil, err
+	}
+	for _, c := range req.MaxCaps {
+		acc, err := sdk.AccAddressFromBech32(c.Contract)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "contract")
+		}
+		if err := m.scheduleMaxCapTasks(ctx, acc, c.MaxCap); err != nil {
+			return nil, errorsmod.Wrapf(err, "contract %s", c.Contract)
+		}
+	}
+	return &types.MsgSetVirtualStakingMaxCapsResponse{}, nil
+}
+
+// scheduleMaxCapTasks schedules the rebalance tasks for a contract with a new max cap limit
+func (m msgServer) scheduleMaxCapTasks(ctx sdk.Context, acc sdk.AccAddress, maxCap sdk.Coin) error {
+	// the module undelegates the amount above a lowered limit when the contract does not within the grace period
+	if err := m.k.ScheduleMaxCapEnforcement(ctx, acc); err != nil {
+		return errorsmod.Wrap(err, "schedule max cap enforcement")
+	}
 	if !m.k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, acc, true) {
 		if err := m.k.ScheduleRegularRebalanceTask(ctx, acc); err != nil {
-			return nil, errorsmod.Wrap(err, "schedule regular rebalance task")
+			return errorsmod.Wrap(err, "schedule regular rebalance task")
+		}
+		// a (re-)registered contract receives the full validator set
+		if err := m.k.ScheduleValsetSnapshot(ctx, acc); err != nil {
+			return errorsmod.Wrap(err, "schedule valset snapshot")
 		}
-		return &types.MsgSetVirtualStakingMaxCapResponse{}, nil
+		return nil
 	}
-	if req.MaxCap.IsZero() {
+	if maxCap.IsZero() {
 		// no need to run regular rebalances with a new limit of 0
 		if err := m.k.DeleteAllScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, acc); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	// schedule last rebalance callback to let the contract do undelegates and housekeeping
 	if err := m.k.ScheduleOneShotTask(ctx, types.SchedulerTaskHandleEpoch, acc, uint64(ctx.BlockHeight())); err != nil {
-		return nil, errorsmod.Wrap(err, "schedule one shot rebalance task")
+		return errorsmod.Wrap(err, "schedule one shot rebalance task")
 	}
-	return &types.MsgSetVirtualStakingMaxCapResponse{}, nil
+	return nil
+}
+
+// SetContractConfig sets the contract specific overrides of the module params for a virtual staking contract.
+// The new epoch length applies after the next rebalance execution.
+func (m msgServer) SetContractConfig(goCtx context.Context, req *types.MsgSetContractConfig) (*types.MsgSetContractConfigResponse, error) {
+	ctx, contract, err := m.authorizeSchedulerMsg(goCtx, req, req.Authority, req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if !m.k.HasMaxCapLimit(ctx, contract) {
+		return nil, types.ErrUnknown.Wrapf("max cap limit for contract: %s", req.Contract)
+	}
+	snapshotsEnabled := !m.k.GetContractConfig(ctx, contract).DisableValsetSnapshots
+	if err := m.k.SetContractConfig(ctx, contract, req.Config); err != nil {
+		return nil, err
+	}
+	if !snapshotsEnabled {
+		// the contract receives the full validator set when snapshots are enabled
+		if err := m.k.ScheduleValsetSnapshot(ctx, contract); err != nil {
+			return nil, errorsmod.Wrap(err, "schedule valset snapshot")
+		}
+	}
+	return &types.MsgSetContractConfigResponse{}, nil
+}
+
+// ScheduleTask schedules a new task for a virtual staking contract
+func (m msgServer) ScheduleTask(goCtx context.Context, req *types.MsgScheduleTask) (*types.MsgScheduleTaskResponse, error) {
+	ctx, contract, err := m.authorizeSchedulerMsg(goCtx, req, req.Authority, req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if !m.k.HasMaxCapLimit(ctx, contract) {
+		return nil, types.ErrUnknown.Wrapf("max cap limit for contract: %s", req.Contract)
+	}
+	height := req.Height
+	if height == 0 {
+		height = uint64(ctx.BlockHeight()) + m.k.GetContractEpochLength(ctx, contract)
+	}
+	tp := types.SchedulerTaskType(req.TaskType)
+	if req.Repeat {
+		err = m.k.ScheduleRepeatingTask(ctx, tp, contract, height)
+	} else {
+		err = m.k.ScheduleOneShotTask(ctx, tp, contract, height)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgScheduleTaskResponse{}, nil
+}
+
+// RescheduleTask moves a scheduled task of a virtual staking contract to a new height
+func (m msgServer) RescheduleTask(goCtx context.Context, req *types.MsgRescheduleTask) (*types.MsgRescheduleTaskResponse, error) {
+	ctx, contract, err := m.authorizeSchedulerMsg(goCtx, req, req.Authority, req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.k.RescheduleTask(ctx, types.SchedulerTaskType(req.TaskType), contract, req.Height, req.NewHeight); err != nil {
+		return nil, err
+	}
+	return &types.MsgRescheduleTaskResponse{}, nil
+}
+
+// PauseScheduledTasks pauses the execution of the scheduled tasks of a virtual staking contract
+func (m msgServer) PauseScheduledTasks(goCtx context.Context, req *types.MsgPauseScheduledTasks) (*types.MsgPauseScheduledTasksResponse, error) {
+	ctx, contract, err := m.authorizeSchedulerMsg(goCtx, req, req.Authority, req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.k.PauseScheduledTasks(ctx, types.SchedulerTaskType(req.TaskType), contract); err != nil {
+		return nil, err
+	}
+	return &types.MsgPauseScheduledTasksResponse{}, nil
+}
+
+// ResumeScheduledTasks resumes the execution of paused tasks of a virtual staking contract
+func (m msgServer) ResumeScheduledTasks(goCtx context.Context, req *types.MsgResumeScheduledTasks) (*types.MsgResumeScheduledTasksResponse, error) {
+	ctx, contract, err := m.authorizeSchedulerMsg(goCtx, req, req.Authority, req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.k.ResumeScheduledTasks(ctx, types.SchedulerTaskType(req.TaskType), contract); err != nil {
+		return nil, err
+	}
+	return &types.MsgResumeScheduledTasksResponse{}, nil
+}
+
+// DeleteScheduledTasks deletes a single or all scheduled tasks of a type for a virtual staking contract
+func (m msgServer) DeleteScheduledTasks(goCtx context.Context, req *types.MsgDeleteScheduledTasks) (*types.MsgDeleteScheduledTasksResponse, error) {
+	ctx, contract, err := m.authorizeSchedulerMsg(goCtx, req, req.Authority, req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	tp := types.SchedulerTaskType(req.TaskType)
+	if req.Height == 0 {
+		if err := m.k.DeleteAllScheduledTasks(ctx, tp, contract); err != nil {
+			return nil, err
+		}
+		return &types.MsgDeleteScheduledTasksResponse{}, nil
+	}
+	if !m.k.hasScheduledTaskAt(ctx, tp, contract, req.Height) {
+		return nil, types.ErrUnknown.Wrapf("task at height %d for contract %s", req.Height, req.Contract)
+	}
+	if err := m.k.deleteScheduledTask(ctx, tp, contract, req.Height); err != nil {
+		return nil, err
+	}
+	return &types.MsgDeleteScheduledTasksResponse{}, nil
+}
+
+// DeleteDeadLetterTasks deletes the dead letter tasks of a type for a virtual staking contract. When requested,
+// a one-shot task of the type is scheduled for the current block to execute the failed task again.
+func (m msgServer) DeleteDeadLetterTasks(goCtx context.Context, req *types.MsgDeleteDeadLetterTasks) (*types.MsgDeleteDeadLetterTasksResponse, error) {
+	ctx, contract, err := m.authorizeSchedulerMsg(goCtx, req, req.Authority, req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	tp := types.SchedulerTaskType(req.TaskType)
+	if m.k.DeleteDeadLetterTasks(ctx, tp, contract) == 0 {
+		return nil, types.ErrUnknown.Wrapf("dead letter tasks for contract %s", req.Contract)
+	}
+	if req.Reschedule {
+		if err := m.k.ScheduleOneShotTask(ctx, tp, contract, uint64(ctx.BlockHeight())); err != nil {
+			return nil, errorsmod.Wrap(err, "reschedule")
+		}
+	}
+	return &types.MsgDeleteDeadLetterTasksResponse{}, nil
+}
+
+// UpdateParams updates the module params
+func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if authority := m.k.GetAuthority(); authority != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+	if err := m.k.UpdateParams(sdk.UnwrapSDKContext(goCtx), req.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RemoveVirtualStakingContract unbonds all virtual stake of a contract and removes the contract from the module state
+func (m msgServer) RemoveVirtualStakingContract(goCtx context.Context, req *types.MsgRemoveVirtualStakingContract) (*types.MsgRemoveVirtualStakingContractResponse, error) {
+	ctx, contract, err := m.authorizeSchedulerMsg(goCtx, req, req.Authority, req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.k.RemoveVirtualStakingContract(ctx, contract); err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveVirtualStakingContractResponse{}, nil
+}
+
+// MigrateVirtualStakingContract moves the virtual staking setup of a contract to a new contract address
+func (m msgServer) MigrateVirtualStakingContract(goCtx context.Context, req *types.MsgMigrateVirtualStakingContract) (*types.MsgMigrateVirtualStakingContractResponse, error) {
+	ctx, contract, err := m.authorizeSchedulerMsg(goCtx, req, req.Authority, req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	newContract, err := sdk.AccAddressFromBech32(req.NewContract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "new contract")
+	}
+	if err := m.k.MigrateVirtualStakingContract(ctx, contract, newContract); err != nil {
+		return nil, err
+	}
+	return &types.MsgMigrateVirtualStakingContractResponse{}, nil
+}
+
+// authorizeSchedulerMsg validates the message and authority and returns the unwrapped context and contract address
+func (m msgServer) authorizeSchedulerMsg(goCtx context.Context, msg sdk.Msg, authority, contract string) (sdk.Context, sdk.AccAddress, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return sdk.Context{}, nil, err
+	}
+	if exp := m.k.GetAuthority(); exp != authority {
+		return sdk.Context{}, nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", exp, authority)
+	}
+	acc, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return sdk.Context{}, nil, errorsmod.Wrap(err, "contract")
+	}
+	return sdk.UnwrapSDKContext(goCtx), acc, nil
 }
@@ -7,7 +7,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
 )
@@ -41,6 +44,24 @@ func TestSetVirtualStakingMaxCap(t *testing.T) {
 			expLimit: myAmount,
 			expSchedule: func(t *testing.T, ctx sdk.Context) {
 				assert.True(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, myContract, true))
+				// and the contract receives the full validator set
+				assert.True(t, k.hasScheduledTaskAt(ctx, types.SchedulerTaskValsetSnapshot, myContract, uint64(ctx.BlockHeight())))
+				assert.False(t, k.HasScheduledTask(ctx, types.SchedulerTaskMaxCapEnforcement, myContract, false))
+			},
+		},
+		"valset snapshots disabled on registration": {
+			setup: func(ctx sdk.Context) {
+				require.NoError(t, k.SetContractConfig(ctx, myContract, types.ContractConfig{DisableValsetSnapshots: true}))
+			},
+			src: types.MsgSetVirtualStakingMaxCap{
+				Authority: k.GetAuthority(),
+				Contract:  myContract.String(),
+				MaxCap:    myAmount,
+			},
+			expLimit: myAmount,
+			expSchedule: func(t *testing.T, ctx sdk.Context) {
+				assert.True(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, myContract, true))
+				assert.False(t, k.HasScheduledTask(ctx, types.SchedulerTaskValsetSnapshot, myContract, false))
 			},
 		},
 		"existing limit updated": {
@@ -87,6 +108,33 @@ func TestSetVirtualStakingMaxCap(t *testing.T) {
 				assert.False(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, myContract, true))
 			},
 		},
+		"max cap enforcement scheduled when lowered below delegated amount": {
+			setup: func(ctx sdk.Context) {
+				_, err := m.SetVirtualStakingMaxCap(sdk.WrapSDKContext(ctx), &types.MsgSetVirtualStakingMaxCap{
+					Authority: k.GetAuthority(),
+					Contract:  myContract.String(),
+					MaxCap:    sdk.NewInt64Coin(denom, 456),
+				})
+				require.NoError(t, err)
+				keepers.Faucet.Fund(ctx, keepers.AccountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName), sdk.NewInt64Coin(denom, 1_000_000))
+				vals := addBondedValidators(t, ctx, keepers.StakingKeeper, math.NewInt(1_000_000))
+				_, err = k.Delegate(ctx, myContract, vals[0].GetOperator(), sdk.NewInt64Coin(denom, 456))
+				require.NoError(t, err)
+			},
+			src: types.MsgSetVirtualStakingMaxCap{
+				Authority: k.GetAuthority(),
+				Contract:  myContract.String(),
+				MaxCap:    myAmount,
+			},
+			expLimit: myAmount,
+			expSchedule: func(t *testing.T, ctx sdk.Context) {
+				assert.True(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, myContract, true))
+				gracePeriod := k.GetMaxCapGracePeriod(ctx)
+				repeat, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskMaxCapEnforcement, myContract, uint64(ctx.BlockHeight())+gracePeriod)
+				require.True(t, exists)
+				assert.False(t, repeat)
+			},
+		},
 		"fails for non existing contract": {
 			setup: func(ctx sdk.Context) {},
 			src: types.MsgSetVirtualStakingMaxCap{
@@ -133,3 +181,660 @@ func TestSetVirtualStakingMaxCap(t *testing.T) {
 		})
 	}
 }
+
+func TestSetVirtualStakingMaxCaps(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	var (
+		myContract      = sdk.AccAddress(rand.Bytes(32))
+		myOtherContract = sdk.AccAddress(rand.Bytes(32))
+		myNewContract   = sdk.AccAddress(rand.Bytes(32))
+		denom           = keepers.StakingKeeper.BondDenom(pCtx)
+		totalMaxCap     = k.GetTotalContractsMaxCap(pCtx).Amount.Int64()
+	)
+	k.wasm = MockWasmKeeper{HasContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+		return !contractAddress.Equals(sdk.AccAddress(make([]byte, 32)))
+	}}
+	m := NewMsgServer(k)
+	// existing contracts use the total max cap
+	for _, c := range []sdk.AccAddress{myContract, myOtherContract} {
+		_, err := m.SetVirtualStakingMaxCap(sdk.WrapSDKContext(pCtx), &types.MsgSetVirtualStakingMaxCap{
+			Authority: k.GetAuthority(),
+			Contract:  c.String(),
+			MaxCap:    sdk.NewInt64Coin(denom, totalMaxCap/2),
+		})
+		require.NoError(t, err)
+	}
+	maxCap := func(contract sdk.AccAddress, amount int64) types.VirtualStakingMaxCap {
+		return types.VirtualStakingMaxCap{Contract: contract.String(), MaxCap: sdk.NewInt64Coin(denom, amount)}
+	}
+
+	specs := map[string]struct {
+		src         types.MsgSetVirtualStakingMaxCaps
+		expErr      bool
+		expLimits   map[string]int64
+		expSchedule func(t *testing.T, ctx sdk.Context)
+	}{
+		"limits rebalanced within total": {
+			src: types.MsgSetVirtualStakingMaxCaps{
+				Authority: k.GetAuthority(),
+				MaxCaps: []types.VirtualStakingMaxCap{
+					maxCap(myContract, totalMaxCap/2+1),
+					maxCap(myOtherContract, totalMaxCap/2-1),
+				},
+			},
+			expLimits: map[string]int64{myContract.String(): totalMaxCap/2 + 1, myOtherContract.String(): totalMaxCap/2 - 1},
+			expSchedule: func(t *testing.T, ctx sdk.Context) {
+				for _, c := range []sdk.AccAddress{myContract, myOtherContract} {
+					repeat, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, c, uint64(ctx.BlockHeight()))
+					require.True(t, exists)
+					assert.False(t, repeat)
+					assert.True(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, c, true))
+				}
+			},
+		},
+		"new contract registered and existing limit removed": {
+			src: types.MsgSetVirtualStakingMaxCaps{
+				Authority: k.GetAuthority(),
+				MaxCaps: []types.VirtualStakingMaxCap{
+					maxCap(myNewContract, totalMaxCap/2),
+					maxCap(myOtherContract, 0),
+				},
+			},
+			expLimits: map[string]int64{myContract.String(): totalMaxCap / 2, myOtherContract.String(): 0, myNewContract.String(): totalMaxCap / 2},
+			expSchedule: func(t *testing.T, ctx sdk.Context) {
+				assert.True(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, myNewContract, true))
+				assert.False(t, k.hasScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, myNewContract, uint64(ctx.BlockHeight())))
+
+				repeat, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, myOtherContract, uint64(ctx.BlockHeight()))
+				require.True(t, exists)
+				assert.False(t, repeat)
+				assert.False(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, myOtherContract, true))
+			},
+		},
+		"total max cap exceeded": {
+			src: types.MsgSetVirtualStakingMaxCaps{
+				Authority: k.GetAuthority(),
+				MaxCaps: []types.VirtualStakingMaxCap{
+					maxCap(myNewContract, 1),
+					maxCap(myOtherContract, totalMaxCap/2),
+				},
+			},
+			expErr: true,
+		},
+		"invalid denom rejected": {
+			src: types.MsgSetVirtualStakingMaxCaps{
+				Authority: k.GetAuthority(),
+				MaxCaps:   []types.VirtualStakingMaxCap{{Contract: myContract.String(), MaxCap: sdk.NewInt64Coin("other", 1)}},
+			},
+			expErr: true,
+		},
+		"fails for non existing contract": {
+			src: types.MsgSetVirtualStakingMaxCaps{
+				Authority: k.GetAuthority(),
+				MaxCaps: []types.VirtualStakingMaxCap{
+					maxCap(myContract, 1),
+					maxCap(sdk.AccAddress(make([]byte, 32)), 1),
+				},
+			},
+			expErr: true,
+		},
+		"unauthorized rejected": {
+			src: types.MsgSetVirtualStakingMaxCaps{
+				Authority: myContract.String(),
+				MaxCaps:   []types.VirtualStakingMaxCap{maxCap(myContract, 1)},
+			},
+			expErr: true,
+		},
+		"invalid data rejected": {
+			src:    types.MsgSetVirtualStakingMaxCaps{Authority: k.GetAuthority()},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+
+			// when
+			gotRsp, gotErr := m.SetVirtualStakingMaxCaps(sdk.WrapSDKContext(ctx), &spec.src)
+
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.NotNil(t, gotRsp)
+			gotLimits := make(map[string]int64)
+			k.IterateMaxCapLimit(ctx, func(contractAddr sdk.AccAddress, m math.Int) bool {
+				gotLimits[contractAddr.String()] = m.Int64()
+				return false
+			})
+			assert.Equal(t, spec.expLimits, gotLimits)
+			// and scheduled
+			spec.expSchedule(t, ctx)
+		})
+	}
+}
+
+func TestSchedulerMsgs(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	myOtherContract := sdk.AccAddress(rand.Bytes(32))
+	k.wasm = MockWasmKeeper{HasContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+		return contractAddress.Equals(myContract) || contractAddress.Equals(myOtherContract)
+	}}
+	require.NoError(t, k.SetMaxCapLimit(pCtx, myContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+	m := NewMsgServer(k)
+	authority := k.GetAuthority()
+	currentHeight := uint64(pCtx.BlockHeight())
+	epochLength := k.GetRebalanceEpochLength(pCtx)
+	require.NoError(t, k.ScheduleRepeatingTask(pCtx, types.SchedulerTaskHandleEpoch, myContract, currentHeight+1))
+	require.NoError(t, k.ScheduleOneShotTask(pCtx, types.SchedulerTaskHandleEpoch, myContract, currentHeight+2))
+
+	specs := map[string]struct {
+		exec    func(ctx sdk.Context) error
+		expErr  bool
+		asserts func(t *testing.T, ctx sdk.Context)
+	}{
+		"schedule task": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.ScheduleTask(sdk.WrapSDKContext(ctx), &types.MsgScheduleTask{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskValsetUpdate, Height: currentHeight + 5,
+				})
+				return err
+			},
+			asserts: func(t *testing.T, ctx sdk.Context) {
+				repeat, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskValsetUpdate, myContract, currentHeight+5)
+				assert.True(t, exists)
+				assert.False(t, repeat)
+			},
+		},
+		"schedule repeating task for next epoch": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.ScheduleTask(sdk.WrapSDKContext(ctx), &types.MsgScheduleTask{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch, Repeat: true,
+				})
+				return err
+			},
+			asserts: func(t *testing.T, ctx sdk.Context) {
+				repeat, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, myContract, currentHeight+epochLength)
+				assert.True(t, exists)
+				assert.True(t, repeat)
+			},
+		},
+		"schedule task for unknown contract": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.ScheduleTask(sdk.WrapSDKContext(ctx), &types.MsgScheduleTask{
+					Authority: authority, Contract: sdk.AccAddress(rand.Bytes(32)).String(), TaskType: types.SchedulerTaskHandleEpoch,
+				})
+				return err
+			},
+			expErr: true,
+		},
+		"schedule task for contract without max cap": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.ScheduleTask(sdk.WrapSDKContext(ctx), &types.MsgScheduleTask{
+					Authority: authority, Contract: myOtherContract.String(), TaskType: types.SchedulerTaskHandleEpoch,
+				})
+				return err
+			},
+			expErr: true,
+		},
+		"schedule task with invalid type": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.ScheduleTask(sdk.WrapSDKContext(ctx), &types.MsgScheduleTask{
+					Authority: authority, Contract: myContract.String(), TaskType: 0xff,
+				})
+				return err
+			},
+			expErr: true,
+		},
+		"schedule task unauthorized": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.ScheduleTask(sdk.WrapSDKContext(ctx), &types.MsgScheduleTask{
+					Authority: myContract.String(), Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch,
+				})
+				return err
+			},
+			expErr: true,
+		},
+		"reschedule task": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.RescheduleTask(sdk.WrapSDKContext(ctx), &types.MsgRescheduleTask{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch, Height: currentHeight + 1, NewHeight: currentHeight + 7,
+				})
+				return err
+			},
+			asserts: func(t *testing.T, ctx sdk.Context) {
+				repeat, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, myContract, currentHeight+7)
+				assert.True(t, exists)
+				assert.True(t, repeat)
+			},
+		},
+		"reschedule task unauthorized": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.RescheduleTask(sdk.WrapSDKContext(ctx), &types.MsgRescheduleTask{
+					Authority: myContract.String(), Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch, Height: currentHeight + 1, NewHeight: currentHeight + 7,
+				})
+				return err
+			},
+			expErr: true,
+		},
+		"pause tasks": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.PauseScheduledTasks(sdk.WrapSDKContext(ctx), &types.MsgPauseScheduledTasks{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch,
+				})
+				return err
+			},
+			asserts: func(t *testing.T, ctx sdk.Context) {
+				assert.True(t, k.IsScheduledTasksPaused(ctx, types.SchedulerTaskHandleEpoch, myContract))
+			},
+		},
+		"pause tasks unauthorized": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.PauseScheduledTasks(sdk.WrapSDKContext(ctx), &types.MsgPauseScheduledTasks{
+					Authority: myContract.String(), Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch,
+				})
+				return err
+			},
+			expErr: true,
+		},
+		"resume tasks": {
+			exec: func(ctx sdk.Context) error {
+				require.NoError(t, k.PauseScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, myContract))
+				_, err := m.ResumeScheduledTasks(sdk.WrapSDKContext(ctx), &types.MsgResumeScheduledTasks{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch,
+				})
+				return err
+			},
+			asserts: func(t *testing.T, ctx sdk.Context) {
+				assert.False(t, k.IsScheduledTasksPaused(ctx, types.SchedulerTaskHandleEpoch, myContract))
+			},
+		},
+		"resume tasks not paused": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.ResumeScheduledTasks(sdk.WrapSDKContext(ctx), &types.MsgResumeScheduledTasks{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch,
+				})
+				return err
+			},
+			expErr: true,
+		},
+		"delete single task": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.DeleteScheduledTasks(sdk.WrapSDKContext(ctx), &types.MsgDeleteScheduledTasks{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch, Height: currentHeight + 2,
+				})
+				return err
+			},
+			asserts: func(t *testing.T, ctx sdk.Context) {
+				_, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, myContract, currentHeight+2)
+				assert.False(t, exists)
+				assert.True(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, myContract, true))
+			},
+		},
+		"delete dead letter tasks": {
+			exec: func(ctx sdk.Context) error {
+				_, err := k.handleFailedTask(ctx, types.FailedTask{Type: types.SchedulerTaskValsetUpdate, Contract: myContract.String(), Attempts: k.GetMaxRetryAttempts(ctx)}, types.ErrUnknown)
+				require.NoError(t, err)
+				_, err = m.DeleteDeadLetterTasks(sdk.WrapSDKContext(ctx), &types.MsgDeleteDeadLetterTasks{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskValsetUpdate,
+				})
+				return err
+			},
+			asserts: func(t *testing.T, ctx sdk.Context) {
+				k.IterateDeadLetterTasks(ctx, func(task types.FailedTask) bool {
+					t.Fatalf("unexpected dead letter task: %d", task.ID)
+					return true
+				})
+				assert.False(t, k.HasScheduledTask(ctx, types.SchedulerTaskValsetUpdate, myContract, false))
+			},
+		},
+		"delete and reschedule dead letter tasks": {
+			exec: func(ctx sdk.Context) error {
+				_, err := k.handleFailedTask(ctx, types.FailedTask{Type: types.SchedulerTaskValsetUpdate, Contract: myContract.String(), Attempts: k.GetMaxRetryAttempts(ctx)}, types.ErrUnknown)
+				require.NoError(t, err)
+				_, err = m.DeleteDeadLetterTasks(sdk.WrapSDKContext(ctx), &types.MsgDeleteDeadLetterTasks{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskValsetUpdate, Reschedule: true,
+				})
+				return err
+			},
+			asserts: func(t *testing.T, ctx sdk.Context) {
+				repeat, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskValsetUpdate, myContract, currentHeight)
+				assert.True(t, exists)
+				assert.False(t, repeat)
+			},
+		},
+		"delete unknown dead letter tasks": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.DeleteDeadLetterTasks(sdk.WrapSDKContext(ctx), &types.MsgDeleteDeadLetterTasks{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskValsetUpdate,
+				})
+				return err
+			},
+			expErr: true,
+		},
+		"delete dead letter tasks unauthorized": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.DeleteDeadLetterTasks(sdk.WrapSDKContext(ctx), &types.MsgDeleteDeadLetterTasks{
+					Authority: myContract.String(), Contract: myContract.String(), TaskType: types.SchedulerTaskValsetUpdate,
+				})
+				return err
+			},
+			expErr: true,
+		},
+		"delete unknown task": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.DeleteScheduledTasks(sdk.WrapSDKContext(ctx), &types.MsgDeleteScheduledTasks{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch, Height: currentHeight + 3,
+				})
+				return err
+			},
+			expErr: true,
+		},
+		"delete all tasks": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.DeleteScheduledTasks(sdk.WrapSDKContext(ctx), &types.MsgDeleteScheduledTasks{
+					Authority: authority, Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch,
+				})
+				return err
+			},
+			asserts: func(t *testing.T, ctx sdk.Context) {
+				_, found := k.GetNextScheduledTaskHeight(ctx, types.SchedulerTaskHandleEpoch, myContract)
+				assert.False(t, found)
+			},
+		},
+		"delete tasks unauthorized": {
+			exec: func(ctx sdk.Context) error {
+				_, err := m.DeleteScheduledTasks(sdk.WrapSDKContext(ctx), &types.MsgDeleteScheduledTasks{
+					Authority: myContract.String(), Contract: myContract.String(), TaskType: types.SchedulerTaskHandleEpoch,
+				})
+				return err
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			gotErr := spec.exec(ctx)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			spec.asserts(t, ctx)
+		})
+	}
+}
+
+func TestSetContractConfig(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	denom := keepers.StakingKeeper.BondDenom(pCtx)
+	require.NoError(t, k.SetMaxCapLimit(pCtx, myContract, sdk.NewInt64Coin(denom, 123)))
+	m := NewMsgServer(k)
+	authority := k.GetAuthority()
+	myConfig := types.ContractConfig{EpochLength: 10, MaxGasEndBlocker: 1_000}
+
+	specs := map[string]struct {
+		src         types.MsgSetContractConfig
+		prevConfig  types.ContractConfig
+		expErr      bool
+		expConfig   types.ContractConfig
+		expSnapshot bool
+	}{
+		"set config": {
+			src:       types.MsgSetContractConfig{Authority: authority, Contract: myContract.String(), Config: myConfig},
+			expConfig: myConfig,
+		},
+		"enable valset snapshots": {
+			src:         types.MsgSetContractConfig{Authority: authority, Contract: myContract.String(), Config: myConfig},
+			prevConfig:  types.ContractConfig{DisableValsetSnapshots: true},
+			expConfig:   myConfig,
+			expSnapshot: true,
+		},
+		"disable valset snapshots": {
+			src:       types.MsgSetContractConfig{Authority: authority, Contract: myContract.String(), Config: types.ContractConfig{DisableValsetSnapshots: true}},
+			expConfig: types.ContractConfig{DisableValsetSnapshots: true},
+		},
+		"empty config": {
+			src: types.MsgSetContractConfig{Authority: authority, Contract: myContract.String()},
+		},
+		"contract without max cap": {
+			src:    types.MsgSetContractConfig{Authority: authority, Contract: sdk.AccAddress(rand.Bytes(32)).String(), Config: myConfig},
+			expErr: true,
+		},
+		"unauthorized": {
+			src:    types.MsgSetContractConfig{Authority: myContract.String(), Contract: myContract.String(), Config: myConfig},
+			expErr: true,
+		},
+		"invalid contract": {
+			src:    types.MsgSetContractConfig{Authority: authority, Contract: "invalid", Config: myConfig},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			prevConfig := types.ContractConfig{EpochLength: 1}
+			if spec.prevConfig != (types.ContractConfig{}) {
+				prevConfig = spec.prevConfig
+			}
+			require.NoError(t, k.SetContractConfig(ctx, myContract, prevConfig))
+			_, gotErr := m.SetContractConfig(sdk.WrapSDKContext(ctx), &spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expConfig, k.GetContractConfig(ctx, myContract))
+			assert.Equal(t, spec.expSnapshot, k.hasScheduledTaskAt(ctx, types.SchedulerTaskValsetSnapshot, myContract, uint64(ctx.BlockHeight())))
+		})
+	}
+}
+
+func TestUpdateParams(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	var (
+		myContract         = sdk.AccAddress(rand.Bytes(32))
+		myOverrideContract = sdk.AccAddress(rand.Bytes(32))
+		denom              = keepers.StakingKeeper.BondDenom(pCtx)
+		authority          = k.GetAuthority()
+		currentHeight      = uint64(pCtx.BlockHeight())
+		oldParams          = k.GetParams(pCtx)
+	)
+	require.NoError(t, k.SetMaxCapLimit(pCtx, myContract, sdk.NewInt64Coin(denom, 1_000)))
+	require.NoError(t, k.SetContractConfig(pCtx, myOverrideContract, types.ContractConfig{EpochLength: 10}))
+	// last rebalances ran 100 blocks ago
+	lastRun := currentHeight - 100
+	require.NoError(t, k.ScheduleRepeatingTask(pCtx, types.SchedulerTaskHandleEpoch, myContract, lastRun+uint64(oldParams.EpochLength)))
+	require.NoError(t, k.ScheduleRepeatingTask(pCtx, types.SchedulerTaskHandleEpoch, myOverrideContract, currentHeight+5))
+	m := NewMsgServer(k)
+
+	withParams := func(mutators ...func(p *types.Params)) types.Params {
+		p := oldParams
+		for _, m := range mutators {
+			m(&p)
+		}
+		return p
+	}
+	specs := map[string]struct {
+		src          types.MsgUpdateParams
+		expErr       bool
+		expTaskAt    uint64
+		expOverrides uint64
+	}{
+		"params updated": {
+			src:       types.MsgUpdateParams{Authority: authority, Params: withParams(func(p *types.Params) { p.MaxRetryAttempts = 7 })},
+			expTaskAt: lastRun + uint64(oldParams.EpochLength),
+		},
+		"longer epoch length": {
+			src:       types.MsgUpdateParams{Authority: authority, Params: withParams(func(p *types.Params) { p.EpochLength += 50 })},
+			expTaskAt: lastRun + uint64(oldParams.EpochLength) + 50,
+		},
+		"shorter epoch length": {
+			src:       types.MsgUpdateParams{Authority: authority, Params: withParams(func(p *types.Params) { p.EpochLength = 150 })},
+			expTaskAt: lastRun + 150,
+		},
+		"shorter epoch length - next run passed already": {
+			src:       types.MsgUpdateParams{Authority: authority, Params: withParams(func(p *types.Params) { p.EpochLength = 50 })},
+			expTaskAt: currentHeight,
+		},
+		"invalid params": {
+			src:    types.MsgUpdateParams{Authority: authority, Params: withParams(func(p *types.Params) { p.MaxGasEndBlocker = 0 })},
+			expErr: true,
+		},
+		"non bond denom": {
+			src:    types.MsgUpdateParams{Authority: authority, Params: withParams(func(p *types.Params) { p.TotalContractsMaxCap = sdk.NewInt64Coin("ALX", 1_000_000) })},
+			expErr: true,
+		},
+		"total max cap below sum of contract max caps": {
+			src:    types.MsgUpdateParams{Authority: authority, Params: withParams(func(p *types.Params) { p.TotalContractsMaxCap = sdk.NewInt64Coin(denom, 999) })},
+			expErr: true,
+		},
+		"unauthorized": {
+			src:    types.MsgUpdateParams{Authority: myContract.String(), Params: oldParams},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			_, gotErr := m.UpdateParams(sdk.WrapSDKContext(ctx), &spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Equal(t, oldParams, k.GetParams(ctx))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.src.Params, k.GetParams(ctx))
+			// and the regular rebalance task is rescheduled
+			repeat, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, myContract, spec.expTaskAt)
+			assert.True(t, exists)
+			assert.True(t, repeat)
+			// and contracts with an epoch length override are not affected
+			assert.True(t, k.hasScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, myOverrideContract, currentHeight+5))
+		})
+	}
+}
+
+func TestRemoveVirtualStakingContract(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	denom := keepers.StakingKeeper.BondDenom(pCtx)
+	k.wasm = MockWasmKeeper{
+		HasContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) bool { return true },
+		SudoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+			return nil, nil
+		},
+	}
+	m := NewMsgServer(k)
+	_, err := m.SetVirtualStakingMaxCap(sdk.WrapSDKContext(pCtx), &types.MsgSetVirtualStakingMaxCap{
+		Authority: k.GetAuthority(),
+		Contract:  myContract.String(),
+		MaxCap:    sdk.NewInt64Coin(denom, 123),
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		src    types.MsgRemoveVirtualStakingContract
+		expErr bool
+	}{
+		"contract removed": {
+			src: types.MsgRemoveVirtualStakingContract{Authority: k.GetAuthority(), Contract: myContract.String()},
+		},
+		"unknown contract": {
+			src:    types.MsgRemoveVirtualStakingContract{Authority: k.GetAuthority(), Contract: sdk.AccAddress(rand.Bytes(32)).String()},
+			expErr: true,
+		},
+		"unauthorized": {
+			src:    types.MsgRemoveVirtualStakingContract{Authority: myContract.String(), Contract: myContract.String()},
+			expErr: true,
+		},
+		"invalid data rejected": {
+			src:    types.MsgRemoveVirtualStakingContract{Authority: k.GetAuthority()},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			// when
+			gotRsp, gotErr := m.RemoveVirtualStakingContract(sdk.WrapSDKContext(ctx), &spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.True(t, k.HasMaxCapLimit(ctx, myContract))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.NotNil(t, gotRsp)
+			assert.False(t, k.HasMaxCapLimit(ctx, myContract))
+			assert.False(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, myContract, true))
+		})
+	}
+}
+
+func TestMigrateVirtualStakingContract(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	newContract := sdk.AccAddress(rand.Bytes(32))
+	denom := keepers.StakingKeeper.BondDenom(pCtx)
+	k.wasm = MockWasmKeeper{HasContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) bool { return true }}
+	m := NewMsgServer(k)
+	_, err := m.SetVirtualStakingMaxCap(sdk.WrapSDKContext(pCtx), &types.MsgSetVirtualStakingMaxCap{
+		Authority: k.GetAuthority(),
+		Contract:  myContract.String(),
+		MaxCap:    sdk.NewInt64Coin(denom, 123),
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		src    types.MsgMigrateVirtualStakingContract
+		expErr bool
+	}{
+		"contract migrated": {
+			src: types.MsgMigrateVirtualStakingContract{Authority: k.GetAuthority(), Contract: myContract.String(), NewContract: newContract.String()},
+		},
+		"unknown contract": {
+			src:    types.MsgMigrateVirtualStakingContract{Authority: k.GetAuthority(), Contract: sdk.AccAddress(rand.Bytes(32)).String(), NewContract: newContract.String()},
+			expErr: true,
+		},
+		"unauthorized": {
+			src:    types.MsgMigrateVirtualStakingContract{Authority: myContract.String(), Contract: myContract.String(), NewContract: newContract.String()},
+			expErr: true,
+		},
+		"invalid data rejected": {
+			src:    types.MsgMigrateVirtualStakingContract{Authority: k.GetAuthority(), Contract: myContract.String()},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			// when
+			gotRsp, gotErr := m.MigrateVirtualStakingContract(sdk.WrapSDKContext(ctx), &spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.True(t, k.HasMaxCapLimit(ctx, myContract))
+				assert.False(t, k.HasMaxCapLimit(ctx, newContract))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.NotNil(t, gotRsp)
+			assert.False(t, k.HasMaxCapLimit(ctx, myContract))
+			assert.Equal(t, sdk.NewInt64Coin(denom, 123), k.GetMaxCapLimit(ctx, newContract))
+			assert.False(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, myContract, true))
+			assert.True(t, k.HasScheduledTask(ctx, types.SchedulerTaskHandleEpoch, newContract, true))
+		})
+	}
+}
@@ -15,3 +15,19 @@ func WithWasmKeeperDecorated(cb func(types.WasmKeeper) types.WasmKeeper) Option
 		keeper.wasm = cb(keeper.wasm)
 	})
 }
+
+// WithSlashingKeeper sets the slashing keeper that is used to report tombstoned validators in the valset snapshots.
+// Without, no validator is reported as tombstoned.
+func WithSlashingKeeper(sk types.SlashingKeeper) Option {
+	return postOptsFn(func(keeper *Keeper) {
+		keeper.slashing = sk
+	})
+}
+
+// WithDistributionKeeper sets the distribution keeper that is used for the staking rewards contract query.
+// Without, the query is not supported.
+func WithDistributionKeeper(dk types.DistributionKeeper) Option {
+	return postOptsFn(func(keeper *Keeper) {
+		keeper.distribution = dk
+	})
+}
@@ -1,13 +1,18 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
 )
 
-// SetParams sets the module's parameters.
+// SetParams sets the module's parameters. Returns an error when the params are invalid.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
 	if err != nil {
@@ -18,6 +23,34 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	return nil
 }
 
+// UpdateParams sets the new module params on a live chain. The total contracts max cap must be in bond denom and
+// must not be lower than the sum of the contract max cap limits. A new epoch length applies to the regular rebalance
+// tasks scheduled already, see RescheduleRegularRebalanceTasks.
+func (k Keeper) UpdateParams(ctx sdk.Context, params types.Params) error {
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+	if bondDenom := k.Staking.BondDenom(ctx); params.TotalContractsMaxCap.Denom != bondDenom {
+		return types.ErrInvalid.Wrapf("total contracts max cap denom: expected %s, got %s", bondDenom, params.TotalContractsMaxCap.Denom)
+	}
+	total := math.ZeroInt()
+	k.IterateMaxCapLimit(ctx, func(_ sdk.AccAddress, m math.Int) bool {
+		total = total.Add(m)
+		return false
+	})
+	if total.GT(params.TotalContractsMaxCap.Amount) {
+		return types.ErrInvalid.Wrapf("total contracts max cap %s is lower than the sum of max caps %s", params.TotalContractsMaxCap.Amount, total)
+	}
+	oldEpochLength := k.GetRebalanceEpochLength(ctx)
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+	if newEpochLength := uint64(params.EpochLength); newEpochLength != oldEpochLength {
+		return k.RescheduleRegularRebalanceTasks(ctx, oldEpochLength, newEpochLength)
+	}
+	return nil
+}
+
 // GetParams gets the module's parameters.
 func (k Keeper) GetParams(clientCtx sdk.Context) (params types.Params) {
 	store := clientCtx.KVStore(k.storeKey)
@@ -34,6 +67,29 @@ func (k Keeper) GetMaxSudoGas(ctx sdk.Context) sdk.Gas {
 	return sdk.Gas(k.GetParams(ctx).MaxGasEndBlocker)
 }
 
+// GetMaxSchedulerGasPerBlock returns the total gas budget for all scheduled tasks in a block.
+// Returns 0 when no budget is set.
+func (k Keeper) GetMaxSchedulerGasPerBlock(ctx sdk.Context) sdk.Gas {
+	return sdk.Gas(k.GetParams(ctx).MaxGasSchedulerPerBlock)
+}
+
+// GetMaxRetryAttempts returns the number of retries for a failed one-shot task.
+// Returns 0 when retries are disabled.
+func (k Keeper) GetMaxRetryAttempts(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxRetryAttempts
+}
+
+// GetRetryBackoffBlocks returns the number of blocks before the first retry of a failed task
+func (k Keeper) GetRetryBackoffBlocks(ctx sdk.Context) uint64 {
+	return uint64(k.GetParams(ctx).RetryBackoffBlocks)
+}
+
+// GetMaxCapGracePeriod returns the number of blocks before a lowered max cap limit is enforced.
+// Returns 0 when the enforcement is disabled.
+func (k Keeper) GetMaxCapGracePeriod(ctx sdk.Context) uint64 {
+	return uint64(k.GetParams(ctx).MaxCapGracePeriod)
+}
+
 func (k Keeper) GetRebalanceEpochLength(ctx sdk.Context) uint64 {
 	return uint64(k.GetParams(ctx).EpochLength)
 }
@@ -41,3 +97,21 @@ func (k Keeper) GetRebalanceEpochLength(ctx sdk.Context) uint64 {
 func (k Keeper) GetTotalContractsMaxCap(ctx sdk.Context) sdk.Coin {
 	return k.GetParams(ctx).TotalContractsMaxCap
 }
+
+// GetContractMaxSudoGas returns the max gas for a sudo callback of the given contract.
+// The contract config overrides the module params when set.
+func (k Keeper) GetContractMaxSudoGas(ctx sdk.Context, contract sdk.AccAddress) sdk.Gas {
+	if c := k.GetContractConfig(ctx, contract); c.MaxGasEndBlocker != 0 {
+		return sdk.Gas(c.MaxGasEndBlocker)
+	}
+	return k.GetMaxSudoGas(ctx)
+}
+
+// GetContractEpochLength returns the rebalance epoch length of the given contract.
+// The contract config overrides the module params when set.
+func (k Keeper) GetContractEpochLength(ctx sdk.Context, contract sdk.AccAddress) uint64 {
+	if c := k.GetContractConfig(ctx, contract); c.EpochLength != 0 {
+		return uint64(c.EpochLength)
+	}
+	return k.GetRebalanceEpochLength(ctx)
+}
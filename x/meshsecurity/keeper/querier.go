@@ -7,7 +7,10 @@ import (
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
 )
@@ -30,24 +33,200 @@ func (g querier) VirtualStakingMaxCapLimit(goCtx context.Context, req *types.Que
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "contract")
 	}
-	return &types.QueryVirtualStakingMaxCapLimitResponse{Cap: g.k.GetMaxCapLimit(sdk.UnwrapSDKContext(goCtx), acc), Delegated: g.k.GetTotalDelegated(sdk.UnwrapSDKContext(goCtx), acc)}, nil
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryVirtualStakingMaxCapLimitResponse{
+		Cap:       g.k.GetMaxCapLimit(ctx, acc),
+		Delegated: g.k.GetTotalDelegated(ctx, acc),
+		Config:    g.k.GetContractConfig(ctx, acc),
+	}, nil
 }
 
-// VirtualStakingMaxCapLimits returns limit amount for all the contracts.
+// VirtualStakingMaxCapLimits returns limit amount for all the contracts. Results can be paginated and filtered
+// to contracts with a non-zero max cap or with delegations.
 func (g querier) VirtualStakingMaxCapLimits(goCtx context.Context, req *types.QueryVirtualStakingMaxCapLimitsRequest) (*types.QueryVirtualStakingMaxCapLimitsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalid.Wrap("empty request")
+	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	rsp := types.QueryVirtualStakingMaxCapLimitsResponse{}
-	g.k.IterateMaxCapLimit(ctx, func(addr sdk.AccAddress, maxCap math.Int) bool {
-		info := types.VirtualStakingMaxCapInfo{
-			Contract:  addr.String(),
-			Delegated: g.k.GetTotalDelegated(ctx, addr),
-			Cap:       sdk.NewCoin(g.k.Staking.BondDenom(ctx), maxCap),
+	bondDenom := g.k.Staking.BondDenom(ctx)
+	rsp := types.QueryVirtualStakingMaxCapLimitsResponse{MaxCapInfos: make([]types.VirtualStakingMaxCapInfo, 0)}
+	prefixStore := prefix.NewStore(ctx.KVStore(g.k.storeKey), types.MaxCapLimitKeyPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var maxCap math.Int
+		if err := maxCap.Unmarshal(value); err != nil {
+			return false, err
+		}
+		if req.OnlyNonZeroCap && maxCap.IsZero() {
+			return false, nil
+		}
+		addr := sdk.AccAddress(key)
+		delegated := g.k.GetTotalDelegated(ctx, addr)
+		if req.OnlyWithDelegations && delegated.IsZero() {
+			return false, nil
 		}
+		if accumulate {
+			rsp.MaxCapInfos = append(rsp.MaxCapInfos, types.VirtualStakingMaxCapInfo{
+				Contract:  addr.String(),
+				Delegated: delegated,
+				Cap:       sdk.NewCoin(bondDenom, maxCap),
+				Config:    g.k.GetContractConfig(ctx, addr),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	rsp.Pagination = pageRes
+	return &rsp, nil
+}
 
-		rsp.MaxCapInfos = append(rsp.MaxCapInfos, info)
+// VirtualStakingDelegations returns the delegations per validator of the given contract.
+// Returns an empty list for unknown addresses
+func (g querier) VirtualStakingDelegations(goCtx context.Context, req *types.QueryVirtualStakingDelegationsRequest) (*types.QueryVirtualStakingDelegationsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalid.Wrap("empty request")
+	}
+	acc, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	bondDenom := g.k.Staking.BondDenom(ctx)
+	rsp := types.QueryVirtualStakingDelegationsResponse{Delegations: make([]types.VirtualStakingDelegation, 0)}
+	g.k.Staking.IterateDelegations(ctx, acc, func(_ int64, del stakingtypes.DelegationI) bool {
+		val, found := g.k.Staking.GetValidator(ctx, del.GetValidatorAddr())
+		if !found {
+			err = errorsmod.Wrapf(stakingtypes.ErrNoValidatorFound, "validator %s", del.GetValidatorAddr())
+			return true
+		}
+		rsp.Delegations = append(rsp.Delegations, types.VirtualStakingDelegation{
+			Validator: val.GetOperator().String(),
+			Shares:    del.GetShares(),
+			Amount:    sdk.NewCoin(bondDenom, val.TokensFromShares(del.GetShares()).TruncateInt()),
+			Status:    val.GetStatus(),
+		})
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+	return &rsp, nil
+}
+
+// ScheduledTasks returns the scheduled tasks filtered by type, contract and height range.
+// The height of a task is the next run height.
+func (g querier) ScheduledTasks(goCtx context.Context, req *types.QueryScheduledTasksRequest) (*types.QueryScheduledTasksResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalid.Wrap("empty request")
+	}
+	keyPrefix := types.SchedulerKeyPrefix
+	if req.Type != uint32(types.SchedulerTaskUndefined) {
+		var err error
+		if keyPrefix, err = types.BuildSchedulerTypeKeyPrefix(types.SchedulerTaskType(req.Type)); err != nil {
+			return nil, err
+		}
+	}
+	var contract sdk.AccAddress
+	if req.Contract != "" {
+		var err error
+		if contract, err = sdk.AccAddressFromBech32(req.Contract); err != nil {
+			return nil, errorsmod.Wrap(err, "contract")
+		}
+	}
+	if req.MaxHeight != 0 && req.MaxHeight < req.MinHeight {
+		return nil, types.ErrInvalid.Wrap("max height must not be lower than min height")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rsp := types.QueryScheduledTasksResponse{Tasks: make([]types.ScheduledTask, 0)}
+	prefixStore := prefix.NewStore(ctx.KVStore(g.k.storeKey), keyPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		tp := types.SchedulerTaskType(req.Type)
+		if tp == types.SchedulerTaskUndefined { // key starts with the task type
+			tp, key = types.SchedulerTaskType(key[0]), key[1:]
+		}
+		height, addr := sdk.BigEndianToUint64(key[0:8]), sdk.AccAddress(key[8:])
+		if height < req.MinHeight ||
+			req.MaxHeight != 0 && height > req.MaxHeight ||
+			contract != nil && !contract.Equals(addr) {
+			return false, nil
+		}
+		if accumulate {
+			rsp.Tasks = append(rsp.Tasks, types.ScheduledTask{
+				Type:     uint32(tp),
+				Contract: addr.String(),
+				Height:   height,
+				Repeat:   isRepeat(value),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	rsp.Pagination = pageRes
+	return &rsp, nil
+}
+
+// DeadLetterTasks returns the failed tasks that exceeded the max retry attempts, optionally filtered by contract.
+func (g querier) DeadLetterTasks(goCtx context.Context, req *types.QueryDeadLetterTasksRequest) (*types.QueryDeadLetterTasksResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalid.Wrap("empty request")
+	}
+	if req.Contract != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Contract); err != nil {
+			return nil, errorsmod.Wrap(err, "contract")
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rsp := types.QueryDeadLetterTasksResponse{Tasks: make([]types.FailedTask, 0)}
+	prefixStore := prefix.NewStore(ctx.KVStore(g.k.storeKey), types.DeadLetterTaskKeyPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var task types.FailedTask
+		if err := g.cdc.Unmarshal(value, &task); err != nil {
+			return false, err
+		}
+		if req.Contract != "" && req.Contract != task.Contract {
+			return false, nil
+		}
+		if accumulate {
+			rsp.Tasks = append(rsp.Tasks, task)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	rsp.Pagination = pageRes
+	return &rsp, nil
+}
 
+// ValsetOutbox returns the validator set operations that are pending for delivery to the given contract,
+// ordered by sequence. Returns an empty list for unknown addresses
+func (g querier) ValsetOutbox(goCtx context.Context, req *types.QueryValsetOutboxRequest) (*types.QueryValsetOutboxResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalid.Wrap("empty request")
+	}
+	contract, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rsp := types.QueryValsetOutboxResponse{Entries: make([]types.ValsetOutboxEntry, 0)}
+	prefixStore := prefix.NewStore(ctx.KVStore(g.k.storeKey), types.BuildValsetOutboxContractPrefix(contract))
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var entry types.ValsetOutboxEntry
+		if err := g.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		rsp.Entries = append(rsp.Entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	rsp.Pagination = pageRes
 	return &rsp, nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
 )
@@ -96,3 +97,322 @@ func TestQueryVirtualStakingMaxCapLimits(t *testing.T) {
 	assert.Equal(t, otherContract.String(), gotRsp.MaxCapInfos[1].Contract)
 	assert.Equal(t, otherAmount, gotRsp.MaxCapInfos[1].Cap)
 }
+
+func TestQueryVirtualStakingMaxCapLimitsPaginationAndFilters(t *testing.T) {
+	// setup
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	bondDenom := keepers.StakingKeeper.BondDenom(ctx)
+	var (
+		zeroCapContract    = sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+		delegatingContract = sdk.AccAddress(bytes.Repeat([]byte{2}, 32))
+		idleContract       = sdk.AccAddress(bytes.Repeat([]byte{3}, 32))
+	)
+	require.NoError(t, k.SetMaxCapLimit(ctx, zeroCapContract, sdk.NewInt64Coin(bondDenom, 0)))
+	require.NoError(t, k.SetMaxCapLimit(ctx, delegatingContract, sdk.NewInt64Coin(bondDenom, 100)))
+	k.setTotalDelegated(ctx, delegatingContract, sdk.NewInt64Coin(bondDenom, 50))
+	require.NoError(t, k.SetMaxCapLimit(ctx, idleContract, sdk.NewInt64Coin(bondDenom, 200)))
+
+	querier := NewQuerier(keepers.EncodingConfig.Marshaler, k)
+	specs := map[string]struct {
+		req          *types.QueryVirtualStakingMaxCapLimitsRequest
+		expContracts []sdk.AccAddress
+		expTotal     uint64
+		expNextKey   bool
+	}{
+		"all": {
+			req:          &types.QueryVirtualStakingMaxCapLimitsRequest{},
+			expContracts: []sdk.AccAddress{zeroCapContract, delegatingContract, idleContract},
+			expTotal:     3,
+		},
+		"first page with total": {
+			req:          &types.QueryVirtualStakingMaxCapLimitsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}},
+			expContracts: []sdk.AccAddress{zeroCapContract, delegatingContract},
+			expTotal:     3,
+			expNextKey:   true,
+		},
+		"second page": {
+			req:          &types.QueryVirtualStakingMaxCapLimitsRequest{Pagination: &query.PageRequest{Offset: 2, Limit: 2}},
+			expContracts: []sdk.AccAddress{idleContract},
+		},
+		"only non zero cap": {
+			req:          &types.QueryVirtualStakingMaxCapLimitsRequest{OnlyNonZeroCap: true},
+			expContracts: []sdk.AccAddress{delegatingContract, idleContract},
+			expTotal:     2,
+		},
+		"only with delegations": {
+			req:          &types.QueryVirtualStakingMaxCapLimitsRequest{OnlyWithDelegations: true},
+			expContracts: []sdk.AccAddress{delegatingContract},
+			expTotal:     1,
+		},
+		"filtered and paginated": {
+			req:          &types.QueryVirtualStakingMaxCapLimitsRequest{OnlyNonZeroCap: true, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}},
+			expContracts: []sdk.AccAddress{delegatingContract},
+			expTotal:     2,
+			expNextKey:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotRsp, err := querier.VirtualStakingMaxCapLimits(sdk.WrapSDKContext(ctx), spec.req)
+			// then
+			require.NoError(t, err)
+			gotContracts := make([]sdk.AccAddress, len(gotRsp.MaxCapInfos))
+			for i, v := range gotRsp.MaxCapInfos {
+				gotContracts[i] = sdk.MustAccAddressFromBech32(v.Contract)
+			}
+			assert.Equal(t, spec.expContracts, gotContracts)
+			require.NotNil(t, gotRsp.Pagination)
+			assert.Equal(t, spec.expTotal, gotRsp.Pagination.Total)
+			assert.Equal(t, spec.expNextKey, gotRsp.Pagination.NextKey != nil)
+		})
+	}
+}
+
+func TestQueryVirtualStakingDelegations(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	vAddrs := add3Validators(t, ctx, keepers.StakingKeeper)
+	require.NoError(t, k.SetMaxCapLimit(ctx, myContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000)))
+	_, err := k.Delegate(ctx, myContract, vAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, err)
+	_, err = k.Delegate(ctx, myContract, vAddrs[1], sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		addr   string
+		exp    map[string]sdk.Coin
+		expErr bool
+	}{
+		"contract with delegations": {
+			addr: myContract.String(),
+			exp: map[string]sdk.Coin{
+				vAddrs[0].String(): sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+				vAddrs[1].String(): sdk.NewInt64Coin(sdk.DefaultBondDenom, 200),
+			},
+		},
+		"unknown contract": {
+			addr: sdk.AccAddress(rand.Bytes(32)).String(),
+			exp:  map[string]sdk.Coin{},
+		},
+		"invalid address": {
+			addr:   "not-an-address",
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := NewQuerier(keepers.EncodingConfig.Marshaler, k).VirtualStakingDelegations(sdk.WrapSDKContext(ctx), &types.QueryVirtualStakingDelegationsRequest{
+				Address: spec.addr,
+			})
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			got := make(map[string]sdk.Coin, len(gotRsp.Delegations))
+			for _, d := range gotRsp.Delegations {
+				got[d.Validator] = d.Amount
+				valAddr, err := sdk.ValAddressFromBech32(d.Validator)
+				require.NoError(t, err)
+				val, found := keepers.StakingKeeper.GetValidator(ctx, valAddr)
+				require.True(t, found)
+				assert.Equal(t, val.GetStatus(), d.Status)
+				assert.True(t, d.Shares.IsPositive())
+			}
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestQueryScheduledTasks(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	var (
+		myContract    = sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+		otherContract = sdk.AccAddress(bytes.Repeat([]byte{2}, 32))
+		h             = uint64(ctx.BlockHeight())
+	)
+	require.NoError(t, k.ScheduleRepeatingTask(ctx, types.SchedulerTaskHandleEpoch, myContract, h+10))
+	require.NoError(t, k.ScheduleRepeatingTask(ctx, types.SchedulerTaskHandleEpoch, otherContract, h+20))
+	require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskValsetUpdate, myContract, h+1))
+
+	var (
+		myEpochTask    = types.ScheduledTask{Type: types.SchedulerTaskHandleEpoch, Contract: myContract.String(), Height: h + 10, Repeat: true}
+		otherEpochTask = types.ScheduledTask{Type: types.SchedulerTaskHandleEpoch, Contract: otherContract.String(), Height: h + 20, Repeat: true}
+		myValsetTask   = types.ScheduledTask{Type: types.SchedulerTaskValsetUpdate, Contract: myContract.String(), Height: h + 1}
+	)
+	specs := map[string]struct {
+		req      *types.QueryScheduledTasksRequest
+		expTasks []types.ScheduledTask
+		expErr   bool
+	}{
+		"all": {
+			req:      &types.QueryScheduledTasksRequest{},
+			expTasks: []types.ScheduledTask{myEpochTask, otherEpochTask, myValsetTask},
+		},
+		"by type": {
+			req:      &types.QueryScheduledTasksRequest{Type: types.SchedulerTaskValsetUpdate},
+			expTasks: []types.ScheduledTask{myValsetTask},
+		},
+		"by contract": {
+			req:      &types.QueryScheduledTasksRequest{Contract: myContract.String()},
+			expTasks: []types.ScheduledTask{myEpochTask, myValsetTask},
+		},
+		"by height range": {
+			req:      &types.QueryScheduledTasksRequest{MinHeight: h + 2, MaxHeight: h + 10},
+			expTasks: []types.ScheduledTask{myEpochTask},
+		},
+		"by min height only": {
+			req:      &types.QueryScheduledTasksRequest{Type: types.SchedulerTaskHandleEpoch, MinHeight: h + 11},
+			expTasks: []types.ScheduledTask{otherEpochTask},
+		},
+		"paginated": {
+			req:      &types.QueryScheduledTasksRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1}},
+			expTasks: []types.ScheduledTask{otherEpochTask},
+		},
+		"unknown contract": {
+			req:      &types.QueryScheduledTasksRequest{Contract: sdk.AccAddress(rand.Bytes(32)).String()},
+			expTasks: []types.ScheduledTask{},
+		},
+		"invalid contract": {
+			req:    &types.QueryScheduledTasksRequest{Contract: "not-an-address"},
+			expErr: true,
+		},
+		"invalid height range": {
+			req:    &types.QueryScheduledTasksRequest{MinHeight: 2, MaxHeight: 1},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := NewQuerier(keepers.EncodingConfig.Marshaler, k).ScheduledTasks(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expTasks, gotRsp.Tasks)
+		})
+	}
+}
+
+func TestQueryDeadLetterTasks(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	var (
+		myContract    = sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+		otherContract = sdk.AccAddress(bytes.Repeat([]byte{2}, 32))
+		maxAttempts   = k.GetMaxRetryAttempts(ctx)
+	)
+	for _, c := range []sdk.AccAddress{myContract, otherContract, myContract} {
+		task := types.FailedTask{Type: types.SchedulerTaskMaxCapEnforcement, Contract: c.String(), Payload: []byte(`{}`), Attempts: maxAttempts}
+		_, err := k.handleFailedTask(ctx, task, types.ErrUnknown)
+		require.NoError(t, err)
+	}
+	deadLetter := func(id uint64, contract sdk.AccAddress) types.FailedTask {
+		return types.FailedTask{
+			ID:        id,
+			Type:      types.SchedulerTaskMaxCapEnforcement,
+			Contract:  contract.String(),
+			Payload:   []byte(`{}`),
+			Attempts:  maxAttempts + 1,
+			Height:    uint64(ctx.BlockHeight()),
+			LastError: "codespace: meshsecurity, code: 4",
+		}
+	}
+	specs := map[string]struct {
+		req      *types.QueryDeadLetterTasksRequest
+		expTasks []types.FailedTask
+		expErr   bool
+	}{
+		"all": {
+			req:      &types.QueryDeadLetterTasksRequest{},
+			expTasks: []types.FailedTask{deadLetter(1, myContract), deadLetter(2, otherContract), deadLetter(3, myContract)},
+		},
+		"by contract": {
+			req:      &types.QueryDeadLetterTasksRequest{Contract: myContract.String()},
+			expTasks: []types.FailedTask{deadLetter(1, myContract), deadLetter(3, myContract)},
+		},
+		"paginated": {
+			req:      &types.QueryDeadLetterTasksRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 1}},
+			expTasks: []types.FailedTask{deadLetter(2, otherContract)},
+		},
+		"unknown contract": {
+			req:      &types.QueryDeadLetterTasksRequest{Contract: sdk.AccAddress(rand.Bytes(32)).String()},
+			expTasks: []types.FailedTask{},
+		},
+		"invalid contract": {
+			req:    &types.QueryDeadLetterTasksRequest{Contract: "not-an-address"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := NewQuerier(keepers.EncodingConfig.Marshaler, k).DeadLetterTasks(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expTasks, gotRsp.Tasks)
+		})
+	}
+}
+
+func TestQueryValsetOutbox(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	var (
+		myContract = sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+		myValAddr  = sdk.ValAddress(bytes.Repeat([]byte{2}, 20))
+	)
+	require.NoError(t, k.SetMaxCapLimit(ctx, myContract, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+	require.NoError(t, k.ScheduleJailed(ctx, myValAddr))
+	require.NoError(t, k.ScheduleTombstoned(ctx, myValAddr, nil))
+	entry := func(seq uint64, op types.PipedValsetOperation) types.ValsetOutboxEntry {
+		return types.ValsetOutboxEntry{
+			Contract:  myContract.String(),
+			Sequence:  seq,
+			Height:    ctx.BlockHeight(),
+			Time:      ctx.BlockTime().Unix(),
+			Operation: uint32(op),
+			Validator: myValAddr.String(),
+		}
+	}
+	specs := map[string]struct {
+		req        *types.QueryValsetOutboxRequest
+		expEntries []types.ValsetOutboxEntry
+		expErr     bool
+	}{
+		"all": {
+			req:        &types.QueryValsetOutboxRequest{Address: myContract.String()},
+			expEntries: []types.ValsetOutboxEntry{entry(1, types.ValidatorJailed), entry(2, types.ValidatorTombstoned)},
+		},
+		"paginated": {
+			req:        &types.QueryValsetOutboxRequest{Address: myContract.String(), Pagination: &query.PageRequest{Offset: 1, Limit: 1}},
+			expEntries: []types.ValsetOutboxEntry{entry(2, types.ValidatorTombstoned)},
+		},
+		"unknown contract": {
+			req:        &types.QueryValsetOutboxRequest{Address: sdk.AccAddress(rand.Bytes(32)).String()},
+			expEntries: []types.ValsetOutboxEntry{},
+		},
+		"invalid contract": {
+			req:    &types.QueryValsetOutboxRequest{Address: "not-an-address"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := NewQuerier(keepers.EncodingConfig.Marshaler, k).ValsetOutbox(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expEntries, gotRsp.Entries)
+		})
+	}
+}
@@ -19,6 +19,10 @@ type (
 	viewKeeper interface {
 		GetMaxCapLimit(ctx sdk.Context, actor sdk.AccAddress) sdk.Coin
 		GetTotalDelegated(ctx sdk.Context, actor sdk.AccAddress) sdk.Coin
+		ValidatorStatus(ctx sdk.Context, valAddr sdk.ValAddress) (contract.ValidatorStatus, bool, error)
+		ActiveValidators(ctx sdk.Context, startAfter sdk.ValAddress, limit uint32) ([]contract.ValidatorStatus, error)
+		ContractDelegations(ctx sdk.Context, contractAddr sdk.AccAddress) []contract.Delegation
+		ContractRewards(ctx sdk.Context, contractAddr sdk.AccAddress) (contract.RewardsResponse, error)
 	}
 	slashingKeeper interface {
 		SlashFractionDoubleSign(ctx sdk.Context) (res sdk.Dec)
@@ -83,6 +87,41 @@ func ChainedCustomQuerier(k viewKeeper, sk slashingKeeper, next wasmkeeper.WasmV
 				SlashFractionDowntime:   sk.SlashFractionDowntime(ctx).String(),
 				SlashFractionDoubleSign: sk.SlashFractionDoubleSign(ctx).String(),
 			}
+		case query.Validator != nil:
+			valAddr, err := sdk.ValAddressFromBech32(query.Validator.Address)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidAddress.Wrap(query.Validator.Address)
+			}
+			status, found, err := k.ValidatorStatus(ctx, valAddr)
+			if err != nil {
+				return nil, err
+			}
+			rsp := contract.ValidatorResponse{}
+			if found {
+				rsp.Validator = &status
+			}
+			res = rsp
+		case query.Validators != nil:
+			var startAfter sdk.ValAddress
+			if query.Validators.StartAfter != "" {
+				var err error
+				if startAfter, err = sdk.ValAddressFromBech32(query.Validators.StartAfter); err != nil {
+					return nil, sdkerrors.ErrInvalidAddress.Wrap(query.Validators.StartAfter)
+				}
+			}
+			vals, err := k.ActiveValidators(ctx, startAfter, query.Validators.Limit)
+			if err != nil {
+				return nil, err
+			}
+			res = contract.ValidatorsResponse{Validators: vals}
+		case query.Delegations != nil:
+			res = contract.DelegationsResponse{Delegations: k.ContractDelegations(ctx, caller)}
+		case query.Rewards != nil:
+			rewards, err := k.ContractRewards(ctx, caller)
+			if err != nil {
+				return nil, err
+			}
+			res = rewards
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown virtual_stake query variant"}
 		}
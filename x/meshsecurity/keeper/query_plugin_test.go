@@ -12,10 +12,20 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
 )
 
 func TestChainedCustomQuerier(t *testing.T) {
 	myContractAddr := sdk.AccAddress(rand.Bytes(32))
+	myValAddr := sdk.ValAddress(rand.Bytes(address.Len))
+	myValStatus := contract.ValidatorStatus{
+		Validator:  contract.Validator{Address: myValAddr.String(), Commission: "0.1", MaxCommission: "0.2", MaxChangeRate: "0.01"},
+		Status:     "bonded",
+		Tombstoned: true,
+		Tokens:     wasmvmtypes.NewCoin(123, "ALX"),
+	}
 	pCtx, keepers := CreateDefaultTestInput(t)
 
 	specs := map[string]struct {
@@ -46,6 +56,100 @@ func TestChainedCustomQuerier(t *testing.T) {
 			viewKeeper: keepers.MeshKeeper,
 			expData:    []byte(`{"slash_fraction_downtime":"0.010000000000000000","slash_fraction_double_sign":"0.050000000000000000"}`),
 		},
+		"validator query": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(fmt.Sprintf(`{"virtual_stake":{"validator":{"address":%q}}}`, myValAddr.String())),
+			},
+			viewKeeper: &MockViewKeeper{
+				ValidatorStatusFn: func(ctx sdk.Context, valAddr sdk.ValAddress) (contract.ValidatorStatus, bool, error) {
+					require.Equal(t, myValAddr, valAddr)
+					return myValStatus, true, nil
+				},
+			},
+			expData: []byte(fmt.Sprintf(`{"validator":{"address":%q,"commission":"0.1","max_commission":"0.2","max_change_rate":"0.01","status":"bonded","jailed":false,"tombstoned":true,"tokens":{"denom":"ALX","amount":"123"}}}`, myValAddr.String())),
+		},
+		"validator query - unknown validator": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(fmt.Sprintf(`{"virtual_stake":{"validator":{"address":%q}}}`, myValAddr.String())),
+			},
+			viewKeeper: &MockViewKeeper{
+				ValidatorStatusFn: func(ctx sdk.Context, valAddr sdk.ValAddress) (contract.ValidatorStatus, bool, error) {
+					return contract.ValidatorStatus{}, false, nil
+				},
+			},
+			expData: []byte(`{"validator":null}`),
+		},
+		"validator query - invalid address": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"virtual_stake":{"validator":{"address":"invalid"}}}`),
+			},
+			viewKeeper: &MockViewKeeper{},
+			expErr:     true,
+		},
+		"validators query": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(fmt.Sprintf(`{"virtual_stake":{"validators":{"start_after":%q,"limit":1}}}`, myValAddr.String())),
+			},
+			viewKeeper: &MockViewKeeper{
+				ActiveValidatorsFn: func(ctx sdk.Context, startAfter sdk.ValAddress, limit uint32) ([]contract.ValidatorStatus, error) {
+					require.Equal(t, myValAddr, startAfter)
+					require.Equal(t, uint32(1), limit)
+					return []contract.ValidatorStatus{myValStatus}, nil
+				},
+			},
+			expData: []byte(fmt.Sprintf(`{"validators":[{"address":%q,"commission":"0.1","max_commission":"0.2","max_change_rate":"0.01","status":"bonded","jailed":false,"tombstoned":true,"tokens":{"denom":"ALX","amount":"123"}}]}`, myValAddr.String())),
+		},
+		"validators query - first page": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"virtual_stake":{"validators":{}}}`),
+			},
+			viewKeeper: &MockViewKeeper{
+				ActiveValidatorsFn: func(ctx sdk.Context, startAfter sdk.ValAddress, limit uint32) ([]contract.ValidatorStatus, error) {
+					require.Nil(t, startAfter)
+					require.Zero(t, limit)
+					return []contract.ValidatorStatus{}, nil
+				},
+			},
+			expData: []byte(`{"validators":[]}`),
+		},
+		"delegations query": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"virtual_stake":{"delegations":{}}}`),
+			},
+			viewKeeper: &MockViewKeeper{
+				ContractDelegationsFn: func(ctx sdk.Context, contractAddr sdk.AccAddress) []contract.Delegation {
+					require.Equal(t, myContractAddr, contractAddr)
+					return []contract.Delegation{{Validator: myValAddr.String(), Amount: wasmvmtypes.NewCoin(123, "ALX"), Shares: "123.000000000000000000"}}
+				},
+			},
+			expData: []byte(fmt.Sprintf(`{"delegations":[{"validator":%q,"amount":{"denom":"ALX","amount":"123"},"shares":"123.000000000000000000"}]}`, myValAddr.String())),
+		},
+		"rewards query": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"virtual_stake":{"rewards":{}}}`),
+			},
+			viewKeeper: &MockViewKeeper{
+				ContractRewardsFn: func(ctx sdk.Context, contractAddr sdk.AccAddress) (contract.RewardsResponse, error) {
+					require.Equal(t, myContractAddr, contractAddr)
+					return contract.RewardsResponse{
+						Rewards: []contract.DelegationReward{{Validator: myValAddr.String(), Reward: wasmvmtypes.Coins{wasmvmtypes.NewCoin(1, "ALX")}}},
+						Total:   wasmvmtypes.Coins{wasmvmtypes.NewCoin(1, "ALX")},
+					}, nil
+				},
+			},
+			expData: []byte(fmt.Sprintf(`{"rewards":[{"validator":%q,"reward":[{"denom":"ALX","amount":"1"}]}],"total":[{"denom":"ALX","amount":"1"}]}`, myValAddr.String())),
+		},
+		"rewards query - failed": {
+			src: wasmvmtypes.QueryRequest{
+				Custom: []byte(`{"virtual_stake":{"rewards":{}}}`),
+			},
+			viewKeeper: &MockViewKeeper{
+				ContractRewardsFn: func(ctx sdk.Context, contractAddr sdk.AccAddress) (contract.RewardsResponse, error) {
+					return contract.RewardsResponse{}, wasmvmtypes.UnsupportedRequest{Kind: "testing"}
+				},
+			},
+			expErr: true,
+		},
 		"non custom query": {
 			src: wasmvmtypes.QueryRequest{
 				Bank: &wasmvmtypes.BankQuery{},
@@ -85,8 +189,12 @@ func TestChainedCustomQuerier(t *testing.T) {
 var _ viewKeeper = &MockViewKeeper{}
 
 type MockViewKeeper struct {
-	GetMaxCapLimitFn    func(ctx sdk.Context, actor sdk.AccAddress) sdk.Coin
-	GetTotalDelegatedFn func(ctx sdk.Context, actor sdk.AccAddress) sdk.Coin
+	GetMaxCapLimitFn      func(ctx sdk.Context, actor sdk.AccAddress) sdk.Coin
+	GetTotalDelegatedFn   func(ctx sdk.Context, actor sdk.AccAddress) sdk.Coin
+	ValidatorStatusFn     func(ctx sdk.Context, valAddr sdk.ValAddress) (contract.ValidatorStatus, bool, error)
+	ActiveValidatorsFn    func(ctx sdk.Context, startAfter sdk.ValAddress, limit uint32) ([]contract.ValidatorStatus, error)
+	ContractDelegationsFn func(ctx sdk.Context, contractAddr sdk.AccAddress) []contract.Delegation
+	ContractRewardsFn     func(ctx sdk.Context, contractAddr sdk.AccAddress) (contract.RewardsResponse, error)
 }
 
 func (m MockViewKeeper) GetMaxCapLimit(ctx sdk.Context, actor sdk.AccAddress) sdk.Coin {
@@ -102,3 +210,31 @@ func (m MockViewKeeper) GetTotalDelegated(ctx sdk.Context, actor sdk.AccAddress)
 	}
 	return m.GetTotalDelegatedFn(ctx, actor)
 }
+
+func (m MockViewKeeper) ValidatorStatus(ctx sdk.Context, valAddr sdk.ValAddress) (contract.ValidatorStatus, bool, error) {
+	if m.ValidatorStatusFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ValidatorStatusFn(ctx, valAddr)
+}
+
+func (m MockViewKeeper) ActiveValidators(ctx sdk.Context, startAfter sdk.ValAddress, limit uint32) ([]contract.ValidatorStatus, error) {
+	if m.ActiveValidatorsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ActiveValidatorsFn(ctx, startAfter, limit)
+}
+
+func (m MockViewKeeper) ContractDelegations(ctx sdk.Context, contractAddr sdk.AccAddress) []contract.Delegation {
+	if m.ContractDelegationsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ContractDelegationsFn(ctx, contractAddr)
+}
+
+func (m MockViewKeeper) ContractRewards(ctx sdk.Context, contractAddr sdk.AccAddress) (contract.RewardsResponse, error) {
+	if m.ContractRewardsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ContractRewardsFn(ctx, contractAddr)
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+// ExecRetryTasks executes the failed tasks of the given type that are due for a retry at the current height.
+// The callback is executed within the scope of a new cached store, like in ExecScheduledTasks. A task that fails
+// again is re-scheduled with a doubled backoff or moved to the dead letter store when the max retry attempts are
+// exceeded. The stored payload is updated with the one returned by the callback.
+// Retries of paused contracts are skipped and retries that do not fit into the scheduler gas budget are
+// deferred to the next block.
+func (k Keeper) ExecRetryTasks(pCtx sdk.Context, tp types.SchedulerTaskType, cb executor) ([]ExecResult, error) {
+	var due []types.FailedTask
+	k.IterateRetryTasks(pCtx, uint64(pCtx.BlockHeight()), func(task types.FailedTask) bool {
+		if types.SchedulerTaskType(task.Type) == tp {
+			due = append(due, task)
+		}
+		return false
+	})
+	var allResults []ExecResult
+	var deferring bool
+	for _, task := range due {
+		contract, err := sdk.AccAddressFromBech32(task.Contract)
+		if err != nil {
+			return allResults, errorsmod.Wrapf(err, "failed task %d", task.ID)
+		}
+		if k.IsScheduledTasksPaused(pCtx, tp, contract) {
+			continue
+		}
+		gasLimit := k.GetContractMaxSudoGas(pCtx, contract)
+		if deferring || !k.fitsSchedulerGasBudget(pCtx, gasLimit) {
+			// keep order: all following retries are deferred as well
+			deferring = true
+			types.EmitSchedulerDeferredEvent(pCtx, contract, tp, task.Height, gasLimit)
+			continue
+		}
+		cachedCtx, done := pCtx.CacheContext()
+		gasMeter := sdk.NewGasMeter(gasLimit)
+		cachedCtx = cachedCtx.WithGasMeter(gasMeter)
+		result := ExecResult{Contract: contract, GasLimit: gasLimit}
+		var payload []byte
+		err = safeExec(func() (err error) {
+			payload, err = cb(cachedCtx, contract)
+			return
+		})
+		if err != nil {
+			result.ExecErr = err
+			if payload != nil {
+				task.Payload = payload
+			}
+		} else {
+			done()
+			ModuleLogger(pCtx).
+				Info("Scheduler retry executed successfully", "gas_used", gasMeter.GasConsumed(),
+					"gas_limit", gasLimit, "contract", contract.String(), "task_type", tp, "attempts", task.Attempts)
+		}
+		result.GasUsed = gasMeter.GasConsumed()
+		k.addSchedulerBlockGas(pCtx, result.GasUsed)
+		types.EmitSchedulerExecutionEvent(pCtx, contract, err)
+
+		pCtx.KVStore(k.storeKey).Delete(types.BuildRetryTaskKey(task.Height, task.ID))
+		if err != nil {
+			nextRunHeight, err := k.handleFailedTask(pCtx, task, err)
+			result.NextRunHeight = nextRunHeight
+			if err != nil {
+				result.RescheduleErr = err
+			}
+		}
+		allResults = append(allResults, result)
+	}
+	return allResults, nil
+}
+
+// handleFailedTask stores the failed task for a retry with backoff. When the max retry attempts are exceeded,
+// the task is moved to the dead letter store instead and 0 is returned for the next run height.
+// A new failure is not stored when a retry of the same type is pending for the contract already, as the retry
+// executes the task with the state at that time. The height of the pending retry is returned instead.
+// The payload is not stored for valset tasks as their reports are rebuilt on retry.
+func (k Keeper) handleFailedTask(ctx sdk.Context, task types.FailedTask, execErr error) (uint64, error) {
+	if task.ID == 0 {
+		if pending, found := k.findRetryTask(ctx, types.SchedulerTaskType(task.Type), task.Contract); found {
+			return pending.Height, nil
+		}
+		task.ID = k.nextFailedTaskID(ctx)
+	}
+	if tp := types.SchedulerTaskType(task.Type); tp == types.SchedulerTaskValsetUpdate || tp == types.SchedulerTaskValsetSnapshot {
+		task.Payload = nil
+	}
+	task.Attempts++
+	// error messages are redacted so that only deterministic data is persisted
+	codespace, code, _ := errorsmod.ABCIInfo(execErr, false)
+	task.LastError = fmt.Sprintf("codespace: %s, code: %d", codespace, code)
+	currentHeight := uint64(ctx.BlockHeight())
+	if task.Attempts > k.GetMaxRetryAttempts(ctx) {
+		task.Height = currentHeight
+		if err := k.setFailedTask(ctx, types.BuildDeadLetterTaskKey(task.ID), task); err != nil {
+			return 0, err
+		}
+		types.EmitSchedulerDeadLetterEvent(ctx, task)
+		return 0, nil
+	}
+	task.Height = currentHeight + retryBackoff(k.GetRetryBackoffBlocks(ctx), task.Attempts)
+	if err := k.setFailedTask(ctx, types.BuildRetryTaskKey(task.Height, task.ID), task); err != nil {
+		return 0, err
+	}
+	types.EmitSchedulerRetryEvent(ctx, task)
+	return task.Height, nil
+}
+
+// IterateRetryTasks iterate over all failed tasks pending for a retry up to given block height (included)
+// Callback can return true to stop early
+func (k Keeper) IterateRetryTasks(ctx sdk.Context, maxHeight uint64, cb func(task types.FailedTask) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RetryTaskKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if sdk.BigEndianToUint64(iter.Key()[0:8]) > maxHeight {
+			return
+		}
+		var task types.FailedTask
+		k.cdc.MustUnmarshal(iter.Value(), &task)
+		if cb(task) {
+			return
+		}
+	}
+}
+
+// findRetryTask returns the pending retry of the given task type for the contract
+func (k Keeper) findRetryTask(ctx sdk.Context, tp types.SchedulerTaskType, contract string) (types.FailedTask, bool) {
+	var (
+		r     types.FailedTask
+		found bool
+	)
+	k.IterateRetryTasks(ctx, math.MaxUint64, func(task types.FailedTask) bool {
+		found = types.SchedulerTaskType(task.Type) == tp && task.Contract == contract
+		if found {
+			r = task
+		}
+		return found
+	})
+	return r, found
+}
+
+// DeleteDeadLetterTasks deletes the dead letter tasks of the given type for the contract.
+// Returns the number of tasks deleted.
+func (k Keeper) DeleteDeadLetterTasks(ctx sdk.Context, tp types.SchedulerTaskType, contract sdk.AccAddress) int {
+	var keys [][]byte
+	k.IterateDeadLetterTasks(ctx, func(task types.FailedTask) bool {
+		if types.SchedulerTaskType(task.Type) == tp && task.Contract == contract.String() {
+			keys = append(keys, types.BuildDeadLetterTaskKey(task.ID))
+		}
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return len(keys)
+}
+
+// IterateDeadLetterTasks iterate over all failed tasks that exceeded the max retry attempts
+// Callback can return true to stop early
+func (k Keeper) IterateDeadLetterTasks(ctx sdk.Context, cb func(task types.FailedTask) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeadLetterTaskKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var task types.FailedTask
+		k.cdc.MustUnmarshal(iter.Value(), &task)
+		if cb(task) {
+			return
+		}
+	}
+}
+
+// setFailedTask stores the failed task under the given key. The failed task sequence is moved forward
+// when the task id is higher.
+func (k Keeper) setFailedTask(ctx sdk.Context, storeKey []byte, task types.FailedTask) error {
+	bz, err := k.cdc.Marshal(&task)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(storeKey, bz)
+	if task.ID > k.getLastFailedTaskID(ctx) {
+		store.Set(types.FailedTaskSeqKey, sdk.Uint64ToBigEndian(task.ID))
+	}
+	return nil
+}
+
+// nextFailedTaskID returns the next unique failed task id
+func (k Keeper) nextFailedTaskID(ctx sdk.Context) uint64 {
+	id := k.getLastFailedTaskID(ctx) + 1
+	ctx.KVStore(k.storeKey).Set(types.FailedTaskSeqKey, sdk.Uint64ToBigEndian(id))
+	return id
+}
+
+// getLastFailedTaskID returns the last failed task id used or 0
+func (k Keeper) getLastFailedTaskID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.FailedTaskSeqKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// retryBackoff returns the number of blocks until the next retry. The base backoff is doubled with every
+// further attempt.
+func retryBackoff(base uint64, attempt uint32) uint64 {
+	shift := attempt - 1
+	if shift > 32 { // base is an uint32 value so that the result can not overflow
+		shift = 32
+	}
+	return base << shift
+}
@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+func TestExecScheduledTasksStoresFailedTaskForRetry(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	currentHeight := uint64(pCtx.BlockHeight())
+	myPayload := []byte(`{"valset_update":{}}`)
+
+	failing := func(payload []byte) executor {
+		return func(ctx sdk.Context, addr sdk.AccAddress) ([]byte, error) {
+			return payload, types.ErrUnknown.Wrap("testing")
+		}
+	}
+	specs := map[string]struct {
+		repeat   bool
+		exec     executor
+		expRetry bool
+	}{
+		"one-shot task with payload": {
+			exec:     failing(myPayload),
+			expRetry: true,
+		},
+		"one-shot task without payload": {
+			exec: failing(nil),
+		},
+		"repeating task": {
+			repeat: true,
+			exec:   failing(myPayload),
+		},
+		"succeeding task": {
+			exec: func(ctx sdk.Context, addr sdk.AccAddress) ([]byte, error) {
+				return myPayload, nil
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			if spec.repeat {
+				require.NoError(t, k.ScheduleRepeatingTask(ctx, types.SchedulerTaskValsetUpdate, myContract, currentHeight))
+			} else {
+				require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskValsetUpdate, myContract, currentHeight))
+			}
+			// when
+			results, err := k.ExecScheduledTasks(ctx, types.SchedulerTaskValsetUpdate, 100, spec.exec)
+			// then
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			require.NoError(t, results[0].RescheduleErr)
+			var retries []types.FailedTask
+			k.IterateRetryTasks(ctx, currentHeight+100, func(task types.FailedTask) bool {
+				retries = append(retries, task)
+				return false
+			})
+			if !spec.expRetry {
+				assert.Empty(t, retries)
+				return
+			}
+			exp := types.FailedTask{
+				ID:        1,
+				Type:      types.SchedulerTaskValsetUpdate,
+				Contract:  myContract.String(),
+				Attempts:  1,
+				Height:    currentHeight + 10,
+				LastError: "codespace: meshsecurity, code: 4",
+			}
+			assert.Equal(t, []types.FailedTask{exp}, retries)
+			assert.Equal(t, currentHeight+10, results[0].NextRunHeight)
+		})
+	}
+}
+
+func TestExecRetryTasks(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	myPayload := []byte(`{"max_cap_enforced":{}}`)
+	params := k.GetParams(ctx)
+	params.MaxRetryAttempts = 2
+	params.RetryBackoffBlocks = 5
+	require.NoError(t, k.SetParams(ctx, params))
+
+	var sudoErr error
+	var received [][]byte
+	k.wasm = MockWasmKeeper{SudoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+		require.Equal(t, myContract, contractAddress)
+		received = append(received, msg)
+		return nil, sudoErr
+	}}
+	nextPayload := myPayload
+	exec := func(ctx sdk.Context, addr sdk.AccAddress) ([]byte, error) {
+		return nextPayload, k.SendRawSudoMsg(ctx, addr, nextPayload)
+	}
+	startHeight := uint64(ctx.BlockHeight())
+	task := types.FailedTask{Type: types.SchedulerTaskMaxCapEnforcement, Contract: myContract.String(), Payload: myPayload}
+	nextRunHeight, err := k.handleFailedTask(ctx, task, types.ErrUnknown)
+	require.NoError(t, err)
+	require.Equal(t, startHeight+5, nextRunHeight)
+
+	// when executed before the retry is due
+	results, err := k.ExecRetryTasks(ctx, types.SchedulerTaskMaxCapEnforcement, exec)
+	// then nothing happens
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	// when executed for a different task type
+	ctx = ctx.WithBlockHeight(int64(startHeight) + 5)
+	results, err = k.ExecRetryTasks(ctx, types.SchedulerTaskHandleEpoch, exec)
+	// then nothing happens
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	// when executed and failing again
+	sudoErr = types.ErrInvalid
+	results, err = k.ExecRetryTasks(ctx, types.SchedulerTaskMaxCapEnforcement, exec)
+	// then the retry is re-scheduled with doubled backoff
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.ErrorIs(t, results[0].ExecErr, types.ErrInvalid)
+	assert.Equal(t, startHeight+5+10, results[0].NextRunHeight)
+	assert.Equal(t, [][]byte{myPayload}, received)
+
+	// when the max attempts are exceeded with a new payload
+	myNewPayload := []byte(`{"max_cap_enforced":{"height":1}}`)
+	nextPayload = myNewPayload
+	ctx = ctx.WithBlockHeight(int64(startHeight) + 15)
+	results, err = k.ExecRetryTasks(ctx, types.SchedulerTaskMaxCapEnforcement, exec)
+	// then the task is moved to the dead letter store
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, uint64(0), results[0].NextRunHeight)
+	var retries, deadLetters []types.FailedTask
+	k.IterateRetryTasks(ctx, startHeight+1_000, func(task types.FailedTask) bool {
+		retries = append(retries, task)
+		return false
+	})
+	assert.Empty(t, retries)
+	k.IterateDeadLetterTasks(ctx, func(task types.FailedTask) bool {
+		deadLetters = append(deadLetters, task)
+		return false
+	})
+	exp := types.FailedTask{
+		ID:        1,
+		Type:      types.SchedulerTaskMaxCapEnforcement,
+		Contract:  myContract.String(),
+		Payload:   myNewPayload,
+		Attempts:  3,
+		Height:    startHeight + 15,
+		LastError: "codespace: meshsecurity, code: 1",
+	}
+	assert.Equal(t, []types.FailedTask{exp}, deadLetters)
+
+	// and a successful retry removes the task
+	sudoErr, nextPayload = nil, myPayload
+	_, err = k.handleFailedTask(ctx, task, types.ErrUnknown)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(int64(startHeight) + 20)
+	results, err = k.ExecRetryTasks(ctx, types.SchedulerTaskMaxCapEnforcement, exec)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].ExecErr)
+	retries = nil
+	k.IterateRetryTasks(ctx, startHeight+1_000, func(task types.FailedTask) bool {
+		retries = append(retries, task)
+		return false
+	})
+	assert.Empty(t, retries)
+
+	// and retries of paused contracts are kept
+	_, err = k.handleFailedTask(ctx, task, types.ErrUnknown)
+	require.NoError(t, err)
+	require.NoError(t, k.PauseScheduledTasks(ctx, types.SchedulerTaskMaxCapEnforcement, myContract))
+	ctx = ctx.WithBlockHeight(int64(startHeight) + 25)
+	results, err = k.ExecRetryTasks(ctx, types.SchedulerTaskMaxCapEnforcement, exec)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestHandleFailedTask(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	myPayload := []byte(`{}`)
+	currentHeight := uint64(pCtx.BlockHeight())
+
+	specs := map[string]struct {
+		tp         types.SchedulerTaskType
+		expPayload []byte
+	}{
+		"max cap enforcement": {
+			tp:         types.SchedulerTaskMaxCapEnforcement,
+			expPayload: myPayload,
+		},
+		"valset update": {
+			tp: types.SchedulerTaskValsetUpdate,
+		},
+		"valset snapshot": {
+			tp: types.SchedulerTaskValsetSnapshot,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			task := types.FailedTask{Type: uint32(spec.tp), Contract: myContract.String(), Payload: myPayload}
+			// when
+			gotHeight, err := k.handleFailedTask(ctx, task, types.ErrUnknown)
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, currentHeight+10, gotHeight)
+			gotTask, found := k.findRetryTask(ctx, spec.tp, myContract.String())
+			require.True(t, found)
+			assert.Equal(t, spec.expPayload, gotTask.Payload)
+
+			// when the task fails again in a later block
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			gotHeight, err = k.handleFailedTask(ctx, task, types.ErrUnknown)
+			// then the pending retry is reused
+			require.NoError(t, err)
+			assert.Equal(t, currentHeight+10, gotHeight)
+			var retries []types.FailedTask
+			k.IterateRetryTasks(ctx, currentHeight+1_000, func(task types.FailedTask) bool {
+				retries = append(retries, task)
+				return false
+			})
+			assert.Equal(t, []types.FailedTask{gotTask}, retries)
+			// and a different contract gets its own retry
+			myOtherContract := sdk.AccAddress(rand.Bytes(32))
+			_, err = k.handleFailedTask(ctx, types.FailedTask{Type: uint32(spec.tp), Contract: myOtherContract.String(), Payload: myPayload}, types.ErrUnknown)
+			require.NoError(t, err)
+			_, found = k.findRetryTask(ctx, spec.tp, myOtherContract.String())
+			assert.True(t, found)
+		})
+	}
+}
+
+func TestDeleteDeadLetterTasks(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	myOtherContract := sdk.AccAddress(rand.Bytes(32))
+	maxAttempts := k.GetMaxRetryAttempts(ctx)
+	for _, task := range []types.FailedTask{
+		{Type: types.SchedulerTaskValsetUpdate, Contract: myContract.String(), Attempts: maxAttempts},
+		{Type: types.SchedulerTaskValsetUpdate, Contract: myContract.String(), Attempts: maxAttempts},
+		{Type: types.SchedulerTaskMaxCapEnforcement, Contract: myContract.String(), Payload: []byte(`{}`), Attempts: maxAttempts},
+		{Type: types.SchedulerTaskValsetUpdate, Contract: myOtherContract.String(), Attempts: maxAttempts},
+	} {
+		_, err := k.handleFailedTask(ctx, task, types.ErrUnknown)
+		require.NoError(t, err)
+	}
+
+	// when
+	got := k.DeleteDeadLetterTasks(ctx, types.SchedulerTaskValsetUpdate, myContract)
+	// then
+	assert.Equal(t, 2, got)
+	var remaining []string
+	k.IterateDeadLetterTasks(ctx, func(task types.FailedTask) bool {
+		remaining = append(remaining, fmt.Sprintf("%d:%s", task.Type, task.Contract))
+		return false
+	})
+	assert.ElementsMatch(t, []string{
+		fmt.Sprintf("%d:%s", types.SchedulerTaskMaxCapEnforcement, myContract),
+		fmt.Sprintf("%d:%s", types.SchedulerTaskValsetUpdate, myOtherContract),
+	}, remaining)
+	// and nothing left to delete
+	assert.Equal(t, 0, k.DeleteDeadLetterTasks(ctx, types.SchedulerTaskValsetUpdate, myContract))
+}
+
+func TestRetryBackoff(t *testing.T) {
+	specs := map[string]struct {
+		base    uint64
+		attempt uint32
+		exp     uint64
+	}{
+		"first attempt":  {base: 10, attempt: 1, exp: 10},
+		"second attempt": {base: 10, attempt: 2, exp: 20},
+		"third attempt":  {base: 10, attempt: 3, exp: 40},
+		"capped":         {base: 1, attempt: 100, exp: 1 << 32},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, retryBackoff(spec.base, spec.attempt))
+		})
+	}
+}
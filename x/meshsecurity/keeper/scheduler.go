@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/binary"
 	"math"
 
 	errorsmod "cosmossdk.io/errors"
@@ -13,16 +14,53 @@ import (
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
 )
 
-// ScheduleRegularRebalanceTask schedule a rebalance task for the given virtual staking contract using params defined epoch length
+// ScheduleRegularRebalanceTask schedule a rebalance task for the given virtual staking contract using the contract epoch length
 func (k Keeper) ScheduleRegularRebalanceTask(ctx sdk.Context, contract sdk.AccAddress) error {
 	if !k.wasm.HasContractInfo(ctx, contract) {
 		return types.ErrUnknown.Wrapf("contract: %s", contract.String())
 	}
-	epochLength := k.GetRebalanceEpochLength(ctx)
+	epochLength := k.GetContractEpochLength(ctx, contract)
 	nextExecBlock := uint64(ctx.BlockHeight()) + epochLength
 	return k.ScheduleRepeatingTask(ctx, types.SchedulerTaskHandleEpoch, contract, nextExecBlock)
 }
 
+// RescheduleRegularRebalanceTasks moves the repeating rebalance tasks of the contracts without an epoch length
+// override to the height of the last run plus the new epoch length. Tasks are not moved to a past height.
+func (k Keeper) RescheduleRegularRebalanceTasks(ctx sdk.Context, oldEpochLength, newEpochLength uint64) error {
+	type task struct {
+		contract sdk.AccAddress
+		height   uint64
+	}
+	var tasks []task
+	err := k.IterateScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, math.MaxUint, func(contract sdk.AccAddress, height uint64, repeat bool) bool {
+		if repeat && k.GetContractConfig(ctx, contract).EpochLength == 0 {
+			tasks = append(tasks, task{contract: contract, height: height})
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	currentHeight := uint64(ctx.BlockHeight())
+	for _, t := range tasks {
+		var lastRun uint64
+		if t.height > oldEpochLength {
+			lastRun = t.height - oldEpochLength
+		}
+		newHeight := lastRun + newEpochLength
+		if newHeight < currentHeight {
+			newHeight = currentHeight
+		}
+		if newHeight == t.height {
+			continue
+		}
+		if err := k.RescheduleTask(ctx, types.SchedulerTaskHandleEpoch, t.contract, t.height, newHeight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // HasScheduledTask returns true if the contract has a task scheduled of the given type and repeat setting
 func (k Keeper) HasScheduledTask(ctx sdk.Context, tp types.SchedulerTaskType, contract sdk.AccAddress, repeat bool) bool {
 	var result bool
@@ -87,19 +125,47 @@ func (k Keeper) IterateScheduledTasks(ctx sdk.Context, tp types.SchedulerTaskTyp
 		return err
 	}
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
-	iter := prefixStore.Iterator(nil, nil)
+	iterateScheduledTasksInRange(prefixStore, nil, nil, maxHeight, cb)
+	return nil
+}
+
+// iterateScheduledTasksFrom iterate over all scheduled task executions for the given type up to given block height
+// (included), starting with the task at the cursor position. The tasks before the cursor are visited last.
+func (k Keeper) iterateScheduledTasksFrom(ctx sdk.Context, tp types.SchedulerTaskType, cursor []byte, maxHeight uint64, cb func(addr sdk.AccAddress, height uint64, repeat bool) bool) error {
+	keyPrefix, err := types.BuildSchedulerTypeKeyPrefix(tp)
+	if err != nil {
+		return err
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	if len(cursor) == 0 {
+		iterateScheduledTasksInRange(prefixStore, nil, nil, maxHeight, cb)
+		return nil
+	}
+	if stopped := iterateScheduledTasksInRange(prefixStore, cursor, nil, maxHeight, cb); stopped {
+		return nil
+	}
+	iterateScheduledTasksInRange(prefixStore, nil, cursor, maxHeight, cb)
+	return nil
+}
+
+// iterateScheduledTasksInRange iterate over the scheduled tasks in the [start, end) key range up to given block
+// height (included). Returns true when the callback stopped the iteration.
+func iterateScheduledTasksInRange(store prefix.Store, start, end []byte, maxHeight uint64, cb func(addr sdk.AccAddress, height uint64, repeat bool) bool) bool {
+	iter := store.Iterator(start, end)
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
 		// cb returns true to stop early
 		key := iter.Key()
 		scheduledHeight := sdk.BigEndianToUint64(key[0:8])
-		if scheduledHeight > maxHeight || // abort for future heights
-			cb(key[8:], scheduledHeight, isRepeat(iter.Value())) {
-			return nil
+		if scheduledHeight > maxHeight { // abort for future heights
+			return false
+		}
+		if cb(key[8:], scheduledHeight, isRepeat(iter.Value())) {
+			return true
 		}
 	}
-	return nil
+	return false
 }
 
 // DeleteAllScheduledTasks deletes all tasks of given type for the contract.
@@ -118,6 +184,112 @@ func (k Keeper) DeleteAllScheduledTasks(ctx sdk.Context, tp types.SchedulerTaskT
 	return innerErr
 }
 
+// RescheduleTask moves the task scheduled at the given height to the new height. The repeat setting is kept.
+func (k Keeper) RescheduleTask(ctx sdk.Context, tp types.SchedulerTaskType, contract sdk.AccAddress, height, newHeight uint64) error {
+	storeKey, err := types.BuildSchedulerContractKey(tp, height, contract)
+	if err != nil {
+		return err
+	}
+	bz := ctx.KVStore(k.storeKey).Get(storeKey)
+	if bz == nil {
+		return types.ErrUnknown.Wrapf("task at height %d for contract %s", height, contract)
+	}
+	if err := k.deleteScheduledTask(ctx, tp, contract, height); err != nil {
+		return err
+	}
+	if isRepeat(bz) {
+		return k.ScheduleRepeatingTask(ctx, tp, contract, newHeight)
+	}
+	return k.ScheduleOneShotTask(ctx, tp, contract, newHeight)
+}
+
+// PauseScheduledTasks pauses the execution of all tasks of the given type for the contract.
+// Paused tasks are kept in the scheduler until resumed or deleted.
+func (k Keeper) PauseScheduledTasks(ctx sdk.Context, tp types.SchedulerTaskType, contract sdk.AccAddress) error {
+	storeKey, err := types.BuildPausedTasksKey(tp, contract)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(storeKey, []byte{})
+	return nil
+}
+
+// ResumeScheduledTasks resumes the execution of paused tasks of the given type for the contract.
+// Tasks that became due while paused are moved to the next block height.
+func (k Keeper) ResumeScheduledTasks(ctx sdk.Context, tp types.SchedulerTaskType, contract sdk.AccAddress) error {
+	storeKey, err := types.BuildPausedTasksKey(tp, contract)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(storeKey) {
+		return types.ErrInvalid.Wrapf("tasks not paused for contract %s", contract)
+	}
+	store.Delete(storeKey)
+
+	type overdueTask struct {
+		height uint64
+		repeat bool
+	}
+	var overdue []overdueTask
+	currentHeight := uint64(ctx.BlockHeight())
+	err = k.iterateScheduledContractTasks(ctx, tp, contract, currentHeight, func(height uint64, repeat bool) bool {
+		overdue = append(overdue, overdueTask{height: height, repeat: repeat})
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	var repeat bool
+	for _, t := range overdue {
+		repeat = repeat || t.repeat
+		if err := k.deleteScheduledTask(ctx, tp, contract, t.height); err != nil {
+			return err
+		}
+	}
+	switch {
+	case len(overdue) == 0:
+		return nil
+	case repeat:
+		return k.ScheduleRepeatingTask(ctx, tp, contract, currentHeight+1)
+	default:
+		return k.ScheduleOneShotTask(ctx, tp, contract, currentHeight+1)
+	}
+}
+
+// IsScheduledTasksPaused returns true when the tasks of the given type are paused for the contract
+func (k Keeper) IsScheduledTasksPaused(ctx sdk.Context, tp types.SchedulerTaskType, contract sdk.AccAddress) bool {
+	storeKey, err := types.BuildPausedTasksKey(tp, contract)
+	if err != nil {
+		return false
+	}
+	return ctx.KVStore(k.storeKey).Has(storeKey)
+}
+
+// IteratePausedTasks iterate over all contracts with paused tasks
+// Callback can return true to stop early
+func (k Keeper) IteratePausedTasks(ctx sdk.Context, cb func(tp types.SchedulerTaskType, contract sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PausedTasksKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if cb(types.SchedulerTaskType(key[0]), key[1:]) {
+			return
+		}
+	}
+}
+
+// hasScheduledTaskAt returns true when a task of the given type is scheduled for the contract at the height
+func (k Keeper) hasScheduledTaskAt(ctx sdk.Context, tp types.SchedulerTaskType, contract sdk.AccAddress, height uint64) bool {
+	storeKey, err := types.BuildSchedulerContractKey(tp, height, contract)
+	if err != nil {
+		return false
+	}
+	return ctx.KVStore(k.storeKey).Has(storeKey)
+}
+
 // deleteScheduledTask removes scheduled task from store
 func (k Keeper) deleteScheduledTask(ctx sdk.Context, tp types.SchedulerTaskType, contract sdk.AccAddress, execBlockHeight uint64) error {
 	storeKey, err := types.BuildSchedulerContractKey(tp, execBlockHeight, contract)
@@ -149,26 +321,51 @@ type ExecResult struct {
 	NextRunHeight uint64
 }
 
-// callback interface to execute a scheduled task
-type executor func(ctx sdk.Context, addr sdk.AccAddress) error
+// callback interface to execute a scheduled task. The returned payload is the message sent to the contract.
+// It is persisted for a retry when the execution of a one-shot task fails. A nil payload disables retries.
+type executor func(ctx sdk.Context, addr sdk.AccAddress) (payload []byte, err error)
 
 // ExecScheduledTasks execute scheduled task at current height
 // The executor function is called within the scope of a new cached store. Any failure on execution
 // reverts the state of this sub call. Rescheduling or other state changes due to the scheduler provisioning
 // are not affected.
+// Tasks of paused contracts are skipped and kept for execution after resume.
+// The gas used by all tasks in a block is limited by the scheduler gas budget param. Due tasks that do not fit
+// into the remaining budget are kept in place. The position of the first deferred task is persisted as cursor, so
+// that the next block starts with it and continues round-robin with the tasks before it. This applies to valset
+// updates as well, as the reported operations are kept in the valset outbox until they are delivered.
 // The result type contains more details information of execution or provisioning errors.
-// The given epoch length is used for re-scheduling the task, when set on the task and value >0
+// The given epoch length is used for re-scheduling the task, when set on the task and value >0.
+// A contract specific epoch length or gas limit overrides the defaults.
+// Failed one-shot tasks with a payload are stored for a retry with backoff, see ExecRetryTasks.
 func (k Keeper) ExecScheduledTasks(pCtx sdk.Context, tp types.SchedulerTaskType, epochLength uint64, cb executor) ([]ExecResult, error) {
 	var allResults []ExecResult
 	currentHeight := uint64(pCtx.BlockHeight())
+	cursor := k.getSchedulerCursor(pCtx, tp)
+	var nextCursor []byte
 	// iterator is most gas cost-efficient currently
-	err := k.IterateScheduledTasks(pCtx, tp, currentHeight, func(contract sdk.AccAddress, scheduledHeight uint64, repeat bool) bool {
-		gasLimit := k.GetMaxSudoGas(pCtx)
+	err := k.iterateScheduledTasksFrom(pCtx, tp, cursor, currentHeight, func(contract sdk.AccAddress, scheduledHeight uint64, repeat bool) bool {
+		if k.IsScheduledTasksPaused(pCtx, tp, contract) {
+			return false
+		}
+		gasLimit := k.GetContractMaxSudoGas(pCtx, contract)
+		if nextCursor != nil || !k.fitsSchedulerGasBudget(pCtx, gasLimit) {
+			// keep order: all following tasks are deferred as well
+			if nextCursor == nil {
+				nextCursor = append(sdk.Uint64ToBigEndian(scheduledHeight), contract...)
+			}
+			types.EmitSchedulerDeferredEvent(pCtx, contract, tp, scheduledHeight, gasLimit)
+			return false
+		}
 		cachedCtx, done := pCtx.CacheContext()
 		gasMeter := sdk.NewGasMeter(gasLimit)
 		cachedCtx = cachedCtx.WithGasMeter(gasMeter)
 		result := ExecResult{Contract: contract, GasLimit: gasLimit}
-		err := safeExec(func() error { return cb(cachedCtx, contract) })
+		var payload []byte
+		err := safeExec(func() (err error) {
+			payload, err = cb(cachedCtx, contract)
+			return
+		})
 		if err != nil {
 			result.ExecErr = err
 		} else {
@@ -178,23 +375,98 @@ func (k Keeper) ExecScheduledTasks(pCtx sdk.Context, tp types.SchedulerTaskType,
 					"gas_limit", gasLimit, "contract", contract.String(), "task_type", tp)
 		}
 		result.GasUsed = gasMeter.GasConsumed()
+		k.addSchedulerBlockGas(pCtx, result.GasUsed)
 		types.EmitSchedulerExecutionEvent(pCtx, contract, err)
 
 		if repeat && epochLength != 0 {
 			// re-schedule
 			nextExecBlock := uint64(pCtx.BlockHeight()) + epochLength
+			if c := k.GetContractConfig(pCtx, contract); c.EpochLength != 0 {
+				nextExecBlock = uint64(pCtx.BlockHeight()) + uint64(c.EpochLength)
+			}
 			result.NextRunHeight = nextExecBlock
 			if err := k.ScheduleRepeatingTask(pCtx, tp, contract, nextExecBlock); err != nil {
 				result.RescheduleErr = err
 			}
 		}
+		if err != nil && !repeat && payload != nil {
+			task := types.FailedTask{Type: uint32(tp), Contract: contract.String(), Payload: payload}
+			nextRunHeight, err := k.handleFailedTask(pCtx, task, err)
+			result.NextRunHeight = nextRunHeight
+			if err != nil {
+				result.RescheduleErr = err
+			}
+		}
 		if err := k.deleteScheduledTask(pCtx, tp, contract, scheduledHeight); err != nil {
 			result.DeleteTaskErr = err
 		}
 		allResults = append(allResults, result)
 		return false
 	})
-	return allResults, err
+	if err != nil {
+		return allResults, err
+	}
+	k.setSchedulerCursor(pCtx, tp, nextCursor)
+	return allResults, nil
+}
+
+// getSchedulerCursor returns the position of the first task of the given type that was deferred in a previous
+// block or nil when no task was deferred
+func (k Keeper) getSchedulerCursor(ctx sdk.Context, tp types.SchedulerTaskType) []byte {
+	storeKey, err := types.BuildSchedulerCursorKey(tp)
+	if err != nil {
+		return nil
+	}
+	return ctx.KVStore(k.storeKey).Get(storeKey)
+}
+
+// setSchedulerCursor stores the position of the first deferred task of the given type or deletes the entry for nil
+func (k Keeper) setSchedulerCursor(ctx sdk.Context, tp types.SchedulerTaskType, cursor []byte) {
+	storeKey, err := types.BuildSchedulerCursorKey(tp)
+	if err != nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	if cursor == nil {
+		store.Delete(storeKey)
+		return
+	}
+	store.Set(storeKey, cursor)
+}
+
+// fitsSchedulerGasBudget returns true when a task with the given gas limit fits into the remaining scheduler
+// gas budget of the current block. The first task in a block always fits to ensure progress.
+func (k Keeper) fitsSchedulerGasBudget(ctx sdk.Context, gasLimit sdk.Gas) bool {
+	budget := k.GetMaxSchedulerGasPerBlock(ctx)
+	if budget == 0 {
+		return true
+	}
+	consumed := k.getSchedulerBlockGas(ctx)
+	return consumed == 0 || (consumed < budget && gasLimit <= budget-consumed)
+}
+
+// getSchedulerBlockGas returns the gas consumed by scheduled tasks in the current block
+func (k Keeper) getSchedulerBlockGas(ctx sdk.Context) sdk.Gas {
+	bz := ctx.KVStore(k.memKey).Get(types.SchedulerBlockGasKey)
+	if len(bz) != 16 || binary.BigEndian.Uint64(bz) != uint64(ctx.BlockHeight()) {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz[8:])
+}
+
+// addSchedulerBlockGas adds the given amount to the gas consumed by scheduled tasks in the current block.
+// The value is stored with the block height so that it resets with a new block.
+func (k Keeper) addSchedulerBlockGas(ctx sdk.Context, gas sdk.Gas) {
+	consumed := k.getSchedulerBlockGas(ctx)
+	if math.MaxUint64-consumed < gas {
+		consumed = math.MaxUint64
+	} else {
+		consumed += gas
+	}
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	binary.BigEndian.PutUint64(bz[8:], consumed)
+	ctx.KVStore(k.memKey).Set(types.SchedulerBlockGasKey, bz)
 }
 
 // execute callback with panics recovered
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/cometbft/cometbft/libs/rand"
@@ -24,10 +25,10 @@ func TestExecuteScheduledTask(t *testing.T) {
 
 	var execCount int
 	incrExec := func(t *testing.T) executor {
-		return func(ctx sdk.Context, addr sdk.AccAddress) error {
+		return func(ctx sdk.Context, addr sdk.AccAddress) ([]byte, error) {
 			require.Equal(t, myContract, addr)
 			execCount++
-			return nil
+			return nil, nil
 		}
 	}
 	currentHeight := uint64(pCtx.BlockHeight())
@@ -60,9 +61,9 @@ func TestExecuteScheduledTask(t *testing.T) {
 		"exec fails": {
 			repeat: true,
 			exec: func(t *testing.T) executor {
-				return func(ctx sdk.Context, addr sdk.AccAddress) error {
-					_ = incrExec(t)(ctx, addr)
-					return types.ErrUnknown.Wrap("testing")
+				return func(ctx sdk.Context, addr sdk.AccAddress) ([]byte, error) {
+					_, _ = incrExec(t)(ctx, addr)
+					return nil, types.ErrUnknown.Wrap("testing")
 				}
 			},
 			expRescheduled: true,
@@ -76,8 +77,8 @@ func TestExecuteScheduledTask(t *testing.T) {
 		"exec panics": {
 			repeat: true,
 			exec: func(t *testing.T) executor {
-				return func(ctx sdk.Context, addr sdk.AccAddress) error {
-					_ = incrExec(t)(ctx, addr)
+				return func(ctx sdk.Context, addr sdk.AccAddress) ([]byte, error) {
+					_, _ = incrExec(t)(ctx, addr)
 					panic("testing")
 				}
 			},
@@ -284,6 +285,259 @@ func TestDeleteAllScheduledTasks(t *testing.T) {
 	}
 }
 
+func TestRescheduleTask(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	currentHeight := uint64(pCtx.BlockHeight())
+	require.NoError(t, k.ScheduleRepeatingTask(pCtx, types.SchedulerTaskHandleEpoch, myContract, currentHeight+1))
+	require.NoError(t, k.ScheduleOneShotTask(pCtx, types.SchedulerTaskValsetUpdate, myContract, currentHeight+1))
+
+	specs := map[string]struct {
+		tp        types.SchedulerTaskType
+		height    uint64
+		newHeight uint64
+		expErr    bool
+		expRepeat bool
+	}{
+		"repeating task": {
+			tp:        types.SchedulerTaskHandleEpoch,
+			height:    currentHeight + 1,
+			newHeight: currentHeight + 10,
+			expRepeat: true,
+		},
+		"one shot task": {
+			tp:        types.SchedulerTaskValsetUpdate,
+			height:    currentHeight + 1,
+			newHeight: currentHeight + 10,
+		},
+		"unknown task": {
+			tp:        types.SchedulerTaskHandleEpoch,
+			height:    currentHeight + 2,
+			newHeight: currentHeight + 10,
+			expErr:    true,
+		},
+		"past height": {
+			tp:        types.SchedulerTaskHandleEpoch,
+			height:    currentHeight + 1,
+			newHeight: currentHeight - 1,
+			expErr:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			gotErr := k.RescheduleTask(ctx, spec.tp, myContract, spec.height, spec.newHeight)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			_, exists := k.getScheduledTaskAt(ctx, spec.tp, myContract, spec.height)
+			assert.False(t, exists)
+			repeat, exists := k.getScheduledTaskAt(ctx, spec.tp, myContract, spec.newHeight)
+			assert.True(t, exists)
+			assert.Equal(t, spec.expRepeat, repeat)
+		})
+	}
+}
+
+func TestPauseResumeScheduledTasks(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	myOtherContract := sdk.AccAddress(rand.Bytes(32))
+	startHeight := uint64(ctx.BlockHeight())
+	require.NoError(t, k.ScheduleRepeatingTask(ctx, types.SchedulerTaskHandleEpoch, myContract, startHeight+1))
+	require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskHandleEpoch, myContract, startHeight+2))
+	require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskHandleEpoch, myContract, startHeight+10))
+	require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskHandleEpoch, myOtherContract, startHeight+2))
+
+	// when paused
+	require.NoError(t, k.PauseScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, myContract))
+	// then
+	assert.True(t, k.IsScheduledTasksPaused(ctx, types.SchedulerTaskHandleEpoch, myContract))
+	assert.False(t, k.IsScheduledTasksPaused(ctx, types.SchedulerTaskValsetUpdate, myContract))
+	assert.False(t, k.IsScheduledTasksPaused(ctx, types.SchedulerTaskHandleEpoch, myOtherContract))
+
+	// and paused tasks are skipped on execution
+	ctx = ctx.WithBlockHeight(int64(startHeight) + 2)
+	var executed []sdk.AccAddress
+	_, err := k.ExecScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, 100, func(_ sdk.Context, addr sdk.AccAddress) ([]byte, error) {
+		executed = append(executed, addr)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []sdk.AccAddress{myOtherContract}, executed)
+	_, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, myContract, startHeight+1)
+	assert.True(t, exists)
+
+	// when resumed
+	require.NoError(t, k.ResumeScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, myContract))
+	// then overdue tasks are merged into the next block
+	assert.False(t, k.IsScheduledTasksPaused(ctx, types.SchedulerTaskHandleEpoch, myContract))
+	for _, h := range []uint64{startHeight + 1, startHeight + 2} {
+		_, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, myContract, h)
+		assert.False(t, exists)
+	}
+	repeat, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, myContract, startHeight+3)
+	assert.True(t, exists)
+	assert.True(t, repeat)
+	// and future tasks are not modified
+	repeat, exists = k.getScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, myContract, startHeight+10)
+	assert.True(t, exists)
+	assert.False(t, repeat)
+
+	// and resume without pause fails
+	require.Error(t, k.ResumeScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, myContract))
+	// and undefined type fails
+	require.Error(t, k.PauseScheduledTasks(ctx, types.SchedulerTaskUndefined, myContract))
+}
+
+func TestExecScheduledTasksWithContractConfig(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	myOtherContract := sdk.AccAddress(rand.Bytes(32))
+	currentHeight := uint64(ctx.BlockHeight())
+	require.NoError(t, k.ScheduleRepeatingTask(ctx, types.SchedulerTaskHandleEpoch, myContract, currentHeight))
+	require.NoError(t, k.ScheduleRepeatingTask(ctx, types.SchedulerTaskHandleEpoch, myOtherContract, currentHeight))
+	require.NoError(t, k.SetContractConfig(ctx, myContract, types.ContractConfig{EpochLength: 7, MaxGasEndBlocker: 1_234}))
+
+	// when
+	results, err := k.ExecScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, 100, func(_ sdk.Context, _ sdk.AccAddress) ([]byte, error) {
+		return nil, nil
+	})
+	// then
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	for _, r := range results {
+		if r.Contract.Equals(myContract) {
+			assert.Equal(t, sdk.Gas(1_234), r.GasLimit)
+			assert.Equal(t, currentHeight+7, r.NextRunHeight)
+			continue
+		}
+		assert.Equal(t, k.GetMaxSudoGas(ctx), r.GasLimit)
+		assert.Equal(t, currentHeight+100, r.NextRunHeight)
+	}
+	// and an empty config falls back to the params
+	require.NoError(t, k.SetContractConfig(ctx, myContract, types.ContractConfig{}))
+	assert.Equal(t, k.GetMaxSudoGas(ctx), k.GetContractMaxSudoGas(ctx, myContract))
+	assert.Equal(t, k.GetRebalanceEpochLength(ctx), k.GetContractEpochLength(ctx, myContract))
+}
+
+func TestExecScheduledTasksWithBlockGasBudget(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	params := k.GetParams(ctx)
+	params.MaxGasEndBlocker = 100
+	params.MaxGasSchedulerPerBlock = 250
+	require.NoError(t, k.SetParams(ctx, params))
+
+	startHeight := uint64(ctx.BlockHeight())
+	contracts := make([]sdk.AccAddress, 4)
+	for i := range contracts {
+		contracts[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(i + 1)}, 20))
+		require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskHandleEpoch, contracts[i], startHeight))
+	}
+	require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskValsetUpdate, contracts[0], startHeight))
+	consumeAll := func(ctx sdk.Context, _ sdk.AccAddress) ([]byte, error) {
+		ctx.GasMeter().ConsumeGas(100, "testing")
+		return nil, nil
+	}
+	// when valset updates are executed
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	results, err := k.ExecScheduledTasks(ctx, types.SchedulerTaskValsetUpdate, 0, consumeAll)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	// and epoch tasks are executed in the same block
+	results, err = k.ExecScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, 0, consumeAll)
+	require.NoError(t, err)
+	// then the remaining budget allows only one task
+	require.Len(t, results, 1)
+	assert.Equal(t, contracts[0], results[0].Contract)
+	// and the deferrals are recorded
+	var deferred []string
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != types.EventTypeSchedulerDeferred {
+			continue
+		}
+		for _, a := range e.Attributes {
+			if a.Key == types.AttributeKeyContractAddr {
+				deferred = append(deferred, a.Value)
+			}
+		}
+	}
+	assert.Equal(t, []string{contracts[1].String(), contracts[2].String(), contracts[3].String()}, deferred)
+	// and the first deferred task is stored as cursor
+	assert.Equal(t, append(sdk.Uint64ToBigEndian(startHeight), contracts[1]...), k.getSchedulerCursor(ctx, types.SchedulerTaskHandleEpoch))
+	// and valset updates are deferred when the budget is exhausted
+	require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskValsetUpdate, contracts[1], startHeight))
+	results, err = k.ExecScheduledTasks(ctx, types.SchedulerTaskValsetUpdate, 0, consumeAll)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Equal(t, append(sdk.Uint64ToBigEndian(startHeight), contracts[1]...), k.getSchedulerCursor(ctx, types.SchedulerTaskValsetUpdate))
+
+	// when executed in the next block
+	ctx = ctx.WithBlockHeight(int64(startHeight) + 1)
+	results, err = k.ExecScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, 0, consumeAll)
+	// then the deferred tasks are executed in order within the budget
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, contracts[1], results[0].Contract)
+	assert.Equal(t, contracts[2], results[1].Contract)
+	_, exists := k.getScheduledTaskAt(ctx, types.SchedulerTaskHandleEpoch, contracts[3], startHeight)
+	assert.True(t, exists)
+
+	// and the first task of a block is always executed, even when the contract gas limit exceeds the budget
+	require.NoError(t, k.SetContractConfig(ctx, contracts[3], types.ContractConfig{MaxGasEndBlocker: 1_000}))
+	ctx = ctx.WithBlockHeight(int64(startHeight) + 2)
+	results, err = k.ExecScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, 0, consumeAll)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, contracts[3], results[0].Contract)
+}
+
+func TestExecScheduledTasksRoundRobin(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	params := k.GetParams(ctx)
+	params.MaxGasEndBlocker = 100
+	params.MaxGasSchedulerPerBlock = 200
+	require.NoError(t, k.SetParams(ctx, params))
+
+	startHeight := uint64(ctx.BlockHeight())
+	contracts := make([]sdk.AccAddress, 3)
+	for i := range contracts {
+		contracts[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(i + 1)}, 20))
+		require.NoError(t, k.ScheduleOneShotTask(ctx, types.SchedulerTaskHandleEpoch, contracts[i], startHeight))
+	}
+	consumeAll := func(ctx sdk.Context, _ sdk.AccAddress) ([]byte, error) {
+		ctx.GasMeter().ConsumeGas(100, "testing")
+		return nil, nil
+	}
+	// when a previous block deferred the tasks starting with the last contract
+	k.setSchedulerCursor(ctx, types.SchedulerTaskHandleEpoch, append(sdk.Uint64ToBigEndian(startHeight), contracts[2]...))
+	results, err := k.ExecScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, 0, consumeAll)
+	// then execution starts at the cursor and continues with the tasks before it
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, contracts[2], results[0].Contract)
+	assert.Equal(t, contracts[0], results[1].Contract)
+	// and the cursor points to the next deferred task
+	assert.Equal(t, append(sdk.Uint64ToBigEndian(startHeight), contracts[1]...), k.getSchedulerCursor(ctx, types.SchedulerTaskHandleEpoch))
+
+	// when executed in the next block
+	ctx = ctx.WithBlockHeight(int64(startHeight) + 1)
+	results, err = k.ExecScheduledTasks(ctx, types.SchedulerTaskHandleEpoch, 0, consumeAll)
+	// then the deferred task is executed
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, contracts[1], results[0].Contract)
+	// and the cursor is cleared when nothing was deferred
+	assert.Nil(t, k.getSchedulerCursor(ctx, types.SchedulerTaskHandleEpoch))
+}
+
 func (k Keeper) getScheduledTaskAt(ctx sdk.Context, tp types.SchedulerTaskType, contract sdk.AccAddress, height uint64) (repeat, exists bool) {
 	key, err := types.BuildSchedulerContractKey(tp, height, contract)
 	if err != nil {
@@ -90,28 +90,38 @@ func (k Keeper) Undelegate(pCtx sdk.Context, actor sdk.AccAddress, valAddr sdk.V
 		return err
 	}
 
-	undelegatedCoins, err := k.Staking.InstantUndelegate(cacheCtx, actor, valAddr, shares)
-	if err != nil {
+	if _, err := k.undelegateShares(cacheCtx, actor, valAddr, shares); err != nil {
 		return err
 	}
-	err = k.bank.SendCoinsFromAccountToModule(cacheCtx, actor, types.ModuleName, undelegatedCoins)
+	done()
+	return nil
+}
+
+// undelegateShares executes an instant undelegate of the shares and burns the released virtual staking tokens.
+// The total delegated amount of the actor is reduced by the amount burned, which is returned.
+func (k Keeper) undelegateShares(ctx sdk.Context, actor sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) (sdk.Coin, error) {
+	bondDenom := k.Staking.BondDenom(ctx)
+	undelegatedCoins, err := k.Staking.InstantUndelegate(ctx, actor, valAddr, shares)
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, actor, types.ModuleName, undelegatedCoins)
+	if err != nil {
+		return sdk.Coin{}, err
 	}
 
-	err = k.bank.BurnCoins(cacheCtx, types.ModuleName, undelegatedCoins)
+	err = k.bank.BurnCoins(ctx, types.ModuleName, undelegatedCoins)
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	unbondedAmount := sdk.NewCoin(bondDenom, undelegatedCoins.AmountOf(bondDenom))
-	k.bank.AddSupplyOffset(cacheCtx, bondDenom, unbondedAmount.Amount)
-	newDelegatedAmt := totalDelegatedAmount.Sub(unbondedAmount)
+	k.bank.AddSupplyOffset(ctx, bondDenom, unbondedAmount.Amount)
+	// sdk.Coin.Sub panics on a negative result so that the clamp is done on the amount
+	newDelegatedAmt := k.GetTotalDelegated(ctx, actor).Amount.Sub(unbondedAmount.Amount)
 	if newDelegatedAmt.IsNegative() {
-		newDelegatedAmt = sdk.NewCoin(bondDenom, math.ZeroInt())
+		newDelegatedAmt = math.ZeroInt()
 	}
-	k.setTotalDelegated(cacheCtx, actor, newDelegatedAmt)
-
-	done()
-	return nil
+	k.setTotalDelegated(ctx, actor, sdk.NewCoin(bondDenom, newDelegatedAmt))
+	return unbondedAmount, nil
 }
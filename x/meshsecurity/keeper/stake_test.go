@@ -260,6 +260,13 @@ func (c *CaptureOffsetBankKeeper) AddSupplyOffset(_ sdk.Context, denom string, o
 	c.Offset[denom] = old.Add(offsetAmount)
 }
 
+func (c *CaptureOffsetBankKeeper) GetSupplyOffset(_ sdk.Context, denom string) math.Int {
+	if v, ok := c.Offset[denom]; ok {
+		return v
+	}
+	return math.ZeroInt()
+}
+
 func add3Validators(t *testing.T, pCtx sdk.Context, stakingKeeper *stakingkeeper.Keeper) []sdk.ValAddress {
 	accNum := 3
 	valAddrs := simtestutil.ConvertAddrsToValAddrs(simtestutil.CreateIncrementalAccounts(accNum))
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"sort"
 	"testing"
 	"time"
 
@@ -105,6 +106,7 @@ func makeEncodingConfig(_ testing.TB) encodingConfig {
 type TestKeepers struct {
 	StakingKeeper  *stakingkeeper.Keeper
 	SlashingKeeper slashingkeeper.Keeper
+	DistKeeper     distributionkeeper.Keeper
 	BankKeeper     bankkeeper.Keeper
 	StoreKey       *storetypes.KVStoreKey
 	EncodingConfig encodingConfig
@@ -277,7 +279,7 @@ func CreateDefaultTestInput(t testing.TB, opts ...Option) (sdk.Context, TestKeep
 		stakingKeeper,
 		wasmKeeper,
 		authority,
-		opts...,
+		append([]Option{WithSlashingKeeper(slashingKeeper), WithDistributionKeeper(distKeeper)}, opts...)...,
 	)
 	require.NoError(t, msKeeper.SetParams(ctx, types.DefaultParams(sdk.DefaultBondDenom)))
 
@@ -286,6 +288,7 @@ func CreateDefaultTestInput(t testing.TB, opts ...Option) (sdk.Context, TestKeep
 		AccountKeeper:  accountKeeper,
 		StakingKeeper:  stakingKeeper,
 		SlashingKeeper: slashingKeeper,
+		DistKeeper:     distKeeper,
 		BankKeeper:     bankKeeper,
 		StoreKey:       keys[types.StoreKey],
 		EncodingConfig: encConfig,
@@ -295,18 +298,24 @@ func CreateDefaultTestInput(t testing.TB, opts ...Option) (sdk.Context, TestKeep
 	}
 }
 
-// FetchAllStoredOperations load all ops from temp db
-func FetchAllStoredOperations(t *testing.T, ctx sdk.Context, msKeeper *Keeper) map[string][]types.PipedValsetOperation {
+// FetchAllStoredOperations load all distinct ops from the outbox of the contract, sorted by operation type
+func FetchAllStoredOperations(t *testing.T, ctx sdk.Context, msKeeper *Keeper, contract sdk.AccAddress) map[string][]types.PipedValsetOperation {
+	t.Helper()
 	index := make(map[string][]types.PipedValsetOperation, 1)
-	err := msKeeper.iteratePipedValsetOperations(ctx, func(valAddr sdk.ValAddress, op types.PipedValsetOperation, slashInfo *types.SlashInfo) bool {
-		ops, ok := index[valAddr.String()]
-		if !ok {
-			ops = []types.PipedValsetOperation{}
+	msKeeper.IterateValsetOutbox(ctx, contract, func(entry types.ValsetOutboxEntry) bool {
+		op := types.PipedValsetOperation(entry.Operation)
+		ops := index[entry.Validator]
+		for _, o := range ops {
+			if o == op {
+				return false
+			}
 		}
-		index[valAddr.String()] = append(ops, op)
+		index[entry.Validator] = append(ops, op)
 		return false
 	})
-	require.NoError(t, err)
+	for _, ops := range index {
+		sort.Slice(ops, func(i, j int) bool { return ops[i] < ops[j] })
+	}
 	return index
 }
 
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+// CaptureValidatorPowerChanges compares the powers of the active validators with the powers reported at the last
+// epoch and stores the changes in the outbox of the contract, unless legacy validators are configured for it.
+// Validators that left the active set are not tracked anymore, they are reported as removals.
+// Returns true when changes were stored. Should be called by the epoch task before the rebalance, so that the
+// contract receives them first and that they are stored only when the epoch succeeds.
+func (k Keeper) CaptureValidatorPowerChanges(ctx sdk.Context, contractAddr sdk.AccAddress) (bool, error) {
+	if !k.GetMaxCapLimit(ctx, contractAddr).Amount.IsPositive() ||
+		k.GetContractConfig(ctx, contractAddr).LegacyValidators {
+		return false, nil
+	}
+	lastPowers := k.getValidatorPowers(ctx, contractAddr)
+	powerReduction := k.Staking.PowerReduction(ctx)
+	var changed bool
+	var innerErr error
+	k.Staking.IterateBondedValidatorsByPower(ctx, func(_ int64, val stakingtypes.ValidatorI) bool {
+		valAddr := val.GetOperator()
+		power := val.GetConsensusPower(powerReduction)
+		lastPower, found := lastPowers[string(valAddr)]
+		delete(lastPowers, string(valAddr))
+		if found && lastPower == power {
+			return false
+		}
+		k.setValidatorPower(ctx, contractAddr, valAddr, power)
+		powerInfo := &types.PowerInfo{Power: power, PreviousPower: lastPower, Tokens: val.GetTokens().String()}
+		innerErr = k.appendValsetOutboxEntry(ctx, contractAddr, types.ValidatorPowerChanged, valAddr, func(_ sdk.AccAddress, entry *types.ValsetOutboxEntry) {
+			entry.PowerInfo = powerInfo
+		})
+		changed = true
+		return innerErr != nil
+	})
+	if innerErr != nil {
+		return false, innerErr
+	}
+	store := ctx.KVStore(k.storeKey)
+	for valAddr := range lastPowers {
+		store.Delete(types.BuildValidatorPowerKey(contractAddr, sdk.ValAddress(valAddr)))
+	}
+	return changed, nil
+}
+
+// getValidatorPowers returns the validator powers reported to the contract at the last epoch by validator address bytes
+func (k Keeper) getValidatorPowers(ctx sdk.Context, contractAddr sdk.AccAddress) map[string]int64 {
+	r := make(map[string]int64)
+	pStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BuildValidatorPowerContractPrefix(contractAddr))
+	iter := pStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		r[string(iter.Key())] = int64(sdk.BigEndianToUint64(iter.Value()))
+	}
+	return r
+}
+
+// setValidatorPower stores the validator power reported to the contract
+func (k Keeper) setValidatorPower(ctx sdk.Context, contractAddr sdk.AccAddress, valAddr sdk.ValAddress, power int64) {
+	ctx.KVStore(k.storeKey).Set(types.BuildValidatorPowerKey(contractAddr, valAddr), sdk.Uint64ToBigEndian(uint64(power)))
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+func TestCaptureValidatorPowerChanges(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	var (
+		myContractAddr      = sdk.AccAddress(rand.Bytes(address.Len))
+		myOtherContractAddr = sdk.AccAddress(rand.Bytes(address.Len))
+		myLegacyContract    = sdk.AccAddress(rand.Bytes(address.Len))
+	)
+	for _, c := range []sdk.AccAddress{myContractAddr, myOtherContractAddr, myLegacyContract} {
+		require.NoError(t, k.SetMaxCapLimit(pCtx, c, sdk.NewInt64Coin("stake", 100_000_000)))
+	}
+	require.NoError(t, k.SetContractConfig(pCtx, myLegacyContract, types.ContractConfig{LegacyValidators: true}))
+
+	keepers.Faucet.Fund(pCtx, keepers.AccountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName), sdk.NewInt64Coin("stake", 17_000_000))
+	vals := addBondedValidators(t, pCtx, keepers.StakingKeeper, math.NewInt(9_000_000), math.NewInt(8_000_000))
+
+	// when
+	changed, err := k.CaptureValidatorPowerChanges(pCtx, myContractAddr)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	// then all active validators are reported
+	got, _, err := k.ValsetUpdateReport(pCtx, myContractAddr)
+	require.NoError(t, err)
+	exp := []contract.ValidatorPowerChange{
+		{ValidatorAddr: vals[0].GetOperator().String(), Power: 9, Tokens: wasmvmtypes.NewCoin(9_000_000, "stake")},
+		{ValidatorAddr: vals[1].GetOperator().String(), Power: 8, Tokens: wasmvmtypes.NewCoin(8_000_000, "stake")},
+	}
+	assert.ElementsMatch(t, exp, got.PowerChanges)
+	// and other contracts are not affected
+	for _, c := range []sdk.AccAddress{myOtherContractAddr, myLegacyContract} {
+		_, lastSeq, err := k.ValsetUpdateReport(pCtx, c)
+		require.NoError(t, err)
+		assert.Zero(t, lastSeq)
+	}
+	// and contracts with legacy validators are skipped
+	changed, err = k.CaptureValidatorPowerChanges(pCtx, myLegacyContract)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	_, lastSeq, err := k.ValsetUpdateReport(pCtx, myLegacyContract)
+	require.NoError(t, err)
+	assert.Zero(t, lastSeq)
+
+	// when captured again without changes
+	ctx, _ := pCtx.CacheContext()
+	_, lastSeq, err = k.ValsetUpdateReport(ctx, myContractAddr)
+	require.NoError(t, err)
+	changed, err = k.CaptureValidatorPowerChanges(ctx, myContractAddr)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	// then nothing is added
+	_, gotSeq, err := k.ValsetUpdateReport(ctx, myContractAddr)
+	require.NoError(t, err)
+	assert.Equal(t, lastSeq, gotSeq)
+
+	// when the power of a validator changes
+	vals[0], _ = vals[0].AddTokensFromDel(math.NewInt(3_000_000))
+	stakingkeeper.TestingUpdateValidator(keepers.StakingKeeper, pCtx, vals[0], true)
+	_, err = k.CaptureValidatorPowerChanges(pCtx, myContractAddr)
+	require.NoError(t, err)
+	// then the latest power is reported with the power of the previous report
+	got, _, err = k.ValsetUpdateReport(pCtx, myContractAddr)
+	require.NoError(t, err)
+	exp[0] = contract.ValidatorPowerChange{ValidatorAddr: vals[0].GetOperator().String(), Power: 12, Tokens: wasmvmtypes.NewCoin(12_000_000, "stake")}
+	assert.ElementsMatch(t, exp, got.PowerChanges)
+
+	// when acknowledged and the power changes again
+	_, lastSeq, err = k.ValsetUpdateReport(pCtx, myContractAddr)
+	require.NoError(t, err)
+	k.AckValsetOutbox(pCtx, myContractAddr, lastSeq)
+	vals[1], _ = vals[1].RemoveDelShares(math.LegacyNewDec(1_000_000))
+	stakingkeeper.TestingUpdateValidator(keepers.StakingKeeper, pCtx, vals[1], true)
+	_, err = k.CaptureValidatorPowerChanges(pCtx, myContractAddr)
+	require.NoError(t, err)
+	// then only the change is reported
+	got, _, err = k.ValsetUpdateReport(pCtx, myContractAddr)
+	require.NoError(t, err)
+	exp = []contract.ValidatorPowerChange{
+		{ValidatorAddr: vals[1].GetOperator().String(), Power: 7, PreviousPower: 8, Tokens: wasmvmtypes.NewCoin(7_000_000, "stake")},
+	}
+	assert.Equal(t, exp, got.PowerChanges)
+}
+
+func addBondedValidators(t *testing.T, ctx sdk.Context, stakingKeeper *stakingkeeper.Keeper, amts ...math.Int) []stakingtypes.Validator {
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(simtestutil.CreateIncrementalAccounts(len(amts)))
+	pks := simtestutil.CreateTestPubKeys(len(amts))
+	validators := make([]stakingtypes.Validator, len(amts))
+	for i, amt := range amts {
+		validators[i] = stakingtestutil.NewValidator(t, valAddrs[i], pks[i])
+		validators[i], _ = validators[i].AddTokensFromDel(amt)
+		validators[i] = stakingkeeper.TestingUpdateValidator(stakingKeeper, ctx, validators[i], true)
+	}
+	return validators
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"bytes"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
+)
+
+const (
+	// DefaultValidatorsQueryLimit is the number of validators returned by the validators query without limit
+	DefaultValidatorsQueryLimit = 30
+	// MaxValidatorsQueryLimit is the max number of validators returned by the validators query
+	MaxValidatorsQueryLimit = 100
+)
+
+// ValidatorStatus returns the current status of the validator. Returns false when the validator does not exist.
+func (k Keeper) ValidatorStatus(ctx sdk.Context, valAddr sdk.ValAddress) (contract.ValidatorStatus, bool, error) {
+	val, found := k.Staking.GetValidator(ctx, valAddr)
+	if !found {
+		return contract.ValidatorStatus{}, false, nil
+	}
+	r, err := k.toValidatorStatus(ctx, val)
+	if err != nil {
+		return contract.ValidatorStatus{}, false, err
+	}
+	return r, true, nil
+}
+
+// ActiveValidators returns the status of the validators in the active set ordered by operator address, starting
+// after the given address. The limit falls back to DefaultValidatorsQueryLimit when 0 and is capped by
+// MaxValidatorsQueryLimit.
+func (k Keeper) ActiveValidators(ctx sdk.Context, startAfter sdk.ValAddress, limit uint32) ([]contract.ValidatorStatus, error) {
+	switch {
+	case limit == 0:
+		limit = DefaultValidatorsQueryLimit
+	case limit > MaxValidatorsQueryLimit:
+		limit = MaxValidatorsQueryLimit
+	}
+	r := make([]contract.ValidatorStatus, 0)
+	var innerErr error
+	// the last validator powers are stored by operator address
+	k.Staking.IterateLastValidatorPowers(ctx, func(valAddr sdk.ValAddress, _ int64) bool {
+		if startAfter != nil && bytes.Compare(valAddr, startAfter) <= 0 {
+			return false
+		}
+		val, found := k.Staking.GetValidator(ctx, valAddr)
+		if !found {
+			return false
+		}
+		var status contract.ValidatorStatus
+		if status, innerErr = k.toValidatorStatus(ctx, val); innerErr != nil {
+			return true
+		}
+		r = append(r, status)
+		return len(r) == int(limit)
+	})
+	return r, innerErr
+}
+
+// toValidatorStatus converts the sdk validator with the bond status details and tokens in bond denom
+func (k Keeper) toValidatorStatus(ctx sdk.Context, val stakingtypes.Validator) (contract.ValidatorStatus, error) {
+	tombstoned, err := k.isTombstoned(ctx, val)
+	if err != nil {
+		return contract.ValidatorStatus{}, err
+	}
+	return contract.ValidatorStatus{
+		Validator:  ConvertSdkValidatorToWasm(val),
+		Status:     BondStatus(val.GetStatus()),
+		Jailed:     val.IsJailed(),
+		Tombstoned: tombstoned,
+		Tokens:     wasmkeeper.ConvertSdkCoinToWasmCoin(sdk.NewCoin(k.Staking.BondDenom(ctx), val.GetTokens())),
+	}, nil
+}
+
+// isTombstoned returns true when the validator was tombstoned. Always false without a slashing keeper, see
+// WithSlashingKeeper.
+func (k Keeper) isTombstoned(ctx sdk.Context, val stakingtypes.Validator) (bool, error) {
+	if k.slashing == nil {
+		return false, nil
+	}
+	consAddr, err := val.GetConsAddr()
+	if err != nil {
+		return false, err
+	}
+	return k.slashing.IsTombstoned(ctx, consAddr), nil
+}
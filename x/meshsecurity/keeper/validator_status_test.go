@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
+)
+
+func TestValidatorStatus(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	keepers.Faucet.Fund(ctx, keepers.AccountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName), sdk.NewInt64Coin("stake", 9_000_000))
+	vals := addBondedValidators(t, ctx, keepers.StakingKeeper, math.NewInt(9_000_000))
+	consAddr, err := vals[0].GetConsAddr()
+	require.NoError(t, err)
+	keepers.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.ValidatorSigningInfo{Address: consAddr.String(), Tombstoned: true})
+
+	// when
+	got, found, err := k.ValidatorStatus(ctx, vals[0].GetOperator())
+	// then
+	require.NoError(t, err)
+	require.True(t, found)
+	exp := contract.ValidatorStatus{
+		Validator:  ConvertSdkValidatorToWasm(vals[0]),
+		Status:     "bonded",
+		Tombstoned: true,
+		Tokens:     wasmvmtypes.NewCoin(9_000_000, "stake"),
+	}
+	assert.Equal(t, exp, got)
+
+	// when unknown
+	_, found, err = k.ValidatorStatus(ctx, sdk.ValAddress(consAddr))
+	// then
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestActiveValidators(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	keepers.Faucet.Fund(ctx, keepers.AccountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName), sdk.NewInt64Coin("stake", 24_000_000))
+	vals := addBondedValidators(t, ctx, keepers.StakingKeeper, math.NewInt(9_000_000), math.NewInt(8_000_000), math.NewInt(7_000_000))
+	// not in the active set
+	keepers.StakingKeeper.SetValidator(ctx, MinValidatorFixture(t))
+
+	// incremental accounts are ordered by address
+	specs := map[string]struct {
+		startAfter sdk.ValAddress
+		limit      uint32
+		exp        []stakingtypes.Validator
+	}{
+		"all": {
+			exp: vals,
+		},
+		"with limit": {
+			limit: 2,
+			exp:   vals[0:2],
+		},
+		"with start after": {
+			startAfter: vals[0].GetOperator(),
+			exp:        vals[1:],
+		},
+		"with start after and limit": {
+			startAfter: vals[0].GetOperator(),
+			limit:      1,
+			exp:        vals[1:2],
+		},
+		"after last": {
+			startAfter: vals[2].GetOperator(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, err := k.ActiveValidators(ctx, spec.startAfter, spec.limit)
+			require.NoError(t, err)
+			gotAddrs := make([]string, len(got))
+			for i, v := range got {
+				gotAddrs[i] = v.Address
+			}
+			expAddrs := make([]string, len(spec.exp))
+			for i, v := range spec.exp {
+				expAddrs[i] = v.OperatorAddress
+			}
+			assert.Equal(t, expAddrs, gotAddrs)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+// ScheduleValsetSnapshot schedules a full validator set snapshot for the contract in the current block.
+// Nothing is scheduled when valset snapshots are disabled in the contract config.
+func (k Keeper) ScheduleValsetSnapshot(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	if k.GetContractConfig(ctx, contractAddr).DisableValsetSnapshots {
+		return nil
+	}
+	return k.ScheduleOneShotTask(ctx, types.SchedulerTaskValsetSnapshot, contractAddr, uint64(ctx.BlockHeight()))
+}
+
+// ScheduleValsetSnapshots schedules a full validator set snapshot in the current block for all registered contracts
+// with a max cap limit > 0 and valset snapshots not disabled. To be called by a chain upgrade handler, for example.
+func (k Keeper) ScheduleValsetSnapshots(ctx sdk.Context) error {
+	var contracts []sdk.AccAddress
+	k.IterateMaxCapLimit(ctx, func(contractAddr sdk.AccAddress, m math.Int) bool {
+		if m.GT(math.ZeroInt()) {
+			contracts = append(contracts, contractAddr)
+		}
+		return false
+	})
+	for _, contractAddr := range contracts {
+		if err := k.ScheduleValsetSnapshot(ctx, contractAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValsetSnapshot returns the current state of all bonded validators and of the jailed validators that were not
+// removed from the store yet. The tombstoned flag is set only when a slashing keeper was provided, see WithSlashingKeeper.
+func (k Keeper) ValsetSnapshot(ctx sdk.Context) (contract.ValsetSnapshot, error) {
+	r := contract.ValsetSnapshot{
+		Height:     ctx.BlockHeight(),
+		Time:       ctx.BlockTime().Unix(),
+		Validators: make([]contract.ValidatorState, 0),
+	}
+	for _, val := range k.Staking.GetAllValidators(ctx) {
+		if !val.IsBonded() && !val.IsJailed() {
+			continue
+		}
+		tombstoned, err := k.isTombstoned(ctx, val)
+		if err != nil {
+			return contract.ValsetSnapshot{}, err
+		}
+		r.Validators = append(r.Validators, contract.ValidatorState{
+			Validator:  ConvertSdkValidatorToWasm(val),
+			Status:     BondStatus(val.GetStatus()),
+			Jailed:     val.IsJailed(),
+			Tombstoned: tombstoned,
+		})
+	}
+	return r, nil
+}
+
+// BondStatus returns the lower case bond status name without prefix, e.g. bonded
+func BondStatus(status stakingtypes.BondStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "BOND_STATUS_"))
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/contract"
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurity/types"
+)
+
+func TestValsetSnapshot(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	keepers.Faucet.Fund(ctx, keepers.AccountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName), sdk.NewInt64Coin("stake", 17_000_000))
+	vals := addBondedValidators(t, ctx, keepers.StakingKeeper, math.NewInt(9_000_000), math.NewInt(8_000_000))
+
+	jailedVal := MinValidatorFixture(t)
+	jailedVal.Status, jailedVal.Jailed = stakingtypes.Unbonding, true
+	keepers.StakingKeeper.SetValidator(ctx, jailedVal)
+	tombstonedVal := MinValidatorFixture(t)
+	tombstonedVal.Status, tombstonedVal.Jailed = stakingtypes.Unbonded, true
+	keepers.StakingKeeper.SetValidator(ctx, tombstonedVal)
+	tombstonedConsAddr, err := tombstonedVal.GetConsAddr()
+	require.NoError(t, err)
+	keepers.SlashingKeeper.SetValidatorSigningInfo(ctx, tombstonedConsAddr, slashingtypes.ValidatorSigningInfo{Address: tombstonedConsAddr.String(), Tombstoned: true})
+	// not reported
+	keepers.StakingKeeper.SetValidator(ctx, MinValidatorFixture(t))
+
+	// reload with the store defaults
+	jailedVal, _ = keepers.StakingKeeper.GetValidator(ctx, jailedVal.GetOperator())
+	tombstonedVal, _ = keepers.StakingKeeper.GetValidator(ctx, tombstonedVal.GetOperator())
+
+	// when
+	got, err := k.ValsetSnapshot(ctx)
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, ctx.BlockHeight(), got.Height)
+	assert.Equal(t, ctx.BlockTime().Unix(), got.Time)
+	exp := []contract.ValidatorState{
+		{Validator: ConvertSdkValidatorToWasm(vals[0]), Status: "bonded"},
+		{Validator: ConvertSdkValidatorToWasm(vals[1]), Status: "bonded"},
+		{Validator: ConvertSdkValidatorToWasm(jailedVal), Status: "unbonding", Jailed: true},
+		{Validator: ConvertSdkValidatorToWasm(tombstonedVal), Status: "unbonded", Jailed: true, Tombstoned: true},
+	}
+	assert.ElementsMatch(t, exp, got.Validators)
+}
+
+func TestScheduleValsetSnapshots(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	var (
+		myContractAddr       = sdk.AccAddress(rand.Bytes(address.Len))
+		myLegacyContractAddr = sdk.AccAddress(rand.Bytes(address.Len))
+		myRemovedContract    = sdk.AccAddress(rand.Bytes(address.Len))
+		currentHeight        = uint64(ctx.BlockHeight())
+	)
+	require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewInt64Coin("stake", 100_000_000)))
+	require.NoError(t, k.SetMaxCapLimit(ctx, myLegacyContractAddr, sdk.NewInt64Coin("stake", 100_000_000)))
+	require.NoError(t, k.SetMaxCapLimit(ctx, myRemovedContract, sdk.NewInt64Coin("stake", 0)))
+	require.NoError(t, k.SetContractConfig(ctx, myLegacyContractAddr, types.ContractConfig{DisableValsetSnapshots: true}))
+
+	// when
+	require.NoError(t, k.ScheduleValsetSnapshots(ctx))
+
+	// then
+	assert.True(t, k.hasScheduledTaskAt(ctx, types.SchedulerTaskValsetSnapshot, myContractAddr, currentHeight))
+	assert.False(t, k.hasScheduledTaskAt(ctx, types.SchedulerTaskValsetSnapshot, myLegacyContractAddr, currentHeight))
+	assert.False(t, k.hasScheduledTaskAt(ctx, types.SchedulerTaskValsetSnapshot, myRemovedContract, currentHeight))
+}
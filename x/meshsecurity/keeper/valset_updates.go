@@ -1,7 +1,13 @@
 package keeper
 
 import (
+	"sort"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -24,15 +30,74 @@ func (k Keeper) ScheduleUnbonded(ctx sdk.Context, addr sdk.ValAddress) error {
 	return k.sendAsync(ctx, types.ValidatorUnbonded, addr, nil)
 }
 
-// ScheduleSlashed store a validator slash event / data for the valset update report
-func (k Keeper) ScheduleSlashed(ctx sdk.Context, addr sdk.ValAddress, power int64, height int64, totalSlashAmount math.Int, slashRatio sdk.Dec) error {
-	var slashInfo = &types.SlashInfo{
-		Power:            power,
-		InfractionHeight: height,
-		TotalSlashAmount: totalSlashAmount.String(),
-		SlashFraction:    slashRatio.String(),
+// ScheduleSlashed store a validator slash event / data for the valset update report.
+// The infraction time is the unix timestamp of the infraction height or nil when unknown.
+// The contract share of the slash is computed from the given pre-slash delegations, see SnapshotContractDelegations.
+// Without a snapshot, the share is derived from the delegator shares ratio when the report is built.
+func (k Keeper) ScheduleSlashed(ctx sdk.Context, addr sdk.ValAddress, power int64, height int64, infractionTime *int64, totalSlashAmount math.Int, slashRatio sdk.Dec, preSlashDelegations map[string]math.Int) error {
+	return k.sendAsync(ctx, types.ValidatorSlashed, addr, func(contractAddr sdk.AccAddress, entry *types.ValsetOutboxEntry) {
+		slashInfo := &types.SlashInfo{
+			Power:            power,
+			InfractionHeight: height,
+			TotalSlashAmount: totalSlashAmount.String(),
+			SlashFraction:    slashRatio.String(),
+		}
+		if infractionTime != nil {
+			slashInfo.InfractionTime, slashInfo.InfractionTimeKnown = *infractionTime, true
+		}
+		if preSlashDelegations != nil {
+			delegatedAmount, ok := preSlashDelegations[contractAddr.String()]
+			if !ok {
+				delegatedAmount = math.ZeroInt()
+			}
+			// delegator shares are not modified by a slash, the difference in tokens is the contract share
+			slashAmount := math.MaxInt(delegatedAmount.Sub(k.contractDelegatedAmount(ctx, contractAddr, addr)), math.ZeroInt())
+			slashInfo.DelegatedAmount = delegatedAmount.String()
+			slashInfo.SlashAmount = slashAmount.String()
+		}
+		entry.SlashInfo = slashInfo
+	})
+}
+
+// SnapshotContractDelegations returns the amounts delegated to the validator by all registered virtual staking contracts
+// and by the contracts with a recorded total delegated amount. To be called before the slash is executed.
+func (k Keeper) SnapshotContractDelegations(ctx sdk.Context, valAddr sdk.ValAddress) map[string]math.Int {
+	r := make(map[string]math.Int)
+	snapshot := func(contractAddr sdk.AccAddress, m math.Int) bool {
+		if _, exists := r[contractAddr.String()]; !exists && m.GT(math.ZeroInt()) {
+			r[contractAddr.String()] = k.contractDelegatedAmount(ctx, contractAddr, valAddr)
+		}
+		return false
+	}
+	k.IterateMaxCapLimit(ctx, snapshot)
+	k.IterateTotalDelegated(ctx, snapshot)
+	return r
+}
+
+// CaptureSlashedAmounts adds the difference between the given pre-slash delegations and the current delegations
+// to the slashed amounts of the contracts. To be called after the slash is executed.
+func (k Keeper) CaptureSlashedAmounts(ctx sdk.Context, valAddr sdk.ValAddress, preSlashDelegations map[string]math.Int) {
+	for contract, delegatedAmount := range preSlashDelegations {
+		contractAddr := sdk.MustAccAddressFromBech32(contract)
+		slashAmount := delegatedAmount.Sub(k.contractDelegatedAmount(ctx, contractAddr, valAddr))
+		if !slashAmount.IsPositive() {
+			continue
+		}
+		k.setSlashedAmount(ctx, contractAddr, k.GetSlashedAmount(ctx, contractAddr).AddAmount(slashAmount))
+	}
+}
+
+// contractDelegatedAmount returns the amount of tokens delegated by the contract to the validator
+func (k Keeper) contractDelegatedAmount(ctx sdk.Context, contractAddr sdk.AccAddress, valAddr sdk.ValAddress) math.Int {
+	validator, found := k.Staking.GetValidator(ctx, valAddr)
+	if !found {
+		return math.ZeroInt()
+	}
+	delegation, found := k.Staking.GetDelegation(ctx, contractAddr, valAddr)
+	if !found {
+		return math.ZeroInt()
 	}
-	return k.sendAsync(ctx, types.ValidatorSlashed, addr, slashInfo)
+	return validator.TokensFromShares(delegation.GetShares()).TruncateInt()
 }
 
 // ScheduleJailed store a validator update to jailed status for the valset update report
@@ -40,9 +105,36 @@ func (k Keeper) ScheduleJailed(ctx sdk.Context, addr sdk.ValAddress) error {
 	return k.sendAsync(ctx, types.ValidatorJailed, addr, nil)
 }
 
-// ScheduleTombstoned store a validator update to tombstoned status for the valset update report
-func (k Keeper) ScheduleTombstoned(ctx sdk.Context, addr sdk.ValAddress) error {
-	return k.sendAsync(ctx, types.ValidatorTombstoned, addr, nil)
+// ScheduleTombstoned store a validator update to tombstoned status with the evidence details for the valset update report
+func (k Keeper) ScheduleTombstoned(ctx sdk.Context, addr sdk.ValAddress, tombstoneInfo *types.TombstoneInfo) error {
+	return k.sendAsync(ctx, types.ValidatorTombstoned, addr, func(_ sdk.AccAddress, entry *types.ValsetOutboxEntry) {
+		entry.TombstoneInfo = tombstoneInfo
+	})
+}
+
+// CaptureTombstoneEvidence stores the evidence details for the validator tombstone that follows in the current block.
+// The details are kept in the memory store only.
+func (k Keeper) CaptureTombstoneEvidence(ctx sdk.Context, consAddr sdk.ConsAddress, info types.TombstoneInfo) {
+	bz := append(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())), k.cdc.MustMarshal(&info)...)
+	ctx.KVStore(k.memKey).Set(types.BuildTombstoneEvidenceKey(consAddr), bz)
+}
+
+// takeTombstoneEvidence returns and deletes the evidence details captured for the validator in the current block.
+// Returns nil when none exists.
+func (k Keeper) takeTombstoneEvidence(ctx sdk.Context, consAddr sdk.ConsAddress) *types.TombstoneInfo {
+	store := ctx.KVStore(k.memKey)
+	key := types.BuildTombstoneEvidenceKey(consAddr)
+	bz := store.Get(key)
+	if bz == nil {
+		return nil
+	}
+	store.Delete(key)
+	if len(bz) < 8 || sdk.BigEndianToUint64(bz[0:8]) != uint64(ctx.BlockHeight()) {
+		return nil
+	}
+	var info types.TombstoneInfo
+	k.cdc.MustUnmarshal(bz[8:], &info)
+	return &info
 }
 
 // ScheduleUnjailed store a validator update to unjailed status for the valset update report
@@ -55,15 +147,19 @@ func (k Keeper) ScheduleModified(ctx sdk.Context, addr sdk.ValAddress) error {
 	return k.sendAsync(ctx, types.ValidatorModified, addr, nil)
 }
 
-// instead of sync calls to the contracts for the different kind of valset changes in a block, we store them in the mem db
-// and async send to all registered contracts in the end blocker
-func (k Keeper) sendAsync(ctx sdk.Context, op types.PipedValsetOperation, valAddr sdk.ValAddress, slashInfo *types.SlashInfo) error {
+// instead of sync calls to the contracts for the different kind of valset changes in a block, we store them in the
+// outbox of every registered contract and async send them in the end blocker. The entries are kept until the contract
+// has acknowledged them, see AckValsetOutbox.
+// The optional callback sets the operation details of the outbox entry for the contract.
+func (k Keeper) sendAsync(ctx sdk.Context, op types.PipedValsetOperation, valAddr sdk.ValAddress, setDetails func(contractAddr sdk.AccAddress, entry *types.ValsetOutboxEntry)) error {
 	ModuleLogger(ctx).Debug("storing for async update", "operation", int(op), "val", valAddr.String())
-	ctx.KVStore(k.memKey).Set(types.BuildPipedValsetOpKey(op, valAddr, slashInfo), []byte{})
 	// and schedule an update callback for all registered contracts
 	var innerErr error
 	k.IterateMaxCapLimit(ctx, func(contractAddr sdk.AccAddress, m math.Int) bool {
 		if m.GT(math.ZeroInt()) {
+			if innerErr = k.appendValsetOutboxEntry(ctx, contractAddr, op, valAddr, setDetails); innerErr != nil {
+				return true
+			}
 			innerErr = k.ScheduleOneShotTask(ctx, types.SchedulerTaskValsetUpdate, contractAddr, uint64(ctx.BlockHeight()))
 			if innerErr != nil {
 				return true
@@ -74,114 +170,374 @@ func (k Keeper) sendAsync(ctx sdk.Context, op types.PipedValsetOperation, valAdd
 	return innerErr
 }
 
-// ValsetUpdateReport aggregate all stored changes of the current block. Should be called by an end-blocker.
-// The events reported are categorized by type and not time. Conflicting events as Bonded/ Unbonded
-// are not supposed to happen within the same block
-func (k Keeper) ValsetUpdateReport(ctx sdk.Context) (contract.ValsetUpdate, error) {
+// appendValsetOutboxEntry stores the valset operation with the next sequence number in the outbox of the contract.
+// Pending entries that are superseded by the new operation are removed, see valsetStateKey. This keeps the outbox
+// bounded by the number of validators when the contract does not acknowledge the entries, for example because the
+// delivery keeps failing or the tasks are paused. Only slashes with different details are accumulated.
+func (k Keeper) appendValsetOutboxEntry(ctx sdk.Context, contractAddr sdk.AccAddress, op types.PipedValsetOperation, valAddr sdk.ValAddress, setDetails func(contractAddr sdk.AccAddress, entry *types.ValsetOutboxEntry)) error {
+	entry := types.ValsetOutboxEntry{
+		Contract:  contractAddr.String(),
+		Sequence:  k.getLastValsetOutboxSeq(ctx, contractAddr) + 1,
+		Height:    ctx.BlockHeight(),
+		Time:      ctx.BlockTime().Unix(),
+		Operation: uint32(op),
+		Validator: valAddr.String(),
+	}
+	if setDetails != nil {
+		setDetails(contractAddr, &entry)
+	}
+	key := string(valsetStateKey(op, valAddr, entry.SlashInfo))
+	var superseded []types.ValsetOutboxEntry
+	k.IterateValsetOutbox(ctx, contractAddr, func(pending types.ValsetOutboxEntry) bool {
+		if pending.Validator != entry.Validator {
+			return false
+		}
+		pendingOp := types.PipedValsetOperation(pending.Operation)
+		if types.ValidateValsetOperation(pending.Operation) != nil ||
+			(pendingOp == types.ValidatorSlashed && pending.SlashInfo == nil) {
+			return false
+		}
+		if string(valsetStateKey(pendingOp, valAddr, pending.SlashInfo)) == key {
+			superseded = append(superseded, pending)
+		}
+		return false
+	})
+	store := ctx.KVStore(k.storeKey)
+	for i, pending := range superseded {
+		if i == 0 && op == types.ValidatorPowerChanged && entry.PowerInfo != nil && pending.PowerInfo != nil {
+			// the latest power is reported with the power of the previous report
+			entry.PowerInfo.PreviousPower = pending.PowerInfo.PreviousPower
+		}
+		store.Delete(types.BuildValsetOutboxKey(contractAddr, pending.Sequence))
+	}
+	return k.setValsetOutboxEntry(ctx, entry)
+}
+
+// valsetStateKey returns a key for the validator state that is set by the valset operation. Operations with the
+// same key supersede each other so that only the latest one is reported: bonded and unbonded, jailed and unjailed,
+// as well as duplicates of the same operation. Slashes are distinct for different slash details.
+func valsetStateKey(op types.PipedValsetOperation, valAddr sdk.ValAddress, slashInfo *types.SlashInfo) []byte {
+	switch op {
+	case types.ValidatorUnbonded:
+		op = types.ValidatorBonded
+	case types.ValidatorUnjailed:
+		op = types.ValidatorJailed
+	}
+	return types.BuildValsetOperationKey(op, valAddr, slashInfo)
+}
+
+// setValsetOutboxEntry stores the entry in the outbox of the contract. The outbox sequence of the contract is moved
+// forward when the entry sequence is higher.
+func (k Keeper) setValsetOutboxEntry(ctx sdk.Context, entry types.ValsetOutboxEntry) error {
+	contractAddr, err := sdk.AccAddressFromBech32(entry.Contract)
+	if err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	bz, err := k.cdc.Marshal(&entry)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BuildValsetOutboxKey(contractAddr, entry.Sequence), bz)
+	if entry.Sequence > k.getLastValsetOutboxSeq(ctx, contractAddr) {
+		store.Set(types.BuildValsetOutboxSeqKey(contractAddr), sdk.Uint64ToBigEndian(entry.Sequence))
+	}
+	return nil
+}
+
+// getLastValsetOutboxSeq returns the last sequence number used in the outbox of the contract or 0
+func (k Keeper) getLastValsetOutboxSeq(ctx sdk.Context, contractAddr sdk.AccAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.BuildValsetOutboxSeqKey(contractAddr))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// AckValsetOutbox deletes all entries up to the given sequence (included) from the outbox of the contract.
+// Should be called only after the entries were delivered successfully.
+func (k Keeper) AckValsetOutbox(ctx sdk.Context, contractAddr sdk.AccAddress, seq uint64) {
+	var keys [][]byte
+	pStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BuildValsetOutboxContractPrefix(contractAddr))
+	iter := pStore.Iterator(nil, sdk.Uint64ToBigEndian(seq+1))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	_ = iter.Close()
+	for _, k := range keys {
+		pStore.Delete(k)
+	}
+}
+
+// IterateValsetOutbox iterate through all pending entries in the outbox of the contract ordered by sequence
+// Callback can return true to stop early
+func (k Keeper) IterateValsetOutbox(ctx sdk.Context, contractAddr sdk.AccAddress, cb func(entry types.ValsetOutboxEntry) bool) {
+	k.iterateValsetOutbox(ctx, types.BuildValsetOutboxContractPrefix(contractAddr), cb)
+}
+
+func (k Keeper) iterateValsetOutbox(ctx sdk.Context, keyPrefix []byte, cb func(entry types.ValsetOutboxEntry) bool) {
+	pStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := pStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.ValsetOutboxEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		if cb(entry) {
+			return
+		}
+	}
+}
+
+// ValsetUpdateReport aggregate all pending changes in the outbox of the contract. Should be called by an end-blocker.
+// The events reported are categorized by type and not time. Duplicate operations are reported only once.
+// Conflicting operations for a validator are collapsed to the latest state: a validator is reported either as
+// bonded or unbonded and either as jailed or unjailed, depending on the last operation in sequence order.
+// The last sequence number included in the report is returned to acknowledge the entries after a successful delivery.
+// It is 0 when the outbox is empty.
+func (k Keeper) ValsetUpdateReport(ctx sdk.Context, contractAddr sdk.AccAddress) (contract.ValsetUpdate, uint64, error) {
 	var innerErr error
-	appendValidator := func(set *[]wasmvmtypes.Validator, valAddr sdk.ValAddress) bool {
+	appendValidator := func(set *[]contract.ValidatorInfo, valAddr sdk.ValAddress) bool {
 		val, ok := k.Staking.GetValidator(ctx, valAddr)
 		if !ok {
 			innerErr = types.ErrUnknown.Wrapf("validator %s", valAddr)
 			return true
 		}
-		*set = append(*set, ConvertSdkValidatorToWasm(val))
+		*set = append(*set, ConvertSdkValidatorToInfo(val))
 		return false
 	}
-	slashValidator := func(set *[]outmessage.ValidatorSlash, valAddr sdk.ValAddress, power int64, infractionHeight int64,
-		infractionTime int64, slashAmount string, slashRatio string) bool {
+	bondDenom := k.Staking.BondDenom(ctx)
+	slashValidator := func(set *[]outmessage.ValidatorSlash, valAddr sdk.ValAddress, slashInfo *types.SlashInfo, height int64, time int64) bool {
+		slashAmount, delegatedAmount, err := k.contractSlashAmounts(ctx, contractAddr, valAddr, slashInfo)
+		if err != nil {
+			innerErr = err
+			return true
+		}
+		if slashAmount.IsZero() { // nothing to reconcile for the contract
+			return false
+		}
 		valSlash := outmessage.ValidatorSlash{
 			ValidatorAddr:    valAddr.String(),
-			Power:            power,
-			InfractionHeight: infractionHeight,
-			InfractionTime:   infractionTime,
-			Height:           ctx.BlockHeight(),
-			Time:             ctx.BlockTime().Unix(),
-			SlashAmount:      slashAmount,
-			SlashRatio:       slashRatio,
+			Power:            slashInfo.Power,
+			InfractionHeight: slashInfo.InfractionHeight,
+			InfractionTime:   knownInfractionTime(slashInfo.InfractionTime, slashInfo.InfractionTimeKnown),
+			Height:           height,
+			Time:             time,
+			SlashAmount:      wasmkeeper.ConvertSdkCoinToWasmCoin(sdk.NewCoin(bondDenom, slashAmount)),
+			DelegatedAmount:  wasmkeeper.ConvertSdkCoinToWasmCoin(sdk.NewCoin(bondDenom, delegatedAmount)),
+			SlashRatio:       slashInfo.SlashFraction,
 		}
 		*set = append(*set, valSlash)
 		return false
 	}
+	appendPowerChange := func(set *[]contract.ValidatorPowerChange, valAddr sdk.ValAddress, powerInfo *types.PowerInfo) bool {
+		tokens, ok := math.NewIntFromString(powerInfo.Tokens)
+		if !ok {
+			innerErr = types.ErrInvalid.Wrapf("tokens %s", powerInfo.Tokens)
+			return true
+		}
+		*set = append(*set, contract.ValidatorPowerChange{
+			ValidatorAddr: valAddr.String(),
+			Power:         powerInfo.Power,
+			PreviousPower: powerInfo.PreviousPower,
+			Tokens:        wasmkeeper.ConvertSdkCoinToWasmCoin(sdk.NewCoin(bondDenom, tokens)),
+		})
+		return false
+	}
 	r := contract.ValsetUpdate{ // init with empty slices for contract that does not handle null or omitted fields
-		Additions:  make([]contract.Validator, 0),
-		Removals:   make([]contract.ValidatorAddr, 0),
-		Updated:    make([]contract.Validator, 0),
-		Jailed:     make([]contract.ValidatorAddr, 0),
-		Unjailed:   make([]contract.ValidatorAddr, 0),
-		Tombstoned: make([]contract.ValidatorAddr, 0),
-		Slashed:    make([]contract.ValidatorSlash, 0),
-	}
-	err := k.iteratePipedValsetOperations(ctx, func(valAddr sdk.ValAddress, op types.PipedValsetOperation, slashInfo *types.SlashInfo) bool {
-		switch op {
+		Additions:    make([]contract.ValidatorInfo, 0),
+		Removals:     make([]contract.ValidatorAddr, 0),
+		Updated:      make([]contract.ValidatorInfo, 0),
+		Jailed:       make([]contract.ValidatorAddr, 0),
+		Unjailed:     make([]contract.ValidatorAddr, 0),
+		Tombstoned:   make([]contract.ValidatorTombstone, 0),
+		Slashed:      make([]contract.ValidatorSlash, 0),
+		PowerChanges: make([]contract.ValidatorPowerChange, 0),
+	}
+	// remove duplicates and superseded operations and sort by the operation key
+	var lastSeq uint64
+	type pendingOp struct {
+		valAddr sdk.ValAddress
+		entry   types.ValsetOutboxEntry
+	}
+	ops := make(map[string]pendingOp)
+	k.IterateValsetOutbox(ctx, contractAddr, func(entry types.ValsetOutboxEntry) bool {
+		lastSeq = entry.Sequence
+		if innerErr = types.ValidateValsetOperation(entry.Operation); innerErr != nil {
+			return true
+		}
+		op := types.PipedValsetOperation(entry.Operation)
+		if op == types.ValidatorSlashed && entry.SlashInfo == nil {
+			innerErr = types.ErrInvalid.Wrapf("empty slash info for entry %d", entry.Sequence)
+			return true
+		}
+		if op == types.ValidatorPowerChanged && entry.PowerInfo == nil {
+			innerErr = types.ErrInvalid.Wrapf("empty power info for entry %d", entry.Sequence)
+			return true
+		}
+		valAddr, err := sdk.ValAddressFromBech32(entry.Validator)
+		if err != nil {
+			innerErr = errorsmod.Wrapf(err, "validator of entry %d", entry.Sequence)
+			return true
+		}
+		key := string(valsetStateKey(op, valAddr, entry.SlashInfo))
+		if prev, exists := ops[key]; exists && op == types.ValidatorPowerChanged {
+			// the latest power is reported with the power of the previous report
+			entry.PowerInfo.PreviousPower = prev.entry.PowerInfo.PreviousPower
+		}
+		ops[key] = pendingOp{valAddr: valAddr, entry: entry}
+		return false
+	})
+	if innerErr != nil {
+		return r, 0, innerErr
+	}
+	keys := make([]string, 0, len(ops))
+	for key := range ops {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		valAddr, entry := ops[key].valAddr, ops[key].entry
+		var stop bool
+		switch types.PipedValsetOperation(entry.Operation) {
 		case types.ValidatorBonded:
-			return appendValidator(&r.Additions, valAddr)
+			stop = appendValidator(&r.Additions, valAddr)
 		case types.ValidatorUnbonded:
 			r.Removals = append(r.Removals, valAddr.String())
 		case types.ValidatorJailed:
 			r.Jailed = append(r.Jailed, valAddr.String())
 		case types.ValidatorTombstoned:
-			r.Tombstoned = append(r.Tombstoned, valAddr.String())
+			r.Tombstoned = append(r.Tombstoned, newValidatorTombstone(valAddr, entry))
 		case types.ValidatorUnjailed:
 			r.Unjailed = append(r.Unjailed, valAddr.String())
 		case types.ValidatorModified:
-			return appendValidator(&r.Updated, valAddr)
+			stop = appendValidator(&r.Updated, valAddr)
 		case types.ValidatorSlashed:
-			// TODO: Add / send the infraction time
-			return slashValidator(&r.Slashed, valAddr, slashInfo.Power, slashInfo.InfractionHeight, 0,
-				slashInfo.TotalSlashAmount, slashInfo.SlashFraction)
-		default:
-			innerErr = types.ErrInvalid.Wrapf("undefined operation type %X", op)
-			return true
+			stop = slashValidator(&r.Slashed, valAddr, entry.SlashInfo, entry.Height, entry.Time)
+		case types.ValidatorPowerChanged:
+			stop = appendPowerChange(&r.PowerChanges, valAddr, entry.PowerInfo)
+		}
+		if stop {
+			return r, 0, innerErr
 		}
-		return false
-	})
-	if err != nil {
-		return r, err
 	}
-	return r, innerErr
+	return r, lastSeq, nil
 }
 
-// ClearPipedValsetOperations delete all entries from the temporary store that contains the valset updates.
-func (k Keeper) ClearPipedValsetOperations(ctx sdk.Context) {
-	var keys [][]byte
-	pStore := prefix.NewStore(ctx.KVStore(k.memKey), types.PipedValsetPrefix)
-	iter := pStore.Iterator(nil, nil)
-	for ; iter.Valid(); iter.Next() {
-		keys = append(keys, iter.Key())
+// newValidatorTombstone converts the outbox entry to a tombstone with the evidence details. Entries stored without
+// evidence details are reported with the unspecified evidence type.
+func newValidatorTombstone(valAddr sdk.ValAddress, entry types.ValsetOutboxEntry) contract.ValidatorTombstone {
+	r := contract.ValidatorTombstone{
+		ValidatorAddr: valAddr.String(),
+		EvidenceType:  InfractionType(stakingtypes.Infraction_INFRACTION_UNSPECIFIED),
+		Height:        entry.Height,
+		Time:          entry.Time,
 	}
-	_ = iter.Close()
-	for _, k := range keys {
-		pStore.Delete(k)
+	if info := entry.TombstoneInfo; info != nil {
+		r.ConsAddress = info.ConsAddress
+		r.EvidenceType = info.EvidenceType
+		r.InfractionHeight = info.InfractionHeight
+		r.InfractionTime = knownInfractionTime(info.InfractionTime, info.InfractionTimeKnown)
 	}
+	return r
 }
 
-// iterate through all stored valset updates. Due to the storage key, there are no contract duplicates within an operation type.
-func (k Keeper) iteratePipedValsetOperations(ctx sdk.Context, cb func(valAddress sdk.ValAddress, op types.PipedValsetOperation, slashInfo *types.SlashInfo) bool) error {
-	pStore := prefix.NewStore(ctx.KVStore(k.memKey), types.PipedValsetPrefix)
-	iter := pStore.Iterator(nil, nil)
-	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-		addrLen := key[0]
-		addr, op := key[1:addrLen+1], key[addrLen+1]
-		var slashInfo *types.SlashInfo = nil
-		if types.PipedValsetOperation(op) == types.ValidatorSlashed {
-			if len(key) <= 1+int(addrLen)+1+8+8+1 {
-				return types.ErrInvalid.Wrapf("invalid slash key length %d", len(key))
-			}
-			totalSlashAmountLen := key[addrLen+2+8+8]
-			slashInfo = &types.SlashInfo{
-				Power:            int64(sdk.BigEndianToUint64(key[addrLen+2 : addrLen+2+8])),
-				InfractionHeight: int64(sdk.BigEndianToUint64(key[addrLen+2+8 : addrLen+2+8+8])),
-				TotalSlashAmount: string(key[addrLen+2+8+8+1 : addrLen+2+8+8+1+totalSlashAmountLen]),
-				SlashFraction:    string(key[addrLen+2+8+8+1+totalSlashAmountLen:]),
-			}
+// InfractionType returns the lower case infraction name without prefix, e.g. double_sign
+func InfractionType(infraction stakingtypes.Infraction) string {
+	return strings.ToLower(strings.TrimPrefix(infraction.String(), "INFRACTION_"))
+}
+
+// contractSlashAmounts returns the share of the slash for the delegation of the contract to the validator and the
+// delegated amount before the slash. The amounts snapshotted at the moment of the slash are used when set. Otherwise,
+// they are derived from the total slash amount and the current delegator shares ratio.
+func (k Keeper) contractSlashAmounts(ctx sdk.Context, contractAddr sdk.AccAddress, valAddr sdk.ValAddress, slashInfo *types.SlashInfo) (math.Int, math.Int, error) {
+	if slashInfo.SlashAmount != "" {
+		slashAmount, ok := math.NewIntFromString(slashInfo.SlashAmount)
+		if !ok {
+			return math.ZeroInt(), math.ZeroInt(), types.ErrInvalid.Wrapf("slash amount %s", slashInfo.SlashAmount)
 		}
-		if cb(addr, types.PipedValsetOperation(op), slashInfo) {
-			break
+		delegatedAmount, ok := math.NewIntFromString(slashInfo.DelegatedAmount)
+		if !ok {
+			return math.ZeroInt(), math.ZeroInt(), types.ErrInvalid.Wrapf("delegated amount %s", slashInfo.DelegatedAmount)
 		}
+		return slashAmount, delegatedAmount, nil
+	}
+	totalSlashAmount, ok := math.NewIntFromString(slashInfo.TotalSlashAmount)
+	if !ok {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrInvalid.Wrapf("total slash amount %s", slashInfo.TotalSlashAmount)
+	}
+	validator, found := k.Staking.GetValidator(ctx, valAddr)
+	if !found {
+		return math.ZeroInt(), math.ZeroInt(), types.ErrUnknown.Wrapf("validator %s", valAddr)
+	}
+	validatorShares := validator.GetDelegatorShares()
+	delegation, found := k.Staking.GetDelegation(ctx, contractAddr, valAddr)
+	if !found || validatorShares.IsZero() {
+		return math.ZeroInt(), math.ZeroInt(), nil
+	}
+	delegatorShares := delegation.GetShares()
+	slashAmount := delegatorShares.Quo(validatorShares).MulInt(totalSlashAmount).RoundInt()
+	delegatedAmount := validator.TokensFromShares(delegatorShares).TruncateInt().Add(slashAmount)
+	return slashAmount, delegatedAmount, nil
+}
+
+// GetBlockTime returns the block time for the given height from the staking historical info.
+// Returns false when the height is not in the range of the stored historical entries.
+func (k Keeper) GetBlockTime(ctx sdk.Context, height int64) (time.Time, bool) {
+	if height == ctx.BlockHeight() {
+		return ctx.BlockTime(), true
+	}
+	hi, found := k.Staking.GetHistoricalInfo(ctx, height)
+	if !found {
+		return time.Time{}, false
+	}
+	return hi.Header.Time, true
+}
+
+// infractionTime returns the unix timestamp of the block at the infraction height or
+// nil when the historical info is not available.
+func (k Keeper) infractionTime(ctx sdk.Context, infractionHeight int64) *int64 {
+	t, found := k.GetBlockTime(ctx, infractionHeight)
+	if !found {
+		ModuleLogger(ctx).
+			Error("infraction time unknown: no historical info", "height", infractionHeight)
+		return nil
+	}
+	r := t.Unix()
+	return &r
+}
+
+// knownInfractionTime returns the stored infraction time or nil when unknown
+func knownInfractionTime(t int64, known bool) *int64 {
+	if !known {
+		return nil
+	}
+	return &t
+}
+
+// InfractionHeight returns the height of the infraction from the distribution height that is passed to the slash
+// methods. The evidence module subtracts the validator update delay from the evidence height, the slashing module
+// subtracts the update delay and one block from the current height for downtime.
+func InfractionHeight(distributionHeight int64, infraction stakingtypes.Infraction) int64 {
+	switch infraction {
+	case stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN:
+		return distributionHeight + sdk.ValidatorUpdateDelay
+	case stakingtypes.Infraction_INFRACTION_DOWNTIME:
+		return distributionHeight + sdk.ValidatorUpdateDelay + 1
+	default:
+		return distributionHeight
+	}
+}
+
+// ConvertSdkValidatorToInfo helper method that converts the validator with the extended metadata
+func ConvertSdkValidatorToInfo(v stakingtypes.Validator) contract.ValidatorInfo {
+	r := contract.ValidatorInfo{
+		Validator: ConvertSdkValidatorToWasm(v),
+		Moniker:   v.Description.Moniker,
+	}
+	if pk, err := v.ConsPubKey(); err == nil {
+		r.ConsPubKey = pk.Bytes()
 	}
-	return iter.Close()
+	return r
 }
 
 // ConvertSdkValidatorToWasm helper method
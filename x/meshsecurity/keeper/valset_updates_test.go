@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"bytes"
+	"fmt"
 	stdrand "math/rand"
 	"testing"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/cometbft/cometbft/libs/rand"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,7 +29,9 @@ func TestSendAsync(t *testing.T) {
 		myOtherValAddr              = sdk.ValAddress(rand.Bytes(address.Len))
 		myVStakingContractAddr      = sdk.AccAddress(rand.Bytes(address.Len))
 		myOtherVStakingContractAddr = sdk.AccAddress(rand.Bytes(address.Len))
+		myContractAddr              = sdk.AccAddress(rand.Bytes(address.Len))
 	)
+	require.NoError(t, k.SetMaxCapLimit(pCtx, myContractAddr, sdk.NewCoin("stake", sdkmath.NewInt(100_000_000))))
 	specs := map[string]struct {
 		setup  func(t *testing.T, ctx sdk.Context)
 		assert func(t *testing.T, ctx sdk.Context, ops map[string][]types.PipedValsetOperation)
@@ -98,7 +102,7 @@ func TestSendAsync(t *testing.T) {
 			gotErr := k.sendAsync(ctx, types.ValidatorModified, myValAddr, nil)
 			// then
 			require.NoError(t, gotErr)
-			allStoredOps := FetchAllStoredOperations(t, ctx, k)
+			allStoredOps := FetchAllStoredOperations(t, ctx, k, myContractAddr)
 			spec.assert(t, ctx, allStoredOps)
 		})
 	}
@@ -113,11 +117,14 @@ func TestBuildValsetUpdateReport(t *testing.T) {
 	)
 	ctx, keepers := CreateDefaultTestInput(t)
 	k := keepers.MeshKeeper
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewCoin("stake", sdkmath.NewInt(100_000_000))))
 	vals := make(map[string]stakingtypes.Validator)
 	for i, v := range []sdk.ValAddress{val1, val2, val3, val4} {
 		val := MinValidatorFixture(t)
 		val.OperatorAddress = v.String()
 		val.Commission.CommissionRates.Rate = sdkmath.LegacyNewDec(int64(i + 1))
+		val.Description.Moniker = fmt.Sprintf("my moniker %d", i+1)
 		keepers.StakingKeeper.SetValidator(ctx, val)
 		vals[v.String()] = val
 	}
@@ -144,52 +151,250 @@ func TestBuildValsetUpdateReport(t *testing.T) {
 	for _, v := range allOps {
 		require.NoError(t, k.sendAsync(ctx, v.op, v.valAddr, nil))
 	}
+	// and duplicates
+	require.NoError(t, k.sendAsync(ctx, types.ValidatorJailed, val1, nil))
 	// when
-	got, err := k.ValsetUpdateReport(ctx)
+	got, lastSeq, err := k.ValsetUpdateReport(ctx, myContractAddr)
 	// then
 	require.NoError(t, err)
-	exp := contract.ValsetUpdate{
-		Additions: []contract.Validator{
-			{
-				Address:       val4.String(),
-				Commission:    "4.000000000000000000",
-				MaxCommission: "0.000000000000000000",
-				MaxChangeRate: "0.000000000000000000",
-			},
-		},
-		Removals: []contract.ValidatorAddr{val3.String()},
-		Updated: []contract.Validator{
-			{
-				Address:       val2.String(),
-				Commission:    "2.000000000000000000",
-				MaxCommission: "0.000000000000000000",
-				MaxChangeRate: "0.000000000000000000",
-			},
-			{
-				Address:       val3.String(),
-				Commission:    "3.000000000000000000",
-				MaxCommission: "0.000000000000000000",
-				MaxChangeRate: "0.000000000000000000",
-			},
-			{
-				Address:       val4.String(),
-				Commission:    "4.000000000000000000",
+	assert.Equal(t, uint64(len(allOps)+1), lastSeq)
+	info := func(valAddr sdk.ValAddress, commission string) contract.ValidatorInfo {
+		pk, err := vals[valAddr.String()].ConsPubKey()
+		require.NoError(t, err)
+		return contract.ValidatorInfo{
+			Validator: contract.Validator{
+				Address:       valAddr.String(),
+				Commission:    commission,
 				MaxCommission: "0.000000000000000000",
 				MaxChangeRate: "0.000000000000000000",
 			},
+			Moniker:    vals[valAddr.String()].Description.Moniker,
+			ConsPubKey: pk.Bytes(),
+		}
+	}
+	exp := contract.ValsetUpdate{
+		Additions: []contract.ValidatorInfo{info(val4, "4.000000000000000000")},
+		Removals:  []contract.ValidatorAddr{val3.String()},
+		Updated: []contract.ValidatorInfo{
+			info(val2, "2.000000000000000000"),
+			info(val3, "3.000000000000000000"),
+			info(val4, "4.000000000000000000"),
 		},
-		Jailed:     []contract.ValidatorAddr{val1.String(), val3.String()},
-		Unjailed:   []contract.ValidatorAddr{val2.String()},
-		Slashed:    []contract.ValidatorSlash{},
-		Tombstoned: []contract.ValidatorAddr{val3.String()},
+		Jailed:       []contract.ValidatorAddr{val1.String(), val3.String()},
+		Unjailed:     []contract.ValidatorAddr{val2.String()},
+		Slashed:      []contract.ValidatorSlash{},
+		PowerChanges: []contract.ValidatorPowerChange{},
+		Tombstoned: []contract.ValidatorTombstone{{
+			ValidatorAddr: val3.String(),
+			EvidenceType:  "unspecified",
+			Height:        ctx.BlockHeight(),
+			Time:          ctx.BlockTime().Unix(),
+		}},
 	}
 	assert.Equal(t, exp, got)
 }
 
+func TestValsetUpdateReportConflictingOperations(t *testing.T) {
+	var (
+		val1 = sdk.ValAddress(bytes.Repeat([]byte{1}, address.Len))
+		val2 = sdk.ValAddress(bytes.Repeat([]byte{2}, address.Len))
+	)
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewCoin("stake", sdkmath.NewInt(100_000_000))))
+	for _, v := range []sdk.ValAddress{val1, val2} {
+		val := MinValidatorFixture(t)
+		val.OperatorAddress = v.String()
+		keepers.StakingKeeper.SetValidator(ctx, val)
+	}
+	// a contract that does not acknowledge the entries
+	for i := 0; i < 10; i++ {
+		require.NoError(t, k.ScheduleJailed(ctx, val1))
+		require.NoError(t, k.ScheduleUnjailed(ctx, val1))
+		require.NoError(t, k.ScheduleUnbonded(ctx, val1))
+		require.NoError(t, k.ScheduleBonded(ctx, val1))
+		require.NoError(t, k.ScheduleBonded(ctx, val2))
+		require.NoError(t, k.ScheduleUnbonded(ctx, val2))
+		require.NoError(t, k.ScheduleUnjailed(ctx, val2))
+		require.NoError(t, k.ScheduleJailed(ctx, val2))
+	}
+	// then the outbox keeps only the latest state per validator
+	exp := map[string][]types.PipedValsetOperation{
+		val1.String(): {types.ValidatorBonded, types.ValidatorUnjailed},
+		val2.String(): {types.ValidatorUnbonded, types.ValidatorJailed},
+	}
+	assert.Equal(t, exp, FetchAllStoredOperations(t, ctx, k, myContractAddr))
+	var count int
+	k.IterateValsetOutbox(ctx, myContractAddr, func(types.ValsetOutboxEntry) bool {
+		count++
+		return false
+	})
+	assert.Equal(t, 4, count)
+
+	// when entries were stored without collapsing, for example by genesis import
+	for _, op := range []types.PipedValsetOperation{types.ValidatorBonded, types.ValidatorJailed, types.ValidatorUnbonded} {
+		require.NoError(t, k.setValsetOutboxEntry(ctx, types.ValsetOutboxEntry{
+			Contract:  myContractAddr.String(),
+			Sequence:  k.getLastValsetOutboxSeq(ctx, myContractAddr) + 1,
+			Operation: uint32(op),
+			Validator: val1.String(),
+		}))
+	}
+	got, lastSeq, err := k.ValsetUpdateReport(ctx, myContractAddr)
+	// then the report contains the latest state only
+	require.NoError(t, err)
+	assert.Equal(t, k.getLastValsetOutboxSeq(ctx, myContractAddr), lastSeq)
+	assert.Empty(t, got.Additions)
+	assert.Equal(t, []contract.ValidatorAddr{val1.String(), val2.String()}, got.Removals)
+	assert.Equal(t, []contract.ValidatorAddr{val1.String(), val2.String()}, got.Jailed)
+	assert.Empty(t, got.Unjailed)
+}
+
+func TestValsetUpdateReportSlashes(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	myOtherContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	for _, c := range []sdk.AccAddress{myContractAddr, myOtherContractAddr} {
+		require.NoError(t, k.SetMaxCapLimit(pCtx, c, sdk.NewCoin("stake", sdkmath.NewInt(100_000_000))))
+	}
+	// validator state after the slash: 10% of 1_000 tokens slashed, contract owns 40% of the shares
+	val := MinValidatorFixture(t)
+	val.Tokens = sdkmath.NewInt(900)
+	val.DelegatorShares = sdkmath.LegacyNewDec(1_000)
+	keepers.StakingKeeper.SetValidator(pCtx, val)
+	valAddr := val.GetOperator()
+	keepers.StakingKeeper.SetDelegation(pCtx, stakingtypes.NewDelegation(myContractAddr, valAddr, sdkmath.LegacyNewDec(400)))
+	require.NoError(t, k.ScheduleSlashed(pCtx, valAddr, 10, 5, unixTime(pCtx.BlockTime()), sdkmath.NewInt(100), sdkmath.LegacyNewDecWithPrec(1, 1), nil))
+
+	// when
+	got, _, err := k.ValsetUpdateReport(pCtx, myContractAddr)
+	// then
+	require.NoError(t, err)
+	exp := []contract.ValidatorSlash{{
+		ValidatorAddr:    valAddr.String(),
+		Height:           pCtx.BlockHeight(),
+		Time:             pCtx.BlockTime().Unix(),
+		InfractionHeight: 5,
+		InfractionTime:   unixTime(pCtx.BlockTime()),
+		Power:            10,
+		SlashAmount:      wasmvmtypes.NewCoin(40, "stake"),
+		DelegatedAmount:  wasmvmtypes.NewCoin(400, "stake"),
+		SlashRatio:       "0.100000000000000000",
+	}}
+	assert.Equal(t, exp, got.Slashed)
+
+	// when the contract has no delegation to the validator
+	got, lastSeq, err := k.ValsetUpdateReport(pCtx, myOtherContractAddr)
+	// then the entry is dropped
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), lastSeq)
+	assert.Empty(t, got.Slashed)
+}
+
+func TestValsetUpdateReportPreSlashSnapshot(t *testing.T) {
+	ctx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	require.NoError(t, k.SetMaxCapLimit(ctx, myContractAddr, sdk.NewCoin("stake", sdkmath.NewInt(100_000_000))))
+	// validator state before the slash: contract owns 40% of the shares
+	val := MinValidatorFixture(t)
+	val.Tokens = sdkmath.NewInt(1_000)
+	val.DelegatorShares = sdkmath.LegacyNewDec(1_000)
+	keepers.StakingKeeper.SetValidator(ctx, val)
+	valAddr := val.GetOperator()
+	keepers.StakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(myContractAddr, valAddr, sdkmath.LegacyNewDec(400)))
+	preSlashDelegations := k.SnapshotContractDelegations(ctx, valAddr)
+	assert.Equal(t, map[string]sdkmath.Int{myContractAddr.String(): sdkmath.NewInt(400)}, preSlashDelegations)
+
+	// when 10% slashed
+	val.Tokens = sdkmath.NewInt(900)
+	keepers.StakingKeeper.SetValidator(ctx, val)
+	// and the infraction time is unknown
+	require.NoError(t, k.ScheduleSlashed(ctx, valAddr, 10, 5, nil, sdkmath.NewInt(100), sdkmath.LegacyNewDecWithPrec(1, 1), preSlashDelegations))
+	// and the contract delegates more in the same block
+	val.Tokens = sdkmath.NewInt(1_350)
+	val.DelegatorShares = sdkmath.LegacyNewDec(1_500)
+	keepers.StakingKeeper.SetValidator(ctx, val)
+	keepers.StakingKeeper.SetDelegation(ctx, stakingtypes.NewDelegation(myContractAddr, valAddr, sdkmath.LegacyNewDec(900)))
+
+	got, _, err := k.ValsetUpdateReport(ctx, myContractAddr)
+	// then the share is computed from the pre-slash state
+	require.NoError(t, err)
+	require.Len(t, got.Slashed, 1)
+	assert.Equal(t, wasmvmtypes.NewCoin(40, "stake"), got.Slashed[0].SlashAmount)
+	assert.Equal(t, wasmvmtypes.NewCoin(400, "stake"), got.Slashed[0].DelegatedAmount)
+	assert.Nil(t, got.Slashed[0].InfractionTime)
+}
+
+func TestEncodeValsetUpdate(t *testing.T) {
+	pCtx, keepers := CreateDefaultTestInput(t)
+	k := keepers.MeshKeeper
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	report := contract.ValsetUpdate{Additions: []contract.ValidatorInfo{{
+		Validator:  contract.Validator{Address: "myValidator", Commission: "0.1", MaxCommission: "0.2", MaxChangeRate: "0.01"},
+		Moniker:    "my moniker",
+		ConsPubKey: []byte{1, 2},
+	}}, PowerChanges: []contract.ValidatorPowerChange{{
+		ValidatorAddr: "myValidator",
+		Power:         2,
+		PreviousPower: 1,
+		Tokens:        wasmvmtypes.NewCoin(2_000_000, "stake"),
+	}}, Slashed: []contract.ValidatorSlash{{
+		ValidatorAddr:   "myValidator",
+		SlashAmount:     wasmvmtypes.NewCoin(40, "stake"),
+		DelegatedAmount: wasmvmtypes.NewCoin(400, "stake"),
+		SlashRatio:      "0.1",
+	}}, Tombstoned: []contract.ValidatorTombstone{{
+		ValidatorAddr:    "myValidator",
+		ConsAddress:      "myConsAddress",
+		EvidenceType:     "double_sign",
+		InfractionHeight: 1,
+	}}}
+	specs := map[string]struct {
+		config types.ContractConfig
+		exp    string
+	}{
+		"default": {
+			exp: `{"valset_update":{"additions":[{"address":"myValidator","commission":"0.1","max_commission":"0.2","max_change_rate":"0.01","moniker":"my moniker","cons_pubkey":"AQI="}],"removals":null,"updated":null,"jailed":null,"unjailed":null,"tombstoned":[{"address":"myValidator","cons_address":"myConsAddress","evidence_type":"double_sign","height":0,"time":0,"infraction_height":1}],"slashed":[{"address":"myValidator","height":0,"time":0,"infraction_height":0,"power":0,"slash_amount":{"denom":"stake","amount":"40"},"delegated_amount":{"denom":"stake","amount":"400"},"slash_ratio":"0.1"}],"power_changes":[{"address":"myValidator","power":2,"previous_power":1,"tokens":{"denom":"stake","amount":"2000000"}}]}}`,
+		},
+		"legacy slashes": {
+			config: types.ContractConfig{LegacySlashes: true},
+			exp:    `{"valset_update":{"additions":[{"address":"myValidator","commission":"0.1","max_commission":"0.2","max_change_rate":"0.01","moniker":"my moniker","cons_pubkey":"AQI="}],"removals":null,"updated":null,"jailed":null,"unjailed":null,"tombstoned":[{"address":"myValidator","cons_address":"myConsAddress","evidence_type":"double_sign","height":0,"time":0,"infraction_height":1}],"slashed":[{"address":"myValidator","height":0,"time":0,"infraction_height":0,"infraction_time":0,"power":0,"slash_amount":"40","slash_ratio":"0.1"}],"power_changes":[{"address":"myValidator","power":2,"previous_power":1,"tokens":{"denom":"stake","amount":"2000000"}}]}}`,
+		},
+		"legacy tombstones": {
+			config: types.ContractConfig{LegacyTombstones: true},
+			exp:    `{"valset_update":{"additions":[{"address":"myValidator","commission":"0.1","max_commission":"0.2","max_change_rate":"0.01","moniker":"my moniker","cons_pubkey":"AQI="}],"removals":null,"updated":null,"jailed":null,"unjailed":null,"tombstoned":["myValidator"],"slashed":[{"address":"myValidator","height":0,"time":0,"infraction_height":0,"power":0,"slash_amount":{"denom":"stake","amount":"40"},"delegated_amount":{"denom":"stake","amount":"400"},"slash_ratio":"0.1"}],"power_changes":[{"address":"myValidator","power":2,"previous_power":1,"tokens":{"denom":"stake","amount":"2000000"}}]}}`,
+		},
+		"legacy validators": {
+			config: types.ContractConfig{LegacyValidators: true},
+			exp:    `{"valset_update":{"additions":[{"address":"myValidator","commission":"0.1","max_commission":"0.2","max_change_rate":"0.01"}],"removals":null,"updated":null,"jailed":null,"unjailed":null,"tombstoned":[{"address":"myValidator","cons_address":"myConsAddress","evidence_type":"double_sign","height":0,"time":0,"infraction_height":1}],"slashed":[{"address":"myValidator","height":0,"time":0,"infraction_height":0,"power":0,"slash_amount":{"denom":"stake","amount":"40"},"delegated_amount":{"denom":"stake","amount":"400"},"slash_ratio":"0.1"}]}}`,
+		},
+		"all legacy": {
+			config: types.ContractConfig{LegacySlashes: true, LegacyTombstones: true, LegacyValidators: true},
+			exp:    `{"valset_update":{"additions":[{"address":"myValidator","commission":"0.1","max_commission":"0.2","max_change_rate":"0.01"}],"removals":null,"updated":null,"jailed":null,"unjailed":null,"tombstoned":["myValidator"],"slashed":[{"address":"myValidator","height":0,"time":0,"infraction_height":0,"infraction_time":0,"power":0,"slash_amount":"40","slash_ratio":"0.1"}]}}`,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			require.NoError(t, k.SetContractConfig(ctx, myContractAddr, spec.config))
+			// when
+			got, err := k.EncodeValsetUpdate(ctx, myContractAddr, report)
+			// then
+			require.NoError(t, err)
+			assert.JSONEq(t, spec.exp, string(got))
+		})
+	}
+}
+
 func TestValsetUpdateReportErrors(t *testing.T) {
 	nonValAddr := sdk.ValAddress(bytes.Repeat([]byte{1}, address.Len))
 	pCtx, keepers := CreateDefaultTestInput(t)
 	k := keepers.MeshKeeper
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	require.NoError(t, k.SetMaxCapLimit(pCtx, myContractAddr, sdk.NewCoin("stake", sdkmath.NewInt(100_000_000))))
 
 	specs := map[string]struct {
 		setup  func(t *testing.T, ctx sdk.Context)
@@ -213,22 +418,43 @@ func TestValsetUpdateReportErrors(t *testing.T) {
 			ctx, _ := pCtx.CacheContext()
 			spec.setup(t, ctx)
 			// when
-			_, gotErr := k.ValsetUpdateReport(ctx)
+			_, _, gotErr := k.ValsetUpdateReport(ctx, myContractAddr)
 			require.Error(t, gotErr)
 			assert.ErrorIs(t, spec.expErr, gotErr)
 		})
 	}
 }
 
-func TestClearPipedValsetOperations(t *testing.T) {
+func TestAckValsetOutbox(t *testing.T) {
 	ctx, keepers := CreateDefaultTestInput(t)
 	k := keepers.MeshKeeper
-	err := k.sendAsync(ctx, types.ValidatorModified, rand.Bytes(address.Len), nil)
-	require.NoError(t, err)
-	err = k.sendAsync(ctx, types.ValidatorUnjailed, rand.Bytes(address.Len), nil)
-	require.NoError(t, err)
+	myContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	myOtherContractAddr := sdk.AccAddress(rand.Bytes(address.Len))
+	for _, c := range []sdk.AccAddress{myContractAddr, myOtherContractAddr} {
+		require.NoError(t, k.SetMaxCapLimit(ctx, c, sdk.NewCoin("stake", sdkmath.NewInt(100_000_000))))
+	}
+	myValAddr, myOtherValAddr := sdk.ValAddress(rand.Bytes(address.Len)), sdk.ValAddress(rand.Bytes(address.Len))
+	require.NoError(t, k.sendAsync(ctx, types.ValidatorModified, myValAddr, nil))
+	require.NoError(t, k.sendAsync(ctx, types.ValidatorUnjailed, myOtherValAddr, nil))
 	// when
-	k.ClearPipedValsetOperations(ctx)
-	// then
-	assert.Empty(t, FetchAllStoredOperations(t, ctx, k))
+	k.AckValsetOutbox(ctx, myContractAddr, 1)
+	// then only the acknowledged entry is removed
+	exp := map[string][]types.PipedValsetOperation{myOtherValAddr.String(): {types.ValidatorUnjailed}}
+	assert.Equal(t, exp, FetchAllStoredOperations(t, ctx, k, myContractAddr))
+	// and the outbox of the other contract is not modified
+	assert.Len(t, FetchAllStoredOperations(t, ctx, k, myOtherContractAddr), 2)
+
+	// when new entries are added after all were acknowledged
+	k.AckValsetOutbox(ctx, myContractAddr, 2)
+	assert.Empty(t, FetchAllStoredOperations(t, ctx, k, myContractAddr))
+	require.NoError(t, k.sendAsync(ctx, types.ValidatorJailed, myValAddr, nil))
+	// then the sequence continues
+	var entries []types.ValsetOutboxEntry
+	k.IterateValsetOutbox(ctx, myContractAddr, func(entry types.ValsetOutboxEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+	require.Len(t, entries, 1)
+	assert.Equal(t, uint64(3), entries[0].Sequence)
+	assert.Equal(t, myContractAddr.String(), entries[0].Contract)
 }
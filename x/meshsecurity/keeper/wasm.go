@@ -20,10 +20,76 @@ func (k Keeper) SendHandleEpoch(ctx sdk.Context, contractAddr sdk.AccAddress) er
 
 // SendValsetUpdate submit the valset update report to the virtual staking contract via sudo
 func (k Keeper) SendValsetUpdate(ctx sdk.Context, contractAddr sdk.AccAddress, v contract.ValsetUpdate) error {
-	msg := contract.SudoMsg{
-		ValsetUpdate: &v,
+	bz, err := k.EncodeValsetUpdate(ctx, contractAddr, v)
+	if err != nil {
+		return err
 	}
-	return k.doSudoCall(ctx, contractAddr, msg)
+	return k.SendRawSudoMsg(ctx, contractAddr, bz)
+}
+
+// SendValsetSnapshot submit the full validator set snapshot to the virtual staking contract via sudo
+func (k Keeper) SendValsetSnapshot(ctx sdk.Context, contractAddr sdk.AccAddress, v contract.ValsetSnapshot) error {
+	bz, err := EncodeValsetSnapshot(v)
+	if err != nil {
+		return err
+	}
+	return k.SendRawSudoMsg(ctx, contractAddr, bz)
+}
+
+// SendRawSudoMsg submit the json encoded sudo message to the virtual staking contract
+func (k Keeper) SendRawSudoMsg(ctx sdk.Context, contractAddr sdk.AccAddress, bz []byte) error {
+	_, err := k.wasm.Sudo(ctx, contractAddr, bz)
+	return err
+}
+
+// EncodeValsetUpdate returns the json encoded sudo message for the valset update report.
+// The legacy format is used only for the fields that are configured as legacy in the contract config.
+func (k Keeper) EncodeValsetUpdate(ctx sdk.Context, contractAddr sdk.AccAddress, v contract.ValsetUpdate) ([]byte, error) {
+	var msg any = contract.SudoMsg{ValsetUpdate: &v}
+	cfg := k.GetContractConfig(ctx, contractAddr)
+	if cfg.LegacySlashes || cfg.LegacyTombstones || cfg.LegacyValidators {
+		legacy := contract.NewLegacyValsetUpdate(v, contract.ValsetUpdateFormat{
+			DenominatedSlashes:   !cfg.LegacySlashes,
+			StructuredTombstones: !cfg.LegacyTombstones,
+			ExtendedValidators:   !cfg.LegacyValidators,
+		})
+		msg = contract.LegacySudoMsg{ValsetUpdate: &legacy}
+	}
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "marshal sudo msg")
+	}
+	return bz, nil
+}
+
+// EncodeValsetSnapshot returns the json encoded sudo message for the valset snapshot
+func EncodeValsetSnapshot(v contract.ValsetSnapshot) ([]byte, error) {
+	bz, err := json.Marshal(contract.SudoMsg{ValsetSnapshot: &v})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "marshal sudo msg")
+	}
+	return bz, nil
+}
+
+// doIsolatedSudoCall sends the sudo message within a cached store that is discarded when the contract fails.
+// Panics are recovered and returned as error.
+func (k Keeper) doIsolatedSudoCall(ctx sdk.Context, contractAddr sdk.AccAddress, msg contract.SudoMsg) error {
+	cacheCtx, done := ctx.CacheContext()
+	if err := safeExec(func() error { return k.doSudoCall(cacheCtx, contractAddr, msg) }); err != nil {
+		return err
+	}
+	done()
+	return nil
+}
+
+// doIsolatedRawSudoCall sends the json encoded sudo message like doIsolatedSudoCall
+func (k Keeper) doIsolatedRawSudoCall(ctx sdk.Context, contractAddr sdk.AccAddress, msg []byte) error {
+	cacheCtx, done := ctx.CacheContext()
+	if err := safeExec(func() error { return k.SendRawSudoMsg(cacheCtx, contractAddr, msg) }); err != nil {
+		return err
+	}
+	done()
+	return nil
 }
 
 // caller must ensure gas limits are set proper and handle panics
@@ -32,6 +98,5 @@ func (k Keeper) doSudoCall(ctx sdk.Context, contractAddr sdk.AccAddress, msg con
 	if err != nil {
 		return errorsmod.Wrap(err, "marshal sudo msg")
 	}
-	_, err = k.wasm.Sudo(ctx, contractAddr, bz)
-	return err
+	return k.SendRawSudoMsg(ctx, contractAddr, bz)
 }
@@ -11,6 +11,17 @@ import (
 // RegisterLegacyAminoCodec register types with legacy amino
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetVirtualStakingMaxCap{}, "meshsecurity/MsgSetVirtualStakingMaxCap", nil)
+	cdc.RegisterConcrete(&MsgSetVirtualStakingMaxCaps{}, "meshsecurity/MsgSetVirtualStakingMaxCaps", nil)
+	cdc.RegisterConcrete(&MsgSetContractConfig{}, "meshsecurity/MsgSetContractConfig", nil)
+	cdc.RegisterConcrete(&MsgScheduleTask{}, "meshsecurity/MsgScheduleTask", nil)
+	cdc.RegisterConcrete(&MsgRescheduleTask{}, "meshsecurity/MsgRescheduleTask", nil)
+	cdc.RegisterConcrete(&MsgPauseScheduledTasks{}, "meshsecurity/MsgPauseScheduledTasks", nil)
+	cdc.RegisterConcrete(&MsgResumeScheduledTasks{}, "meshsecurity/MsgResumeScheduledTasks", nil)
+	cdc.RegisterConcrete(&MsgDeleteScheduledTasks{}, "meshsecurity/MsgDeleteScheduledTasks", nil)
+	cdc.RegisterConcrete(&MsgDeleteDeadLetterTasks{}, "meshsecurity/MsgDeleteDeadLetterTasks", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "meshsecurity/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgRemoveVirtualStakingContract{}, "meshsecurity/MsgRemoveVirtualStakingContract", nil)
+	cdc.RegisterConcrete(&MsgMigrateVirtualStakingContract{}, "meshsecurity/MsgMigrateVirtualStakingContract", nil)
 }
 
 // RegisterInterfaces register types with interface registry
@@ -18,6 +29,17 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgSetVirtualStakingMaxCap{},
+		&MsgSetVirtualStakingMaxCaps{},
+		&MsgSetContractConfig{},
+		&MsgScheduleTask{},
+		&MsgRescheduleTask{},
+		&MsgPauseScheduledTasks{},
+		&MsgResumeScheduledTasks{},
+		&MsgDeleteScheduledTasks{},
+		&MsgDeleteDeadLetterTasks{},
+		&MsgUpdateParams{},
+		&MsgRemoveVirtualStakingContract{},
+		&MsgMigrateVirtualStakingContract{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
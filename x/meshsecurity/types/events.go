@@ -9,19 +9,34 @@ import (
 const (
 	EventTypeSchedulerExec       = "scheduler_execution"
 	EventTypeSchedulerRegistered = "scheduler_registered"
+	EventTypeSchedulerDeferred   = "scheduler_deferred"
+	EventTypeSchedulerRetry      = "scheduler_retry"
+	EventTypeSchedulerDeadLetter = "scheduler_dead_letter"
 	EventTypeMaxCapLimitUpdated  = "max_cap_limit_updated"
+	EventTypeContractConfigSet   = "contract_config_set"
 	EventTypeUnbond              = "instant_unbond"
 	EventTypeDelegate            = "instant_delegate"
+	EventTypeMaxCapEnforced      = "max_cap_enforced"
+	EventTypeContractRemoved     = "virtual_staking_contract_removed"
+	EventTypeContractMigrated    = "virtual_staking_contract_migrated"
 )
 
 const (
 	AttributeKeyContractAddr         = "virtual_staking_contract"
+	AttributeKeyNewContractAddr      = "new_virtual_staking_contract"
 	AttributeKeySchedulerNextExec    = "next_exececution_block"
 	AttributeKeySchedulerExecSuccess = "execution_success"
 	AttributeKeySchedulerRepeat      = "repeat"
 	AttributeKeySchedulerExecError   = "error"
+	AttributeKeySchedulerTaskType    = "task_type"
+	AttributeKeySchedulerHeight      = "scheduled_height"
+	AttributeKeySchedulerGasLimit    = "gas_limit"
+	AttributeKeySchedulerAttempts    = "attempts"
 	AttributeKeyValidator            = "validator"
 	AttributeKeyDelegator            = "delegator"
+	AttributeKeyEpochLength          = "epoch_length"
+	AttributeKeyMaxGasEndBlocker     = "max_gas_end_blocker"
+	AttributeKeyMaxCap               = "max_cap"
 )
 
 // EmitSchedulerExecutionEvent emits an event signalling a successful or failed scheduler execution and including the error
@@ -59,6 +74,50 @@ func EmitSchedulerRegisteredEvent(ctx sdk.Context, contractAddr sdk.AccAddress,
 	)
 }
 
+// EmitSchedulerDeferredEvent emits an event signalling that a due task was deferred to the next block
+// because it does not fit into the remaining scheduler gas budget of the block
+func EmitSchedulerDeferredEvent(ctx sdk.Context, contractAddr sdk.AccAddress, tp SchedulerTaskType, scheduledHeight uint64, gasLimit sdk.Gas) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeSchedulerDeferred,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddr, contractAddr.String()),
+			sdk.NewAttribute(AttributeKeySchedulerTaskType, fmt.Sprintf("%d", tp)),
+			sdk.NewAttribute(AttributeKeySchedulerHeight, fmt.Sprintf("%d", scheduledHeight)),
+			sdk.NewAttribute(AttributeKeySchedulerGasLimit, fmt.Sprintf("%d", gasLimit)),
+		),
+	)
+}
+
+// EmitSchedulerRetryEvent emits an event signalling that a failed task is scheduled for a retry
+func EmitSchedulerRetryEvent(ctx sdk.Context, task FailedTask) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeSchedulerRetry,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddr, task.Contract),
+			sdk.NewAttribute(AttributeKeySchedulerTaskType, fmt.Sprintf("%d", task.Type)),
+			sdk.NewAttribute(AttributeKeySchedulerAttempts, fmt.Sprintf("%d", task.Attempts)),
+			sdk.NewAttribute(AttributeKeySchedulerNextExec, fmt.Sprintf("%d", task.Height)),
+		),
+	)
+}
+
+// EmitSchedulerDeadLetterEvent emits an event signalling that a failed task exceeded the max retry attempts
+// and was moved to the dead letter store
+func EmitSchedulerDeadLetterEvent(ctx sdk.Context, task FailedTask) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeSchedulerDeadLetter,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddr, task.Contract),
+			sdk.NewAttribute(AttributeKeySchedulerTaskType, fmt.Sprintf("%d", task.Type)),
+			sdk.NewAttribute(AttributeKeySchedulerAttempts, fmt.Sprintf("%d", task.Attempts)),
+			sdk.NewAttribute(AttributeKeySchedulerExecError, task.LastError),
+		),
+	)
+}
+
 // EmitMaxCapLimitUpdatedEvent emits an event signalling that max cap limit is updated
 func EmitMaxCapLimitUpdatedEvent(ctx sdk.Context, contractAddr sdk.AccAddress, amount sdk.Coin) {
 	ctx.EventManager().EmitEvent(
@@ -70,3 +129,56 @@ func EmitMaxCapLimitUpdatedEvent(ctx sdk.Context, contractAddr sdk.AccAddress, a
 		),
 	)
 }
+
+// EmitContractConfigSetEvent emits an event signalling that the contract specific param overrides are updated
+func EmitContractConfigSetEvent(ctx sdk.Context, contractAddr sdk.AccAddress, config ContractConfig) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeContractConfigSet,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddr, contractAddr.String()),
+			sdk.NewAttribute(AttributeKeyEpochLength, fmt.Sprintf("%d", config.EpochLength)),
+			sdk.NewAttribute(AttributeKeyMaxGasEndBlocker, fmt.Sprintf("%d", config.MaxGasEndBlocker)),
+		),
+	)
+}
+
+// EmitMaxCapEnforcedEvent emits an event signalling that the module undelegated an amount of the contract
+// to enforce the max cap limit
+func EmitMaxCapEnforcedEvent(ctx sdk.Context, contractAddr sdk.AccAddress, valAddr sdk.ValAddress, amount, maxCap sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeMaxCapEnforced,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddr, contractAddr.String()),
+			sdk.NewAttribute(AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(AttributeKeyMaxCap, maxCap.String()),
+		),
+	)
+}
+
+// EmitContractRemovedEvent emits an event signalling that the virtual staking contract was removed and the remaining
+// virtual stake unbonded
+func EmitContractRemovedEvent(ctx sdk.Context, contractAddr sdk.AccAddress, unbonded sdk.Coin) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeContractRemoved,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddr, contractAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, unbonded.String()),
+		),
+	)
+}
+
+// EmitContractMigratedEvent emits an event signalling that the virtual staking setup was moved to a new contract address
+func EmitContractMigratedEvent(ctx sdk.Context, contractAddr, newContractAddr sdk.AccAddress) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeContractMigrated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyContractAddr, contractAddr.String()),
+			sdk.NewAttribute(AttributeKeyNewContractAddr, newContractAddr.String()),
+		),
+	)
+}
@@ -1,18 +1,233 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState constructor
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(
+	params Params,
+	maxCapInfos []VirtualStakingMaxCapInfo,
+	scheduledTasks []ScheduledTask,
+	pausedTasks []PausedTasks,
+	retryTasks []FailedTask,
+	deadLetterTasks []FailedTask,
+	valsetOutbox []ValsetOutboxEntry,
+	delegatedAmounts []ContractDelegatedAmount,
+	slashedAmounts []ContractDelegatedAmount,
+) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:                    params,
+		VirtualStakingMaxCapInfos: maxCapInfos,
+		ScheduledTasks:            scheduledTasks,
+		PausedTasks:               pausedTasks,
+		RetryTasks:                retryTasks,
+		DeadLetterTasks:           deadLetterTasks,
+		ValsetOutbox:              valsetOutbox,
+		DelegatedAmounts:          delegatedAmounts,
+		SlashedAmounts:            slashedAmounts,
 	}
 }
 
 // DefaultGenesisState default genesis state
 func DefaultGenesisState(denom string) *GenesisState {
-	return NewGenesisState(DefaultParams(denom))
+	return NewGenesisState(DefaultParams(denom), []VirtualStakingMaxCapInfo{}, []ScheduledTask{}, []PausedTasks{}, []FailedTask{}, []FailedTask{}, []ValsetOutboxEntry{}, []ContractDelegatedAmount{}, []ContractDelegatedAmount{})
 }
 
 // ValidateGenesis does basic validation on genesis state
 func ValidateGenesis(gs *GenesisState) error {
-	return gs.Params.ValidateBasic()
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+	bondDenom := gs.Params.TotalContractsMaxCap.Denom
+	// a delegated amount above the max cap is valid only while the lowered cap is pending enforcement
+	pendingEnforcement := make(map[string]struct{})
+	for _, task := range gs.ScheduledTasks {
+		if SchedulerTaskType(task.Type) == SchedulerTaskMaxCapEnforcement {
+			pendingEnforcement[task.Contract] = struct{}{}
+		}
+	}
+	for _, task := range gs.RetryTasks {
+		if SchedulerTaskType(task.Type) == SchedulerTaskMaxCapEnforcement {
+			pendingEnforcement[task.Contract] = struct{}{}
+		}
+	}
+	totalCap := math.ZeroInt()
+	contracts := make(map[string]struct{}, len(gs.VirtualStakingMaxCapInfos))
+	for i, info := range gs.VirtualStakingMaxCapInfos {
+		if err := info.ValidateBasic(bondDenom); err != nil {
+			return errorsmod.Wrapf(err, "max cap info %d", i)
+		}
+		if _, exists := contracts[info.Contract]; exists {
+			return ErrInvalid.Wrapf("duplicate max cap info for contract: %s", info.Contract)
+		}
+		if _, pending := pendingEnforcement[info.Contract]; !pending && info.Delegated.Amount.GT(info.Cap.Amount) {
+			return ErrInvalid.Wrapf("delegated %s exceeds max cap %s without pending enforcement for contract: %s", info.Delegated, info.Cap, info.Contract)
+		}
+		contracts[info.Contract] = struct{}{}
+		totalCap = totalCap.Add(info.Cap.Amount)
+	}
+	for i, d := range gs.DelegatedAmounts {
+		if err := d.ValidateBasic(bondDenom); err != nil {
+			return errorsmod.Wrapf(err, "delegated amount %d", i)
+		}
+		// contracts with a max cap limit have the amount in the max cap info
+		if _, exists := contracts[d.Contract]; exists {
+			return ErrInvalid.Wrapf("duplicate delegated amount for contract: %s", d.Contract)
+		}
+		contracts[d.Contract] = struct{}{}
+	}
+	slashed := make(map[string]struct{}, len(gs.SlashedAmounts))
+	for i, d := range gs.SlashedAmounts {
+		if err := d.ValidateBasic(bondDenom); err != nil {
+			return errorsmod.Wrapf(err, "slashed amount %d", i)
+		}
+		if _, exists := slashed[d.Contract]; exists {
+			return ErrInvalid.Wrapf("duplicate slashed amount for contract: %s", d.Contract)
+		}
+		slashed[d.Contract] = struct{}{}
+	}
+	if totalCap.GT(gs.Params.TotalContractsMaxCap.Amount) {
+		return ErrInvalid.Wrapf("sum of max caps %s exceeds total contracts max cap %s", totalCap, gs.Params.TotalContractsMaxCap)
+	}
+	tasks := make(map[string]struct{}, len(gs.ScheduledTasks))
+	for i, task := range gs.ScheduledTasks {
+		if err := task.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "scheduled task %d", i)
+		}
+		key, err := BuildSchedulerContractKey(SchedulerTaskType(task.Type), task.Height, sdk.MustAccAddressFromBech32(task.Contract))
+		if err != nil {
+			return errorsmod.Wrapf(err, "scheduled task %d", i)
+		}
+		if _, exists := tasks[string(key)]; exists {
+			return ErrInvalid.Wrapf("duplicate scheduled task for contract %s at height %d", task.Contract, task.Height)
+		}
+		tasks[string(key)] = struct{}{}
+	}
+	paused := make(map[string]struct{}, len(gs.PausedTasks))
+	for i, p := range gs.PausedTasks {
+		if err := p.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "paused tasks %d", i)
+		}
+		key, err := BuildPausedTasksKey(SchedulerTaskType(p.Type), sdk.MustAccAddressFromBech32(p.Contract))
+		if err != nil {
+			return errorsmod.Wrapf(err, "paused tasks %d", i)
+		}
+		if _, exists := paused[string(key)]; exists {
+			return ErrInvalid.Wrapf("duplicate paused tasks for contract %s and type %d", p.Contract, p.Type)
+		}
+		paused[string(key)] = struct{}{}
+	}
+	failedTaskIDs := make(map[uint64]struct{}, len(gs.RetryTasks)+len(gs.DeadLetterTasks))
+	for i, task := range append(append([]FailedTask{}, gs.RetryTasks...), gs.DeadLetterTasks...) {
+		if err := task.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "failed task %d", i)
+		}
+		if _, exists := failedTaskIDs[task.ID]; exists {
+			return ErrInvalid.Wrapf("duplicate failed task id: %d", task.ID)
+		}
+		failedTaskIDs[task.ID] = struct{}{}
+	}
+	outboxEntries := make(map[string]struct{}, len(gs.ValsetOutbox))
+	for i, entry := range gs.ValsetOutbox {
+		if err := entry.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "valset outbox entry %d", i)
+		}
+		key := string(BuildValsetOutboxKey(sdk.MustAccAddressFromBech32(entry.Contract), entry.Sequence))
+		if _, exists := outboxEntries[key]; exists {
+			return ErrInvalid.Wrapf("duplicate valset outbox entry %d for contract %s", entry.Sequence, entry.Contract)
+		}
+		outboxEntries[key] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation on the max cap info. Amounts must be in the given bond denom
+func (i VirtualStakingMaxCapInfo) ValidateBasic(bondDenom string) error {
+	if _, err := sdk.AccAddressFromBech32(i.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := i.Cap.Validate(); err != nil {
+		return errorsmod.Wrap(err, "cap")
+	}
+	if i.Cap.Denom != bondDenom {
+		return ErrInvalid.Wrapf("cap denom: expected %s, got %s", bondDenom, i.Cap.Denom)
+	}
+	if err := i.Delegated.Validate(); err != nil { // rejects negative amounts
+		return errorsmod.Wrap(err, "delegated")
+	}
+	if i.Delegated.Denom != bondDenom {
+		return ErrInvalid.Wrapf("delegated denom: expected %s, got %s", bondDenom, i.Delegated.Denom)
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation on the delegated or slashed amount. The amount must be in the given bond denom
+func (d ContractDelegatedAmount) ValidateBasic(bondDenom string) error {
+	if _, err := sdk.AccAddressFromBech32(d.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := d.Amount.Validate(); err != nil { // rejects negative amounts
+		return errorsmod.Wrap(err, "amount")
+	}
+	if d.Amount.Denom != bondDenom {
+		return ErrInvalid.Wrapf("amount denom: expected %s, got %s", bondDenom, d.Amount.Denom)
+	}
+	return nil
+}
+
+// ValidateBasic performs basic validation on the scheduled task
+func (t ScheduledTask) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(t.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return ValidateSchedulerTaskType(t.Type)
+}
+
+// ValidateBasic performs basic validation on the paused tasks
+func (p PausedTasks) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return ValidateSchedulerTaskType(p.Type)
+}
+
+// ValidateBasic performs basic validation on the failed task
+func (t FailedTask) ValidateBasic() error {
+	if t.ID == 0 {
+		return ErrInvalid.Wrap("empty id")
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if t.Attempts == 0 {
+		return ErrInvalid.Wrap("empty attempts")
+	}
+	return ValidateSchedulerTaskType(t.Type)
+}
+
+// ValidateBasic performs basic validation on the valset outbox entry
+func (e ValsetOutboxEntry) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(e.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if e.Sequence == 0 {
+		return ErrInvalid.Wrap("empty sequence")
+	}
+	if _, err := sdk.ValAddressFromBech32(e.Validator); err != nil {
+		return errorsmod.Wrap(err, "validator")
+	}
+	if err := ValidateValsetOperation(e.Operation); err != nil {
+		return err
+	}
+	if PipedValsetOperation(e.Operation) == ValidatorSlashed && e.SlashInfo == nil {
+		return ErrInvalid.Wrap("empty slash info")
+	}
+	if PipedValsetOperation(e.Operation) == ValidatorPowerChanged && e.PowerInfo == nil {
+		return ErrInvalid.Wrap("empty power info")
+	}
+	return nil
 }
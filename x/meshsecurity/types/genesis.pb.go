@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,6 +29,31 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines meshsecurity module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// VirtualStakingMaxCapInfos contains the max cap limit and the total
+	// delegated amount of each virtual staking contract
+	VirtualStakingMaxCapInfos []VirtualStakingMaxCapInfo `protobuf:"bytes,2,rep,name=virtual_staking_max_cap_infos,json=virtualStakingMaxCapInfos,proto3" json:"virtual_staking_max_cap_infos"`
+	// ScheduledTasks contains all pending scheduler tasks. The task heights are
+	// relative to the export height.
+	ScheduledTasks []ScheduledTask `protobuf:"bytes,3,rep,name=scheduled_tasks,json=scheduledTasks,proto3" json:"scheduled_tasks"`
+	// PausedTasks contains the task types paused per contract
+	PausedTasks []PausedTasks `protobuf:"bytes,4,rep,name=paused_tasks,json=pausedTasks,proto3" json:"paused_tasks"`
+	// RetryTasks contains the failed tasks that are pending for a retry. The
+	// retry heights are relative to the export height.
+	RetryTasks []FailedTask `protobuf:"bytes,5,rep,name=retry_tasks,json=retryTasks,proto3" json:"retry_tasks"`
+	// DeadLetterTasks contains the failed tasks that exceeded the max retry
+	// attempts. The heights of the last attempts are exported as the number of
+	// blocks before the export height.
+	DeadLetterTasks []FailedTask `protobuf:"bytes,6,rep,name=dead_letter_tasks,json=deadLetterTasks,proto3" json:"dead_letter_tasks"`
+	// ValsetOutbox contains the validator set operations that are pending for
+	// delivery to the virtual staking contracts
+	ValsetOutbox []ValsetOutboxEntry `protobuf:"bytes,7,rep,name=valset_outbox,json=valsetOutbox,proto3" json:"valset_outbox"`
+	// DelegatedAmounts contains the recorded total delegated amounts of the
+	// contracts without max cap limit. The amounts of the contracts with a max
+	// cap limit are part of the max cap infos.
+	DelegatedAmounts []ContractDelegatedAmount `protobuf:"bytes,8,rep,name=delegated_amounts,json=delegatedAmounts,proto3" json:"delegated_amounts"`
+	// SlashedAmounts contains the amounts slashed from the delegations of the
+	// contracts
+	SlashedAmounts []ContractDelegatedAmount `protobuf:"bytes,9,rep,name=slashed_amounts,json=slashedAmounts,proto3" json:"slashed_amounts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -62,8 +89,51 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// ContractDelegatedAmount is the recorded total delegated amount of a virtual
+// staking contract
+type ContractDelegatedAmount struct {
+	// Contract is the address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Amount is the total amount delegated
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *ContractDelegatedAmount) Reset()         { *m = ContractDelegatedAmount{} }
+func (m *ContractDelegatedAmount) String() string { return proto.CompactTextString(m) }
+func (*ContractDelegatedAmount) ProtoMessage()    {}
+func (*ContractDelegatedAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e38a457d5139d73a, []int{1}
+}
+func (m *ContractDelegatedAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractDelegatedAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractDelegatedAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractDelegatedAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractDelegatedAmount.Merge(m, src)
+}
+func (m *ContractDelegatedAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractDelegatedAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractDelegatedAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractDelegatedAmount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.meshsecurity.v1beta1.GenesisState")
+	proto.RegisterType((*ContractDelegatedAmount)(nil), "osmosis.meshsecurity.v1beta1.ContractDelegatedAmount")
 }
 
 func init() {
@@ -71,23 +141,46 @@ func init() {
 }
 
 var fileDescriptor_e38a457d5139d73a = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4d, 0x2d, 0xce, 0x28, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0xa5, 0x8f, 0xd7, 0x24, 0x14, 0x2d, 0x60, 0xe3, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1,
-	0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0x2a, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42,
-	0x4a, 0xb1, 0x5c, 0x3c, 0xee, 0x10, 0x87, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xb9, 0x73, 0xb1,
-	0x15, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xa9, 0xe8, 0xe1,
-	0x73, 0x98, 0x5e, 0x00, 0x58, 0xad, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0,
-	0x62, 0x0c, 0x82, 0x6a, 0xb7, 0x62, 0x79, 0xb1, 0x40, 0x9e, 0xd1, 0x29, 0xe1, 0xc4, 0x43, 0x39,
-	0x86, 0x15, 0x8f, 0xe4, 0x18, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca,
-	0x2e, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0xe6, 0x4b, 0xdd, 0x9c, 0xc4,
-	0x24, 0x88, 0x57, 0x75, 0x61, 0x16, 0xea, 0x16, 0xa7, 0x64, 0xeb, 0x57, 0xa0, 0x7a, 0xbf, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x0f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75,
-	0x0f, 0xd3, 0x37, 0x6d, 0x01, 0x00, 0x00,
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x4f, 0xd4, 0x40,
+	0x18, 0xc6, 0x5b, 0xc0, 0x05, 0x66, 0x11, 0xa4, 0x21, 0xb1, 0x10, 0x2d, 0x84, 0x78, 0x40, 0x74,
+	0xdb, 0x80, 0xff, 0x12, 0x0f, 0x26, 0x2c, 0x2a, 0x31, 0xd1, 0x68, 0x58, 0xa2, 0x89, 0x97, 0x3a,
+	0x6d, 0x87, 0xee, 0x64, 0xdb, 0x4e, 0x33, 0xef, 0x74, 0xb3, 0x7b, 0xf6, 0xae, 0x7e, 0x04, 0x8f,
+	0x1c, 0x3d, 0xf8, 0x21, 0x38, 0x12, 0x4f, 0x9e, 0x8c, 0x2e, 0x07, 0xfd, 0x18, 0x66, 0xa7, 0xd3,
+	0xb5, 0x9b, 0x48, 0x49, 0x8c, 0x97, 0x4d, 0x3b, 0xef, 0xf3, 0xfc, 0x9e, 0xb7, 0xef, 0x66, 0x5e,
+	0xb4, 0xc9, 0x20, 0x66, 0x40, 0xc1, 0x89, 0x09, 0xb4, 0x81, 0xf8, 0x19, 0xa7, 0xa2, 0xef, 0x74,
+	0xb7, 0x3c, 0x22, 0xf0, 0x96, 0x13, 0x92, 0x84, 0x00, 0x05, 0x3b, 0xe5, 0x4c, 0x30, 0xe3, 0x8a,
+	0xd2, 0xda, 0x65, 0xad, 0xad, 0xb4, 0x2b, 0x4e, 0x25, 0x69, 0xcc, 0x22, 0x71, 0x2b, 0x37, 0x2b,
+	0x0d, 0xe0, 0xb7, 0x49, 0x90, 0x45, 0x84, 0x2b, 0xf5, 0x52, 0xc8, 0x42, 0x26, 0x1f, 0x9d, 0xe1,
+	0x93, 0x3a, 0x5d, 0xc4, 0x31, 0x4d, 0x98, 0x23, 0x7f, 0xd5, 0xd1, 0xb2, 0x2f, 0xb9, 0x6e, 0xae,
+	0xcd, 0x5f, 0x54, 0xc9, 0xca, 0xdf, 0x1c, 0x0f, 0x03, 0x19, 0x05, 0xf9, 0x8c, 0x26, 0x79, 0x7d,
+	0xfd, 0xfd, 0x34, 0x9a, 0xdb, 0xcb, 0x3f, 0xb9, 0x25, 0xb0, 0x20, 0xc6, 0x1e, 0xaa, 0xa5, 0x98,
+	0xe3, 0x18, 0x4c, 0x7d, 0x4d, 0xdf, 0xa8, 0x6f, 0x5f, 0xb3, 0xab, 0x46, 0x60, 0xbf, 0x90, 0xda,
+	0xe6, 0xec, 0xf1, 0xb7, 0x55, 0xed, 0xe8, 0xe7, 0xa7, 0x4d, 0x7d, 0x5f, 0xd9, 0x8d, 0xb7, 0x3a,
+	0xba, 0xda, 0xa5, 0x5c, 0x64, 0x38, 0x72, 0x41, 0xe0, 0x0e, 0x4d, 0x42, 0x37, 0xc6, 0x3d, 0xd7,
+	0xc7, 0xa9, 0x4b, 0x93, 0x43, 0x06, 0xe6, 0xc4, 0xda, 0xe4, 0x46, 0x7d, 0xfb, 0x6e, 0x75, 0xc0,
+	0xcb, 0x1c, 0xd1, 0xca, 0x09, 0xcf, 0x70, 0x6f, 0x17, 0xa7, 0x4f, 0x92, 0x43, 0x56, 0x8e, 0x5c,
+	0xee, 0x9e, 0x21, 0x02, 0xc3, 0x45, 0x0b, 0xc5, 0x58, 0x03, 0x57, 0x60, 0xe8, 0x80, 0x39, 0x29,
+	0x63, 0x6f, 0x54, 0xc7, 0xb6, 0x0a, 0xd3, 0x01, 0x86, 0x4e, 0x39, 0x6b, 0x1e, 0xca, 0x15, 0x30,
+	0x5e, 0xa1, 0xb9, 0x14, 0x67, 0x30, 0xa2, 0x4f, 0x49, 0xfa, 0xf5, 0xf3, 0xa6, 0x96, 0x81, 0x02,
+	0x94, 0xd9, 0xf5, 0xf4, 0xcf, 0xb9, 0x71, 0x80, 0xea, 0x9c, 0x08, 0xde, 0x57, 0xdc, 0x0b, 0x92,
+	0xbb, 0x51, 0xcd, 0x7d, 0x8c, 0xe9, 0x5f, 0x5a, 0x46, 0x92, 0x93, 0x53, 0x5d, 0xb4, 0x18, 0x10,
+	0x1c, 0xb8, 0x11, 0x11, 0x82, 0x70, 0xc5, 0xae, 0xfd, 0x3b, 0x7b, 0x61, 0x48, 0x7b, 0x2a, 0x61,
+	0x45, 0xc0, 0xc5, 0x2e, 0x8e, 0x80, 0x08, 0x97, 0x65, 0xc2, 0x63, 0x3d, 0x73, 0x5a, 0xc2, 0x9d,
+	0x73, 0xfe, 0x65, 0x69, 0x79, 0x2e, 0x1d, 0x8f, 0x12, 0xc1, 0xfb, 0xe5, 0x8c, 0xb9, 0x6e, 0xa9,
+	0x6a, 0xc4, 0xc3, 0x2f, 0x88, 0x48, 0x88, 0x05, 0x09, 0x5c, 0x1c, 0xb3, 0x2c, 0x11, 0x60, 0xce,
+	0xc8, 0x90, 0x3b, 0xd5, 0x21, 0xbb, 0x2c, 0x11, 0x1c, 0xfb, 0xe2, 0x61, 0x61, 0xdf, 0x91, 0xee,
+	0x72, 0xd4, 0xa5, 0x60, 0xbc, 0x06, 0x06, 0x45, 0x0b, 0x10, 0x61, 0x68, 0x97, 0xc2, 0x66, 0xff,
+	0x53, 0xd8, 0xbc, 0x02, 0xab, 0xa8, 0xfb, 0x53, 0xbf, 0x3e, 0xae, 0xea, 0xeb, 0xef, 0x74, 0x74,
+	0xf9, 0x0c, 0xb3, 0x71, 0x1b, 0xcd, 0xf8, 0xaa, 0x24, 0xaf, 0xe7, 0x6c, 0xd3, 0xfc, 0xf2, 0xb9,
+	0xb1, 0xa4, 0x6e, 0xfc, 0x4e, 0x10, 0x70, 0x02, 0xd0, 0x12, 0x9c, 0x26, 0xe1, 0xfe, 0x48, 0x69,
+	0xdc, 0x43, 0xb5, 0xbc, 0x75, 0x73, 0x42, 0x5e, 0xe9, 0x65, 0x5b, 0x19, 0x86, 0x4b, 0xa1, 0xd4,
+	0x30, 0x4d, 0x9a, 0x53, 0xc3, 0xee, 0xf6, 0x95, 0x3c, 0x6f, 0xa8, 0xf9, 0xe6, 0xf8, 0x87, 0xa5,
+	0x1d, 0x0d, 0x2c, 0xed, 0x78, 0x60, 0xe9, 0x27, 0x03, 0x4b, 0xff, 0x3e, 0xb0, 0xf4, 0x0f, 0xa7,
+	0x96, 0x76, 0x72, 0x6a, 0x69, 0x5f, 0x4f, 0x2d, 0xed, 0xf5, 0x83, 0x90, 0x8a, 0x76, 0xe6, 0xd9,
+	0x3e, 0x8b, 0x8b, 0x95, 0xd8, 0x88, 0xb0, 0x97, 0xaf, 0xb9, 0x46, 0x31, 0x9a, 0x06, 0x04, 0x1d,
+	0xa7, 0x37, 0xbe, 0xfa, 0x44, 0x3f, 0x25, 0xe0, 0xd5, 0xe4, 0x2e, 0xba, 0xf5, 0x7b, 0x00, 0x9e,
+	0x8c, 0x53, 0x5d, 0x9a, 0x05, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -112,6 +205,97 @@ func (this *GenesisState) Equal(that interface{}) bool {
 	if !this.Params.Equal(&that1.Params) {
 		return false
 	}
+	if len(this.VirtualStakingMaxCapInfos) != len(that1.VirtualStakingMaxCapInfos) {
+		return false
+	}
+	for i := range this.VirtualStakingMaxCapInfos {
+		if !this.VirtualStakingMaxCapInfos[i].Equal(&that1.VirtualStakingMaxCapInfos[i]) {
+			return false
+		}
+	}
+	if len(this.ScheduledTasks) != len(that1.ScheduledTasks) {
+		return false
+	}
+	for i := range this.ScheduledTasks {
+		if !this.ScheduledTasks[i].Equal(&that1.ScheduledTasks[i]) {
+			return false
+		}
+	}
+	if len(this.PausedTasks) != len(that1.PausedTasks) {
+		return false
+	}
+	for i := range this.PausedTasks {
+		if !this.PausedTasks[i].Equal(&that1.PausedTasks[i]) {
+			return false
+		}
+	}
+	if len(this.RetryTasks) != len(that1.RetryTasks) {
+		return false
+	}
+	for i := range this.RetryTasks {
+		if !this.RetryTasks[i].Equal(&that1.RetryTasks[i]) {
+			return false
+		}
+	}
+	if len(this.DeadLetterTasks) != len(that1.DeadLetterTasks) {
+		return false
+	}
+	for i := range this.DeadLetterTasks {
+		if !this.DeadLetterTasks[i].Equal(&that1.DeadLetterTasks[i]) {
+			return false
+		}
+	}
+	if len(this.ValsetOutbox) != len(that1.ValsetOutbox) {
+		return false
+	}
+	for i := range this.ValsetOutbox {
+		if !this.ValsetOutbox[i].Equal(&that1.ValsetOutbox[i]) {
+			return false
+		}
+	}
+	if len(this.DelegatedAmounts) != len(that1.DelegatedAmounts) {
+		return false
+	}
+	for i := range this.DelegatedAmounts {
+		if !this.DelegatedAmounts[i].Equal(&that1.DelegatedAmounts[i]) {
+			return false
+		}
+	}
+	if len(this.SlashedAmounts) != len(that1.SlashedAmounts) {
+		return false
+	}
+	for i := range this.SlashedAmounts {
+		if !this.SlashedAmounts[i].Equal(&that1.SlashedAmounts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ContractDelegatedAmount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractDelegatedAmount)
+	if !ok {
+		that2, ok := that.(ContractDelegatedAmount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -134,6 +318,118 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashedAmounts) > 0 {
+		for iNdEx := len(m.SlashedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DelegatedAmounts) > 0 {
+		for iNdEx := len(m.DelegatedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ValsetOutbox) > 0 {
+		for iNdEx := len(m.ValsetOutbox) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetOutbox[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DeadLetterTasks) > 0 {
+		for iNdEx := len(m.DeadLetterTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadLetterTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RetryTasks) > 0 {
+		for iNdEx := len(m.RetryTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetryTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PausedTasks) > 0 {
+		for iNdEx := len(m.PausedTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ScheduledTasks) > 0 {
+		for iNdEx := len(m.ScheduledTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.VirtualStakingMaxCapInfos) > 0 {
+		for iNdEx := len(m.VirtualStakingMaxCapInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VirtualStakingMaxCapInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -147,6 +443,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractDelegatedAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractDelegatedAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractDelegatedAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -166,6 +502,69 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VirtualStakingMaxCapInfos) > 0 {
+		for _, e := range m.VirtualStakingMaxCapInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledTasks) > 0 {
+		for _, e := range m.ScheduledTasks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedTasks) > 0 {
+		for _, e := range m.PausedTasks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetryTasks) > 0 {
+		for _, e := range m.RetryTasks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeadLetterTasks) > 0 {
+		for _, e := range m.DeadLetterTasks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetOutbox) > 0 {
+		for _, e := range m.ValsetOutbox {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatedAmounts) > 0 {
+		for _, e := range m.DelegatedAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashedAmounts) > 0 {
+		for _, e := range m.SlashedAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractDelegatedAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -237,6 +636,393 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualStakingMaxCapInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VirtualStakingMaxCapInfos = append(m.VirtualStakingMaxCapInfos, VirtualStakingMaxCapInfo{})
+			if err := m.VirtualStakingMaxCapInfos[len(m.VirtualStakingMaxCapInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTasks = append(m.ScheduledTasks, ScheduledTask{})
+			if err := m.ScheduledTasks[len(m.ScheduledTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedTasks = append(m.PausedTasks, PausedTasks{})
+			if err := m.PausedTasks[len(m.PausedTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryTasks = append(m.RetryTasks, FailedTask{})
+			if err := m.RetryTasks[len(m.RetryTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadLetterTasks = append(m.DeadLetterTasks, FailedTask{})
+			if err := m.DeadLetterTasks[len(m.DeadLetterTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetOutbox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetOutbox = append(m.ValsetOutbox, ValsetOutboxEntry{})
+			if err := m.ValsetOutbox[len(m.ValsetOutbox)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedAmounts = append(m.DelegatedAmounts, ContractDelegatedAmount{})
+			if err := m.DelegatedAmounts[len(m.DelegatedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedAmounts = append(m.SlashedAmounts, ContractDelegatedAmount{})
+			if err := m.SlashedAmounts[len(m.SlashedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractDelegatedAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractDelegatedAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractDelegatedAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -11,6 +11,17 @@ import (
 )
 
 func TestValidateGenesis(t *testing.T) {
+	myContract := sdk.AccAddress("my_contract_________").String()
+	myOtherContract := sdk.AccAddress("my_other_contract___").String()
+	myValidator := sdk.ValAddress("my_validator________").String()
+	defaultParams := DefaultParams(sdk.DefaultBondDenom)
+	maxCapInfo := func(contract string, capAmount, delegatedAmount int64) VirtualStakingMaxCapInfo {
+		return VirtualStakingMaxCapInfo{
+			Contract:  contract,
+			Cap:       sdk.NewInt64Coin(sdk.DefaultBondDenom, capAmount),
+			Delegated: sdk.NewInt64Coin(sdk.DefaultBondDenom, delegatedAmount),
+		}
+	}
 	specs := map[string]struct {
 		state  GenesisState
 		expErr bool
@@ -59,6 +70,28 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"max gas scheduler per block lower than max gas end-blocker, should fail": {
+			state: GenesisState{
+				Params: Params{
+					TotalContractsMaxCap:    sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(15_000_000_000)),
+					EpochLength:             10,
+					MaxGasEndBlocker:        600_000,
+					MaxGasSchedulerPerBlock: 599_999,
+				},
+			},
+			expErr: true,
+		},
+		"retries without backoff, should fail": {
+			state: GenesisState{
+				Params: Params{
+					TotalContractsMaxCap: sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(15_000_000_000)),
+					EpochLength:          10,
+					MaxGasEndBlocker:     600_000,
+					MaxRetryAttempts:     1,
+				},
+			},
+			expErr: true,
+		},
 		"invalid max cap coin denom, should fail": {
 			state: GenesisState{
 				Params: Params{
@@ -79,6 +112,322 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"with max caps and scheduled tasks": {
+			state: GenesisState{
+				Params: defaultParams,
+				VirtualStakingMaxCapInfos: []VirtualStakingMaxCapInfo{
+					maxCapInfo(myContract, 1_000_000, 500_000),
+					maxCapInfo(myOtherContract, 2_000_000, 0),
+				},
+				ScheduledTasks: []ScheduledTask{
+					{Type: SchedulerTaskHandleEpoch, Contract: myContract, Height: 100, Repeat: true},
+					{Type: SchedulerTaskValsetUpdate, Contract: myContract, Height: 100},
+					{Type: SchedulerTaskHandleEpoch, Contract: myOtherContract, Height: 100, Repeat: true},
+				},
+			},
+			expErr: false,
+		},
+		"max caps exceed total contracts max cap": {
+			state: GenesisState{
+				Params: defaultParams,
+				VirtualStakingMaxCapInfos: []VirtualStakingMaxCapInfo{
+					maxCapInfo(myContract, 6_000_000_000, 0),
+					maxCapInfo(myOtherContract, 4_000_000_001, 0),
+				},
+			},
+			expErr: true,
+		},
+		"delegated above max cap without pending enforcement": {
+			state: GenesisState{
+				Params:                    defaultParams,
+				VirtualStakingMaxCapInfos: []VirtualStakingMaxCapInfo{maxCapInfo(myContract, 1_000_000, 1_000_001)},
+			},
+			expErr: true,
+		},
+		"delegated above max cap with pending enforcement": {
+			state: GenesisState{
+				Params:                    defaultParams,
+				VirtualStakingMaxCapInfos: []VirtualStakingMaxCapInfo{maxCapInfo(myContract, 1_000_000, 1_000_001)},
+				ScheduledTasks: []ScheduledTask{
+					{Type: SchedulerTaskMaxCapEnforcement, Contract: myContract, Height: 100},
+				},
+			},
+		},
+		"delegated above max cap with pending enforcement retry": {
+			state: GenesisState{
+				Params:                    defaultParams,
+				VirtualStakingMaxCapInfos: []VirtualStakingMaxCapInfo{maxCapInfo(myContract, 1_000_000, 1_000_001)},
+				RetryTasks: []FailedTask{
+					{ID: 1, Type: SchedulerTaskMaxCapEnforcement, Contract: myContract, Payload: []byte(`{}`), Attempts: 1, Height: 100},
+				},
+			},
+		},
+		"negative delegated amount": {
+			state: GenesisState{
+				Params: defaultParams,
+				VirtualStakingMaxCapInfos: []VirtualStakingMaxCapInfo{{
+					Contract:  myContract,
+					Cap:       sdk.NewInt64Coin(sdk.DefaultBondDenom, 1),
+					Delegated: sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(-1)},
+				}},
+			},
+			expErr: true,
+		},
+		"duplicate max cap contract": {
+			state: GenesisState{
+				Params: defaultParams,
+				VirtualStakingMaxCapInfos: []VirtualStakingMaxCapInfo{
+					maxCapInfo(myContract, 1, 0),
+					maxCapInfo(myContract, 1, 0),
+				},
+			},
+			expErr: true,
+		},
+		"invalid max cap contract address": {
+			state: GenesisState{
+				Params: defaultParams,
+				VirtualStakingMaxCapInfos: []VirtualStakingMaxCapInfo{
+					maxCapInfo("invalid", 1, 0),
+				},
+			},
+			expErr: true,
+		},
+		"invalid max cap denom": {
+			state: GenesisState{
+				Params: defaultParams,
+				VirtualStakingMaxCapInfos: []VirtualStakingMaxCapInfo{{
+					Contract:  myContract,
+					Cap:       sdk.NewInt64Coin("other", 1),
+					Delegated: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				}},
+			},
+			expErr: true,
+		},
+		"invalid delegated denom": {
+			state: GenesisState{
+				Params: defaultParams,
+				VirtualStakingMaxCapInfos: []VirtualStakingMaxCapInfo{{
+					Contract:  myContract,
+					Cap:       sdk.NewInt64Coin(sdk.DefaultBondDenom, 1),
+					Delegated: sdk.NewInt64Coin("other", 0),
+				}},
+			},
+			expErr: true,
+		},
+		"delegated amount without max cap": {
+			state: GenesisState{
+				Params:           defaultParams,
+				DelegatedAmounts: []ContractDelegatedAmount{{Contract: myOtherContract, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)}},
+			},
+		},
+		"delegated amount of contract with max cap": {
+			state: GenesisState{
+				Params:                    defaultParams,
+				VirtualStakingMaxCapInfos: []VirtualStakingMaxCapInfo{maxCapInfo(myContract, 1, 0)},
+				DelegatedAmounts:          []ContractDelegatedAmount{{Contract: myContract, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)}},
+			},
+			expErr: true,
+		},
+		"duplicate delegated amount": {
+			state: GenesisState{
+				Params: defaultParams,
+				DelegatedAmounts: []ContractDelegatedAmount{
+					{Contract: myOtherContract, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)},
+					{Contract: myOtherContract, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)},
+				},
+			},
+			expErr: true,
+		},
+		"invalid delegated amount denom": {
+			state: GenesisState{
+				Params:           defaultParams,
+				DelegatedAmounts: []ContractDelegatedAmount{{Contract: myOtherContract, Amount: sdk.NewInt64Coin("other", 1)}},
+			},
+			expErr: true,
+		},
+		"slashed amount": {
+			state: GenesisState{
+				Params:         defaultParams,
+				SlashedAmounts: []ContractDelegatedAmount{{Contract: myContract, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)}},
+			},
+		},
+		"duplicate slashed amount": {
+			state: GenesisState{
+				Params: defaultParams,
+				SlashedAmounts: []ContractDelegatedAmount{
+					{Contract: myContract, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)},
+					{Contract: myContract, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)},
+				},
+			},
+			expErr: true,
+		},
+		"invalid slashed amount denom": {
+			state: GenesisState{
+				Params:         defaultParams,
+				SlashedAmounts: []ContractDelegatedAmount{{Contract: myContract, Amount: sdk.NewInt64Coin("other", 1)}},
+			},
+			expErr: true,
+		},
+		"duplicate scheduled task": {
+			state: GenesisState{
+				Params: defaultParams,
+				ScheduledTasks: []ScheduledTask{
+					{Type: SchedulerTaskHandleEpoch, Contract: myContract, Height: 100, Repeat: true},
+					{Type: SchedulerTaskHandleEpoch, Contract: myContract, Height: 100},
+				},
+			},
+			expErr: true,
+		},
+		"undefined scheduled task type": {
+			state: GenesisState{
+				Params: defaultParams,
+				ScheduledTasks: []ScheduledTask{
+					{Type: uint32(SchedulerTaskUndefined), Contract: myContract, Height: 100},
+				},
+			},
+			expErr: true,
+		},
+		"with paused tasks": {
+			state: GenesisState{
+				Params: defaultParams,
+				PausedTasks: []PausedTasks{
+					{Type: SchedulerTaskHandleEpoch, Contract: myContract},
+					{Type: SchedulerTaskValsetUpdate, Contract: myContract},
+				},
+			},
+		},
+		"duplicate paused tasks": {
+			state: GenesisState{
+				Params: defaultParams,
+				PausedTasks: []PausedTasks{
+					{Type: SchedulerTaskHandleEpoch, Contract: myContract},
+					{Type: SchedulerTaskHandleEpoch, Contract: myContract},
+				},
+			},
+			expErr: true,
+		},
+		"undefined paused tasks type": {
+			state: GenesisState{
+				Params: defaultParams,
+				PausedTasks: []PausedTasks{
+					{Type: uint32(SchedulerTaskUndefined), Contract: myContract},
+				},
+			},
+			expErr: true,
+		},
+		"with failed tasks": {
+			state: GenesisState{
+				Params: defaultParams,
+				RetryTasks: []FailedTask{
+					{ID: 1, Type: SchedulerTaskValsetUpdate, Contract: myContract, Payload: []byte(`{}`), Attempts: 1, Height: 100},
+				},
+				DeadLetterTasks: []FailedTask{
+					{ID: 2, Type: SchedulerTaskValsetUpdate, Contract: myOtherContract, Payload: []byte(`{}`), Attempts: 6, Height: 90},
+				},
+			},
+		},
+		"duplicate failed task id": {
+			state: GenesisState{
+				Params: defaultParams,
+				RetryTasks: []FailedTask{
+					{ID: 1, Type: SchedulerTaskValsetUpdate, Contract: myContract, Payload: []byte(`{}`), Attempts: 1, Height: 100},
+				},
+				DeadLetterTasks: []FailedTask{
+					{ID: 1, Type: SchedulerTaskValsetUpdate, Contract: myOtherContract, Payload: []byte(`{}`), Attempts: 6, Height: 90},
+				},
+			},
+			expErr: true,
+		},
+		"empty failed task id": {
+			state: GenesisState{
+				Params: defaultParams,
+				RetryTasks: []FailedTask{
+					{Type: SchedulerTaskValsetUpdate, Contract: myContract, Payload: []byte(`{}`), Attempts: 1, Height: 100},
+				},
+			},
+			expErr: true,
+		},
+		"failed task without payload": {
+			state: GenesisState{
+				Params: defaultParams,
+				DeadLetterTasks: []FailedTask{
+					{ID: 1, Type: SchedulerTaskValsetUpdate, Contract: myContract, Attempts: 6, Height: 90},
+				},
+			},
+		},
+		"with valset outbox": {
+			state: GenesisState{
+				Params: defaultParams,
+				ValsetOutbox: []ValsetOutboxEntry{
+					{Contract: myContract, Sequence: 1, Operation: uint32(ValidatorJailed), Validator: myValidator},
+					{Contract: myOtherContract, Sequence: 1, Operation: uint32(ValidatorSlashed), Validator: myValidator, SlashInfo: &SlashInfo{}},
+				},
+			},
+		},
+		"duplicate valset outbox sequence": {
+			state: GenesisState{
+				Params: defaultParams,
+				ValsetOutbox: []ValsetOutboxEntry{
+					{Contract: myContract, Sequence: 1, Operation: uint32(ValidatorJailed), Validator: myValidator},
+					{Contract: myContract, Sequence: 1, Operation: uint32(ValidatorUnjailed), Validator: myValidator},
+				},
+			},
+			expErr: true,
+		},
+		"empty valset outbox sequence": {
+			state: GenesisState{
+				Params: defaultParams,
+				ValsetOutbox: []ValsetOutboxEntry{
+					{Contract: myContract, Operation: uint32(ValidatorJailed), Validator: myValidator},
+				},
+			},
+			expErr: true,
+		},
+		"undefined valset outbox operation": {
+			state: GenesisState{
+				Params: defaultParams,
+				ValsetOutbox: []ValsetOutboxEntry{
+					{Contract: myContract, Sequence: 1, Operation: uint32(ValsetOperationUndefined), Validator: myValidator},
+				},
+			},
+			expErr: true,
+		},
+		"valset outbox slash without slash info": {
+			state: GenesisState{
+				Params: defaultParams,
+				ValsetOutbox: []ValsetOutboxEntry{
+					{Contract: myContract, Sequence: 1, Operation: uint32(ValidatorSlashed), Validator: myValidator},
+				},
+			},
+			expErr: true,
+		},
+		"valset outbox power change without power info": {
+			state: GenesisState{
+				Params: defaultParams,
+				ValsetOutbox: []ValsetOutboxEntry{
+					{Contract: myContract, Sequence: 1, Operation: uint32(ValidatorPowerChanged), Validator: myValidator},
+				},
+			},
+			expErr: true,
+		},
+		"invalid valset outbox validator address": {
+			state: GenesisState{
+				Params: defaultParams,
+				ValsetOutbox: []ValsetOutboxEntry{
+					{Contract: myContract, Sequence: 1, Operation: uint32(ValidatorJailed), Validator: myContract},
+				},
+			},
+			expErr: true,
+		},
+		"invalid scheduled task contract address": {
+			state: GenesisState{
+				Params: defaultParams,
+				ScheduledTasks: []ScheduledTask{
+					{Type: SchedulerTaskHandleEpoch, Contract: "invalid", Height: 100},
+				},
+			},
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
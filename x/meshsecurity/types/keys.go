@@ -30,7 +30,34 @@ var (
 	TotalDelegatedAmountKeyPrefix = []byte{0x3}
 	SchedulerKeyPrefix            = []byte{0x4}
 
-	PipedValsetPrefix = []byte{0x5}
+	PausedTasksKeyPrefix = []byte{0x6}
+
+	ContractConfigKeyPrefix = []byte{0x7}
+
+	// SchedulerBlockGasKey is the memory store key for the scheduler gas consumed in the current block
+	SchedulerBlockGasKey = []byte{0x8}
+
+	RetryTaskKeyPrefix      = []byte{0x9}
+	DeadLetterTaskKeyPrefix = []byte{0xa}
+	// FailedTaskSeqKey is the key for the last failed task id
+	FailedTaskSeqKey = []byte{0xb}
+
+	// ValsetOutboxKeyPrefix is the prefix for the valset operations pending for delivery to a contract
+	ValsetOutboxKeyPrefix = []byte{0xc}
+	// ValsetOutboxSeqKeyPrefix is the prefix for the last outbox sequence of a contract
+	ValsetOutboxSeqKeyPrefix = []byte{0xd}
+
+	// TombstoneEvidenceKeyPrefix is the memory store prefix for the evidence details captured for a tombstone
+	TombstoneEvidenceKeyPrefix = []byte{0xe}
+
+	// ValidatorPowerKeyPrefix is the prefix for the validator powers reported to a contract at the last epoch
+	ValidatorPowerKeyPrefix = []byte{0xf}
+
+	// SchedulerCursorKeyPrefix is the prefix for the position of the first deferred task of a scheduler task type
+	SchedulerCursorKeyPrefix = []byte{0x10}
+
+	// SlashedAmountKeyPrefix is the prefix for the amount slashed from the delegations of a contract
+	SlashedAmountKeyPrefix = []byte{0x11}
 )
 
 type PipedValsetOperation byte
@@ -44,15 +71,9 @@ const (
 	ValidatorUnjailed
 	ValidatorModified
 	ValidatorSlashed
+	ValidatorPowerChanged
 )
 
-type SlashInfo struct {
-	InfractionHeight int64
-	Power            int64
-	TotalSlashAmount string
-	SlashFraction    string
-}
-
 // BuildMaxCapLimitKey build max cap limit store key
 func BuildMaxCapLimitKey(contractAddr sdk.AccAddress) []byte {
 	return append(MaxCapLimitKeyPrefix, contractAddr.Bytes()...)
@@ -63,6 +84,16 @@ func BuildTotalDelegatedAmountKey(contractAddr sdk.AccAddress) []byte {
 	return append(TotalDelegatedAmountKeyPrefix, contractAddr.Bytes()...)
 }
 
+// BuildSlashedAmountKey build slashed amount store key for given contract
+func BuildSlashedAmountKey(contractAddr sdk.AccAddress) []byte {
+	return append(SlashedAmountKeyPrefix, contractAddr.Bytes()...)
+}
+
+// BuildContractConfigKey build contract config store key
+func BuildContractConfigKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractConfigKeyPrefix, contractAddr.Bytes()...)
+}
+
 // BuildSchedulerTypeKeyPrefix internal scheduler store key
 func BuildSchedulerTypeKeyPrefix(tp SchedulerTaskType) ([]byte, error) {
 	if tp == SchedulerTaskUndefined {
@@ -89,12 +120,69 @@ func BuildSchedulerContractKey(tp SchedulerTaskType, blockHeight uint64, contrac
 	return append(prefix, contractAddr.Bytes()...), nil
 }
 
-// BuildPipedValsetOpKey build store key for the temporary valset operation store
-func BuildPipedValsetOpKey(op PipedValsetOperation, val sdk.ValAddress, slashInfo *SlashInfo) []byte {
+// BuildSchedulerCursorKey build store key for the scheduler cursor of the given task type
+func BuildSchedulerCursorKey(tp SchedulerTaskType) ([]byte, error) {
+	if tp == SchedulerTaskUndefined {
+		return nil, ErrInvalid.Wrapf("scheduler type: %x", tp)
+	}
+	return append(SchedulerCursorKeyPrefix, byte(tp)), nil
+}
+
+// BuildPausedTasksKey build store key for the paused scheduler tasks of the given type and contract
+func BuildPausedTasksKey(tp SchedulerTaskType, contractAddr sdk.AccAddress) ([]byte, error) {
+	if tp == SchedulerTaskUndefined {
+		return nil, ErrInvalid.Wrapf("scheduler type: %x", tp)
+	}
+	return append(append(PausedTasksKeyPrefix, byte(tp)), contractAddr.Bytes()...), nil
+}
+
+// BuildRetryTaskKey build store key for a failed task that is retried at the given block height
+func BuildRetryTaskKey(blockHeight, id uint64) []byte {
+	return append(append(RetryTaskKeyPrefix, sdk.Uint64ToBigEndian(blockHeight)...), sdk.Uint64ToBigEndian(id)...)
+}
+
+// BuildDeadLetterTaskKey build store key for a failed task that exceeded the max retry attempts
+func BuildDeadLetterTaskKey(id uint64) []byte {
+	return append(DeadLetterTaskKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// BuildValsetOutboxContractPrefix build store key prefix for the valset outbox entries of the given contract
+func BuildValsetOutboxContractPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(ValsetOutboxKeyPrefix, address.MustLengthPrefix(contractAddr)...)
+}
+
+// BuildValsetOutboxKey build store key for a valset outbox entry of the given contract
+func BuildValsetOutboxKey(contractAddr sdk.AccAddress, seq uint64) []byte {
+	return append(BuildValsetOutboxContractPrefix(contractAddr), sdk.Uint64ToBigEndian(seq)...)
+}
+
+// BuildValsetOutboxSeqKey build store key for the last valset outbox sequence of the given contract
+func BuildValsetOutboxSeqKey(contractAddr sdk.AccAddress) []byte {
+	return append(ValsetOutboxSeqKeyPrefix, contractAddr.Bytes()...)
+}
+
+// BuildTombstoneEvidenceKey build memory store key for the evidence details of the given validator
+func BuildTombstoneEvidenceKey(consAddr sdk.ConsAddress) []byte {
+	return append(TombstoneEvidenceKeyPrefix, consAddr.Bytes()...)
+}
+
+// BuildValidatorPowerContractPrefix build store key prefix for the validator powers reported to the given contract
+func BuildValidatorPowerContractPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(ValidatorPowerKeyPrefix, address.MustLengthPrefix(contractAddr)...)
+}
+
+// BuildValidatorPowerKey build store key for the validator power reported to the given contract
+func BuildValidatorPowerKey(contractAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(BuildValidatorPowerContractPrefix(contractAddr), valAddr.Bytes()...)
+}
+
+// BuildValsetOperationKey build a unique key for the valset operation. The key is used to remove duplicates and
+// to sort the operations by validator when building a valset update report
+func BuildValsetOperationKey(op PipedValsetOperation, val sdk.ValAddress, slashInfo *SlashInfo) []byte {
 	if op == ValsetOperationUndefined {
 		panic("empty operation")
 	}
-	pn, an := len(PipedValsetPrefix), len(val)
+	pn, an := 0, len(val)
 	sn := 0
 	if op == ValidatorSlashed {
 		if slashInfo == nil {
@@ -103,7 +191,6 @@ func BuildPipedValsetOpKey(op PipedValsetOperation, val sdk.ValAddress, slashInf
 		sn = 8 + 8 + 1 + len(slashInfo.TotalSlashAmount) + len(slashInfo.SlashFraction) // 8 for height, 8 for power, +1 for total amount length
 	}
 	r := make([]byte, pn+an+sn+1+1) // +1 for address prefix, +1 for op
-	copy(r, PipedValsetPrefix)
 	copy(r[pn:], address.MustLengthPrefix(val))
 	r[pn+an+1] = byte(op)
 	if op == ValidatorSlashed {
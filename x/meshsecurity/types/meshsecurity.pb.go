@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -34,6 +35,8 @@ type VirtualStakingMaxCapInfo struct {
 	Delegated types.Coin `protobuf:"bytes,2,opt,name=delegated,proto3" json:"delegated"`
 	// Cap is the current max cap limit
 	Cap types.Coin `protobuf:"bytes,3,opt,name=cap,proto3" json:"cap"`
+	// Config contains the contract specific overrides of the module params
+	Config ContractConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config"`
 }
 
 func (m *VirtualStakingMaxCapInfo) Reset()         { *m = VirtualStakingMaxCapInfo{} }
@@ -69,6 +72,271 @@ func (m *VirtualStakingMaxCapInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VirtualStakingMaxCapInfo proto.InternalMessageInfo
 
+// ContractConfig stores the contract specific overrides of the module params.
+// Zero values fall back to the module params.
+type ContractConfig struct {
+	// EpochLength is the number of blocks between two rebalance epochs of the
+	// contract
+	EpochLength uint32 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// MaxGasEndBlocker defines the maximum gas that can be spent in a sudo
+	// callback of the contract
+	MaxGasEndBlocker uint32 `protobuf:"varint,2,opt,name=max_gas_end_blocker,json=maxGasEndBlocker,proto3" json:"max_gas_end_blocker,omitempty"`
+	// LegacySlashes sends the slash amounts as bare integer strings without the
+	// delegated amount before the slash in the valset update reports, for
+	// contracts that do not support denominated slashes
+	LegacySlashes bool `protobuf:"varint,3,opt,name=legacy_slashes,json=legacySlashes,proto3" json:"legacy_slashes,omitempty"`
+	// LegacyTombstones sends the tombstoned validators as bare address strings
+	// without the evidence details in the valset update reports, for contracts
+	// that do not support structured tombstones
+	LegacyTombstones bool `protobuf:"varint,4,opt,name=legacy_tombstones,json=legacyTombstones,proto3" json:"legacy_tombstones,omitempty"`
+	// LegacyValidators omits the validator power changes and the extended
+	// validator metadata in the valset update reports, for contracts that do not
+	// support them
+	LegacyValidators bool `protobuf:"varint,5,opt,name=legacy_validators,json=legacyValidators,proto3" json:"legacy_validators,omitempty"`
+	// DisableValsetSnapshots disables the full validator set snapshots that are
+	// sent to the contract on registration, after a chain upgrade or on a
+	// governance request, for contracts that do not support them
+	DisableValsetSnapshots bool `protobuf:"varint,6,opt,name=disable_valset_snapshots,json=disableValsetSnapshots,proto3" json:"disable_valset_snapshots,omitempty"`
+}
+
+func (m *ContractConfig) Reset()         { *m = ContractConfig{} }
+func (m *ContractConfig) String() string { return proto.CompactTextString(m) }
+func (*ContractConfig) ProtoMessage()    {}
+func (*ContractConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53771980e3e4256c, []int{1}
+}
+func (m *ContractConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractConfig.Merge(m, src)
+}
+func (m *ContractConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractConfig proto.InternalMessageInfo
+
+// ValsetOutboxEntry is a validator set operation that is pending for delivery
+// to a virtual staking contract
+type ValsetOutboxEntry struct {
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Sequence is the position of the entry in the outbox of the contract
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Height is the block height of the operation
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Time is the block time of the operation in unix seconds
+	Time int64 `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	// Operation is the type of the validator set operation
+	Operation uint32 `protobuf:"varint,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Validator is the operator address of the validator
+	Validator string `protobuf:"bytes,6,opt,name=validator,proto3" json:"validator,omitempty"`
+	// SlashInfo contains the details of a slash operation
+	SlashInfo *SlashInfo `protobuf:"bytes,7,opt,name=slash_info,json=slashInfo,proto3" json:"slash_info,omitempty"`
+	// TombstoneInfo contains the evidence details of a tombstone operation
+	TombstoneInfo *TombstoneInfo `protobuf:"bytes,8,opt,name=tombstone_info,json=tombstoneInfo,proto3" json:"tombstone_info,omitempty"`
+	// PowerInfo contains the details of a validator power change
+	PowerInfo *PowerInfo `protobuf:"bytes,9,opt,name=power_info,json=powerInfo,proto3" json:"power_info,omitempty"`
+}
+
+func (m *ValsetOutboxEntry) Reset()         { *m = ValsetOutboxEntry{} }
+func (m *ValsetOutboxEntry) String() string { return proto.CompactTextString(m) }
+func (*ValsetOutboxEntry) ProtoMessage()    {}
+func (*ValsetOutboxEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53771980e3e4256c, []int{2}
+}
+func (m *ValsetOutboxEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValsetOutboxEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValsetOutboxEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValsetOutboxEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValsetOutboxEntry.Merge(m, src)
+}
+func (m *ValsetOutboxEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValsetOutboxEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValsetOutboxEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValsetOutboxEntry proto.InternalMessageInfo
+
+// SlashInfo contains the details of a validator slash
+type SlashInfo struct {
+	// InfractionHeight is the block height of the infraction
+	InfractionHeight int64 `protobuf:"varint,1,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// Power is the validator power at the infraction height
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// TotalSlashAmount is the total amount slashed from the validator
+	TotalSlashAmount string `protobuf:"bytes,3,opt,name=total_slash_amount,json=totalSlashAmount,proto3" json:"total_slash_amount,omitempty"`
+	// SlashFraction is the fraction of the stake slashed
+	SlashFraction string `protobuf:"bytes,4,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	// InfractionTime is the block time of the infraction in unix seconds. Only
+	// set when InfractionTimeKnown is true.
+	InfractionTime int64 `protobuf:"varint,5,opt,name=infraction_time,json=infractionTime,proto3" json:"infraction_time,omitempty"`
+	// DelegatedAmount is the amount delegated by the contract to the validator
+	// at the moment of the slash
+	DelegatedAmount string `protobuf:"bytes,6,opt,name=delegated_amount,json=delegatedAmount,proto3" json:"delegated_amount,omitempty"`
+	// SlashAmount is the amount slashed from the delegation of the contract
+	SlashAmount string `protobuf:"bytes,7,opt,name=slash_amount,json=slashAmount,proto3" json:"slash_amount,omitempty"`
+	// InfractionTimeKnown is false when the block time of the infraction height
+	// was not available anymore
+	InfractionTimeKnown bool `protobuf:"varint,8,opt,name=infraction_time_known,json=infractionTimeKnown,proto3" json:"infraction_time_known,omitempty"`
+}
+
+func (m *SlashInfo) Reset()         { *m = SlashInfo{} }
+func (m *SlashInfo) String() string { return proto.CompactTextString(m) }
+func (*SlashInfo) ProtoMessage()    {}
+func (*SlashInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53771980e3e4256c, []int{3}
+}
+func (m *SlashInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashInfo.Merge(m, src)
+}
+func (m *SlashInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashInfo proto.InternalMessageInfo
+
+// TombstoneInfo contains the evidence details of a validator tombstone
+type TombstoneInfo struct {
+	// ConsAddress is the consensus address of the validator
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// EvidenceType is the infraction type of the evidence, e.g. double_sign
+	EvidenceType string `protobuf:"bytes,2,opt,name=evidence_type,json=evidenceType,proto3" json:"evidence_type,omitempty"`
+	// InfractionHeight is the block height of the infraction
+	InfractionHeight int64 `protobuf:"varint,3,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// InfractionTime is the block time of the infraction in unix seconds. Only
+	// set when InfractionTimeKnown is true.
+	InfractionTime int64 `protobuf:"varint,4,opt,name=infraction_time,json=infractionTime,proto3" json:"infraction_time,omitempty"`
+	// InfractionTimeKnown is false when the block time of the infraction height
+	// was not available anymore
+	InfractionTimeKnown bool `protobuf:"varint,5,opt,name=infraction_time_known,json=infractionTimeKnown,proto3" json:"infraction_time_known,omitempty"`
+}
+
+func (m *TombstoneInfo) Reset()         { *m = TombstoneInfo{} }
+func (m *TombstoneInfo) String() string { return proto.CompactTextString(m) }
+func (*TombstoneInfo) ProtoMessage()    {}
+func (*TombstoneInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53771980e3e4256c, []int{4}
+}
+func (m *TombstoneInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TombstoneInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TombstoneInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TombstoneInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TombstoneInfo.Merge(m, src)
+}
+func (m *TombstoneInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TombstoneInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TombstoneInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TombstoneInfo proto.InternalMessageInfo
+
+// PowerInfo contains the voting power of an active validator captured at the
+// end of an epoch
+type PowerInfo struct {
+	// Power is the consensus power of the validator
+	Power int64 `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
+	// PreviousPower is the consensus power reported at the previous epoch
+	PreviousPower int64 `protobuf:"varint,2,opt,name=previous_power,json=previousPower,proto3" json:"previous_power,omitempty"`
+	// Tokens is the amount of tokens bonded to the validator
+	Tokens string `protobuf:"bytes,3,opt,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (m *PowerInfo) Reset()         { *m = PowerInfo{} }
+func (m *PowerInfo) String() string { return proto.CompactTextString(m) }
+func (*PowerInfo) ProtoMessage()    {}
+func (*PowerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53771980e3e4256c, []int{5}
+}
+func (m *PowerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PowerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PowerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PowerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerInfo.Merge(m, src)
+}
+func (m *PowerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PowerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PowerInfo proto.InternalMessageInfo
+
 // Params defines the parameters for the x/meshsecurity module.
 type Params struct {
 	// TotalContractsMaxCap is the maximum that the sum of all contract max caps
@@ -79,13 +347,28 @@ type Params struct {
 	// MaxGasEndBlocker defines the maximum gas that can be spent in a contract
 	// sudo callback
 	MaxGasEndBlocker uint32 `protobuf:"varint,3,opt,name=max_gas_end_blocker,json=maxGasEndBlocker,proto3" json:"max_gas_end_blocker,omitempty"`
+	// MaxGasSchedulerPerBlock defines the total gas budget for all scheduled
+	// tasks executed in a single block. Tasks that do not fit are deferred to
+	// the next block. A value of 0 disables the budget.
+	MaxGasSchedulerPerBlock uint32 `protobuf:"varint,4,opt,name=max_gas_scheduler_per_block,json=maxGasSchedulerPerBlock,proto3" json:"max_gas_scheduler_per_block,omitempty"`
+	// MaxRetryAttempts is the number of retries for a failed one-shot scheduler
+	// task before it is moved to the dead letter store. A value of 0 disables
+	// retries.
+	MaxRetryAttempts uint32 `protobuf:"varint,5,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	// RetryBackoffBlocks is the number of blocks before the first retry of a
+	// failed task. The delay is doubled with every further attempt.
+	RetryBackoffBlocks uint32 `protobuf:"varint,6,opt,name=retry_backoff_blocks,json=retryBackoffBlocks,proto3" json:"retry_backoff_blocks,omitempty"`
+	// MaxCapGracePeriod is the number of blocks a contract has to undelegate the
+	// amount above a lowered max cap limit before the module undelegates the
+	// excess itself. A value of 0 disables the enforcement.
+	MaxCapGracePeriod uint32 `protobuf:"varint,7,opt,name=max_cap_grace_period,json=maxCapGracePeriod,proto3" json:"max_cap_grace_period,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_53771980e3e4256c, []int{1}
+	return fileDescriptor_53771980e3e4256c, []int{6}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -116,6 +399,11 @@ var xxx_messageInfo_Params proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*VirtualStakingMaxCapInfo)(nil), "osmosis.meshsecurity.v1beta1.VirtualStakingMaxCapInfo")
+	proto.RegisterType((*ContractConfig)(nil), "osmosis.meshsecurity.v1beta1.ContractConfig")
+	proto.RegisterType((*ValsetOutboxEntry)(nil), "osmosis.meshsecurity.v1beta1.ValsetOutboxEntry")
+	proto.RegisterType((*SlashInfo)(nil), "osmosis.meshsecurity.v1beta1.SlashInfo")
+	proto.RegisterType((*TombstoneInfo)(nil), "osmosis.meshsecurity.v1beta1.TombstoneInfo")
+	proto.RegisterType((*PowerInfo)(nil), "osmosis.meshsecurity.v1beta1.PowerInfo")
 	proto.RegisterType((*Params)(nil), "osmosis.meshsecurity.v1beta1.Params")
 }
 
@@ -124,33 +412,75 @@ func init() {
 }
 
 var fileDescriptor_53771980e3e4256c = []byte{
-	// 416 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xce, 0xb8, 0xcb, 0xe2, 0xce, 0xba, 0xa0, 0xd9, 0x05, 0x63, 0x59, 0x66, 0xd7, 0x9e, 0x8a,
-	0x90, 0x84, 0xea, 0xad, 0xa0, 0x87, 0x16, 0x11, 0x41, 0x41, 0x22, 0xf4, 0xe0, 0x25, 0xbe, 0x4c,
-	0xc6, 0x64, 0x68, 0x32, 0x13, 0x32, 0x53, 0x49, 0xff, 0x82, 0x27, 0x7f, 0x82, 0x47, 0x4f, 0xe2,
-	0xcf, 0xe8, 0xb1, 0x47, 0x4f, 0xa2, 0xe9, 0x41, 0x7f, 0x86, 0x64, 0xd2, 0x54, 0x72, 0xeb, 0x65,
-	0x78, 0xf3, 0xbd, 0xf9, 0xbe, 0xf9, 0x3e, 0xde, 0xc3, 0xbe, 0x54, 0xb9, 0x54, 0x5c, 0xf9, 0x39,
-	0x53, 0xa9, 0x62, 0x74, 0x59, 0x72, 0xbd, 0xf2, 0x3f, 0x8e, 0x23, 0xa6, 0x61, 0xdc, 0x03, 0xbd,
-	0xa2, 0x94, 0x5a, 0xda, 0x57, 0x3b, 0x82, 0xd7, 0xeb, 0xed, 0x08, 0x03, 0x42, 0x4d, 0xdb, 0x8f,
-	0x40, 0xb1, 0xbd, 0x0a, 0x95, 0x5c, 0xb4, 0xec, 0xc1, 0x65, 0x22, 0x13, 0x69, 0x4a, 0xbf, 0xa9,
-	0x76, 0xe8, 0x3d, 0xc8, 0xb9, 0x90, 0xbe, 0x39, 0x5b, 0x68, 0xf8, 0x0d, 0x61, 0x67, 0xce, 0x4b,
-	0xbd, 0x84, 0xec, 0xad, 0x86, 0x05, 0x17, 0xc9, 0x6b, 0xa8, 0x66, 0x50, 0xbc, 0x14, 0x1f, 0xa4,
-	0x3d, 0xc0, 0xb7, 0xa9, 0x14, 0xba, 0x04, 0xaa, 0x1d, 0x74, 0x83, 0x46, 0xa7, 0xc1, 0xfe, 0x6e,
-	0x3f, 0xc5, 0xa7, 0x31, 0xcb, 0x58, 0x02, 0x9a, 0xc5, 0xce, 0xad, 0x1b, 0x34, 0x3a, 0x7b, 0xfc,
-	0xc0, 0x6b, 0x5d, 0x79, 0x8d, 0xab, 0xce, 0xaa, 0x37, 0x93, 0x5c, 0x4c, 0x8f, 0xd7, 0x3f, 0xaf,
-	0xad, 0xe0, 0x3f, 0xc3, 0x1e, 0xe3, 0x23, 0x0a, 0x85, 0x73, 0x74, 0x18, 0xb1, 0x79, 0x3b, 0x39,
-	0xfe, 0xfb, 0xe5, 0x1a, 0x0d, 0x37, 0x08, 0x9f, 0xbc, 0x81, 0x12, 0x72, 0x65, 0xcf, 0xf1, 0x7d,
-	0x2d, 0x35, 0x64, 0x61, 0x67, 0x4a, 0x85, 0x39, 0x54, 0x61, 0xa3, 0x8b, 0x0e, 0xd3, 0xbd, 0x34,
-	0xfc, 0x59, 0x47, 0x6f, 0xa3, 0xdb, 0x0f, 0xf1, 0x1d, 0x56, 0x48, 0x9a, 0x86, 0x19, 0x13, 0x89,
-	0x4e, 0x4d, 0xba, 0xf3, 0xe0, 0xcc, 0x60, 0xaf, 0x0c, 0x64, 0xbb, 0xf8, 0xa2, 0xf9, 0x2a, 0x01,
-	0x15, 0x32, 0x11, 0x87, 0x51, 0x26, 0xe9, 0x82, 0x95, 0x26, 0xce, 0x79, 0x70, 0x37, 0x87, 0xea,
-	0x05, 0xa8, 0xe7, 0x22, 0x9e, 0xb6, 0xf8, 0xe4, 0xaa, 0xb1, 0xfe, 0xe9, 0xcf, 0xf7, 0x47, 0x17,
-	0xbd, 0xf1, 0xb7, 0x39, 0xa6, 0xef, 0xd7, 0xbf, 0x89, 0xf5, 0xb5, 0x26, 0xd6, 0xba, 0x26, 0x68,
-	0x53, 0x13, 0xf4, 0xab, 0x26, 0xe8, 0xf3, 0x96, 0x58, 0x9b, 0x2d, 0xb1, 0x7e, 0x6c, 0x89, 0xf5,
-	0xee, 0x59, 0xc2, 0x75, 0xba, 0x8c, 0x3c, 0x2a, 0xf3, 0x6e, 0x91, 0xdc, 0x0c, 0xa2, 0x76, 0x9b,
-	0xdc, 0x4e, 0xcf, 0x55, 0xf1, 0xc2, 0xaf, 0xfa, 0x1b, 0xa6, 0x57, 0x05, 0x53, 0xd1, 0x89, 0x19,
-	0xf6, 0x93, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x98, 0xcb, 0x62, 0xae, 0x86, 0x02, 0x00, 0x00,
+	// 1075 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xc6, 0x8e, 0xeb, 0x9d, 0x74, 0xd3, 0x64, 0x62, 0xda, 0x6d, 0x88, 0xdc, 0x3f, 0xa8,
+	0x6a, 0xa1, 0x8d, 0x4d, 0x0a, 0x42, 0xa8, 0x02, 0xa4, 0x24, 0x6a, 0xcb, 0x5f, 0x11, 0x4d, 0xa2,
+	0x1c, 0xb8, 0x2c, 0xe3, 0xdd, 0xf1, 0x7a, 0x65, 0xef, 0xcc, 0xb2, 0x33, 0x4e, 0xe3, 0xaf, 0xc0,
+	0x89, 0x6f, 0x00, 0x12, 0x17, 0x8e, 0x1c, 0xf8, 0x10, 0x39, 0x16, 0xc4, 0x81, 0x13, 0x82, 0xe4,
+	0x50, 0x3e, 0x03, 0x27, 0x34, 0x6f, 0x66, 0xd7, 0x76, 0x49, 0xd2, 0x5c, 0x56, 0x33, 0xbf, 0xf7,
+	0x77, 0xde, 0xef, 0xcd, 0xdb, 0x41, 0x1d, 0x21, 0x53, 0x21, 0x13, 0xd9, 0x49, 0x99, 0xec, 0x4b,
+	0x16, 0x8e, 0xf2, 0x44, 0x8d, 0x3b, 0x07, 0x1b, 0x5d, 0xa6, 0xe8, 0xc6, 0x0c, 0xd8, 0xce, 0x72,
+	0xa1, 0x04, 0x5e, 0xb3, 0x06, 0xed, 0x19, 0x99, 0x35, 0x58, 0x6d, 0x85, 0x20, 0xee, 0x74, 0xa9,
+	0x64, 0xa5, 0x97, 0x50, 0x24, 0xdc, 0x58, 0xaf, 0x36, 0x63, 0x11, 0x0b, 0x58, 0x76, 0xf4, 0xca,
+	0xa2, 0xcb, 0x34, 0x4d, 0xb8, 0xe8, 0xc0, 0xd7, 0x42, 0xd7, 0x8d, 0xa3, 0xc0, 0xe8, 0x9a, 0x8d,
+	0x11, 0xdd, 0xfe, 0xd7, 0x41, 0xfe, 0x7e, 0x92, 0xab, 0x11, 0x1d, 0xee, 0x2a, 0x3a, 0x48, 0x78,
+	0xfc, 0x05, 0x3d, 0xdc, 0xa6, 0xd9, 0x27, 0xbc, 0x27, 0xf0, 0x2a, 0x6a, 0x84, 0x82, 0xab, 0x9c,
+	0x86, 0xca, 0x77, 0x6e, 0x3a, 0xf7, 0x5c, 0x52, 0xee, 0xf1, 0x87, 0xc8, 0x8d, 0xd8, 0x90, 0xc5,
+	0x54, 0xb1, 0xc8, 0x9f, 0xbb, 0xe9, 0xdc, 0x5b, 0x78, 0x78, 0xbd, 0x6d, 0x5d, 0xeb, 0x84, 0x8b,
+	0x53, 0xb4, 0xb7, 0x45, 0xc2, 0xb7, 0x6a, 0x47, 0x7f, 0xde, 0xa8, 0x90, 0x89, 0x05, 0xde, 0x40,
+	0xd5, 0x90, 0x66, 0x7e, 0xf5, 0x62, 0x86, 0x5a, 0x17, 0x7f, 0x8a, 0xea, 0xa1, 0xe0, 0xbd, 0x24,
+	0xf6, 0x6b, 0x60, 0xf5, 0xa0, 0x7d, 0x5e, 0xf5, 0xda, 0xdb, 0x36, 0xd3, 0x6d, 0xb0, 0xb1, 0x8e,
+	0xac, 0x87, 0x47, 0xb5, 0x7f, 0x7e, 0xb8, 0xe1, 0xdc, 0xfe, 0x71, 0x0e, 0x2d, 0xce, 0xaa, 0xe1,
+	0x5b, 0xe8, 0x32, 0xcb, 0x44, 0xd8, 0x0f, 0x86, 0x8c, 0xc7, 0xaa, 0x0f, 0xc7, 0xf6, 0xc8, 0x02,
+	0x60, 0x9f, 0x03, 0x84, 0xd7, 0xd1, 0x4a, 0x4a, 0x0f, 0x83, 0x98, 0xca, 0x80, 0xf1, 0x28, 0xe8,
+	0x0e, 0x45, 0x38, 0x60, 0x39, 0xd4, 0xc0, 0x23, 0x4b, 0x29, 0x3d, 0x7c, 0x4a, 0xe5, 0x63, 0x1e,
+	0x6d, 0x19, 0x1c, 0xdf, 0x41, 0x8b, 0xfa, 0xd0, 0xe1, 0x38, 0x90, 0x43, 0x2a, 0xfb, 0x4c, 0xc2,
+	0xa1, 0x1b, 0xc4, 0x33, 0xe8, 0xae, 0x01, 0xf1, 0x7d, 0xb4, 0x6c, 0xd5, 0x94, 0x48, 0xbb, 0x52,
+	0x09, 0xce, 0x24, 0x1c, 0xb4, 0x41, 0x96, 0x8c, 0x60, 0xaf, 0xc4, 0xa7, 0x94, 0x0f, 0xe8, 0x30,
+	0x89, 0xa8, 0x12, 0xb9, 0xf4, 0xe7, 0xa7, 0x95, 0xf7, 0x4b, 0x1c, 0xbf, 0x8f, 0xfc, 0x28, 0x91,
+	0xb4, 0x3b, 0x64, 0x5a, 0x5b, 0x32, 0x15, 0x48, 0x4e, 0x33, 0xd9, 0x17, 0x4a, 0xfa, 0x75, 0xb0,
+	0xb9, 0x6a, 0xe5, 0xfb, 0x20, 0xde, 0x2d, 0xa4, 0xb6, 0x4a, 0xbf, 0x56, 0xd1, 0xb2, 0x91, 0x7c,
+	0x39, 0x52, 0x5d, 0x71, 0xf8, 0x98, 0xab, 0x7c, 0x8c, 0xdf, 0x7d, 0xb9, 0x37, 0xb6, 0xfc, 0xdf,
+	0x7e, 0x59, 0x6f, 0x5a, 0x22, 0x37, 0xa3, 0x28, 0x67, 0x52, 0xee, 0xaa, 0x3c, 0xe1, 0xf1, 0x54,
+	0xd7, 0xac, 0xa2, 0x86, 0x64, 0xdf, 0x8c, 0x18, 0x0f, 0x19, 0x14, 0xac, 0x46, 0xca, 0x3d, 0xbe,
+	0x8a, 0xea, 0x7d, 0x96, 0xc4, 0x7d, 0x05, 0x05, 0xaa, 0x12, 0xbb, 0xc3, 0x18, 0xd5, 0x54, 0x92,
+	0x32, 0x28, 0x46, 0x95, 0xc0, 0x1a, 0xaf, 0x21, 0x57, 0x64, 0x2c, 0xa7, 0x2a, 0x11, 0x1c, 0x0e,
+	0xee, 0x91, 0x09, 0x80, 0xdf, 0x43, 0x6e, 0x59, 0x17, 0xbf, 0xfe, 0x8a, 0xe4, 0x26, 0xaa, 0xf8,
+	0x09, 0x42, 0xc0, 0x51, 0x90, 0xf0, 0x9e, 0xf0, 0x2f, 0x41, 0x97, 0xdd, 0x3d, 0xbf, 0xcb, 0x80,
+	0x3e, 0x7d, 0x59, 0x88, 0x2b, 0x8b, 0x25, 0x26, 0x68, 0xb1, 0x24, 0xd1, 0xf8, 0x6a, 0x80, 0xaf,
+	0xfb, 0xe7, 0xfb, 0x2a, 0x09, 0x06, 0x7f, 0x9e, 0x9a, 0xde, 0xea, 0xdc, 0x32, 0xf1, 0x8c, 0xe5,
+	0xc6, 0x9f, 0x7b, 0x91, 0xdc, 0x76, 0xb4, 0xbe, 0xc9, 0x2d, 0x2b, 0x96, 0x96, 0xd3, 0xdf, 0xe7,
+	0x90, 0x5b, 0xa6, 0xae, 0xdb, 0x29, 0xe1, 0x3d, 0x4d, 0x50, 0x22, 0x78, 0x60, 0x49, 0x70, 0xa0,
+	0xdc, 0x4b, 0x13, 0xc1, 0xc7, 0x86, 0x8e, 0x26, 0x9a, 0x07, 0x6f, 0xc0, 0x5f, 0x95, 0x98, 0x0d,
+	0x7e, 0x80, 0xb0, 0x12, 0x8a, 0x0e, 0x4d, 0x93, 0x07, 0x34, 0x15, 0x23, 0x6e, 0x88, 0x74, 0xc9,
+	0x12, 0x48, 0x20, 0xdc, 0x26, 0xe0, 0xfa, 0x4e, 0x18, 0xbd, 0xc2, 0x37, 0x90, 0xeb, 0x12, 0x0f,
+	0xd0, 0x27, 0x16, 0xc4, 0x77, 0xd1, 0x95, 0xa9, 0xbc, 0xa0, 0x09, 0xe6, 0x21, 0xe8, 0xe2, 0x04,
+	0xde, 0xd3, 0xed, 0xf0, 0x26, 0x5a, 0x2a, 0x47, 0x4b, 0x11, 0x1b, 0x78, 0x27, 0x57, 0x4a, 0xdc,
+	0x86, 0xbe, 0x85, 0x2e, 0xcf, 0xa4, 0x78, 0x09, 0xd4, 0x16, 0xe4, 0x54, 0x76, 0x0f, 0xd1, 0x6b,
+	0x2f, 0x85, 0x0d, 0x06, 0x5c, 0x3c, 0xe3, 0xc0, 0x62, 0x83, 0xac, 0xcc, 0x06, 0xff, 0x4c, 0x8b,
+	0x6c, 0x59, 0x5f, 0x38, 0xc8, 0x9b, 0x61, 0x51, 0x87, 0x0b, 0x05, 0x97, 0x01, 0x35, 0x3d, 0x67,
+	0xc7, 0xe8, 0x82, 0xc6, 0x6c, 0x1b, 0xe2, 0x37, 0x90, 0xc7, 0x0e, 0x92, 0x48, 0xdf, 0x81, 0x40,
+	0x8d, 0x33, 0x73, 0x31, 0x5c, 0x72, 0xb9, 0x00, 0xf7, 0xc6, 0x19, 0x3b, 0x9d, 0xa2, 0xea, 0x19,
+	0x14, 0x9d, 0x52, 0xb7, 0xda, 0xa9, 0x75, 0x3b, 0xf3, 0xa4, 0xf3, 0xaf, 0x3a, 0x69, 0x0f, 0xb9,
+	0x65, 0x7b, 0x4d, 0x5a, 0xc2, 0x99, 0x6e, 0x89, 0x3b, 0x68, 0x31, 0xcb, 0xd9, 0x41, 0x22, 0x46,
+	0x32, 0x98, 0xee, 0x18, 0xaf, 0x40, 0xc1, 0x81, 0xbe, 0xf6, 0x4a, 0x0c, 0x18, 0x97, 0xb6, 0x5b,
+	0xec, 0xce, 0xc6, 0xf9, 0xbe, 0x8a, 0xea, 0x3b, 0x34, 0xa7, 0xa9, 0xc4, 0xfb, 0xe8, 0x9a, 0x69,
+	0xb1, 0x62, 0x9a, 0xc8, 0x40, 0xcf, 0x61, 0xfd, 0x1b, 0x71, 0x2e, 0xf6, 0x1b, 0x69, 0x82, 0x7d,
+	0x31, 0xf1, 0xa5, 0xf9, 0xd3, 0xfd, 0x6f, 0xe4, 0xcf, 0x5d, 0x78, 0xe4, 0x57, 0xcf, 0x18, 0xf9,
+	0x1f, 0xa0, 0xd7, 0x0b, 0x75, 0x19, 0xf6, 0x59, 0x34, 0x1a, 0xb2, 0x3c, 0xc8, 0x58, 0x6e, 0x0c,
+	0x81, 0x0b, 0x8f, 0x5c, 0x33, 0x66, 0xbb, 0x85, 0xc2, 0x0e, 0xcb, 0xc1, 0x5e, 0x5f, 0x25, 0x6d,
+	0x9d, 0x33, 0x95, 0x8f, 0x03, 0xaa, 0x14, 0x4b, 0x33, 0x25, 0xfd, 0xf9, 0x32, 0x16, 0xd1, 0x82,
+	0x4d, 0x8b, 0xe3, 0xb7, 0x51, 0xd3, 0x68, 0x76, 0x69, 0x38, 0x10, 0xbd, 0x9e, 0x89, 0x61, 0x26,
+	0xbb, 0x47, 0x30, 0xc8, 0xb6, 0x8c, 0x08, 0xdc, 0x4b, 0xdc, 0x41, 0x4d, 0x5b, 0xb7, 0x20, 0xce,
+	0x69, 0xc8, 0x74, 0x66, 0x89, 0x88, 0xe0, 0x26, 0x78, 0x64, 0x39, 0x85, 0xaa, 0x3c, 0xd5, 0x92,
+	0x1d, 0x10, 0x3c, 0x5a, 0xd3, 0x4c, 0x7c, 0xfb, 0xe2, 0xe7, 0xb7, 0x56, 0x66, 0xde, 0x35, 0x86,
+	0x96, 0xad, 0xaf, 0x8f, 0xfe, 0x6e, 0x55, 0x7e, 0x3a, 0x6e, 0x55, 0x8e, 0x8e, 0x5b, 0xce, 0xf3,
+	0xe3, 0x96, 0xf3, 0xd7, 0x71, 0xcb, 0xf9, 0xee, 0xa4, 0x55, 0x79, 0x7e, 0xd2, 0xaa, 0xfc, 0x71,
+	0xd2, 0xaa, 0x7c, 0xf5, 0x51, 0x9c, 0xa8, 0xfe, 0xa8, 0xdb, 0x0e, 0x45, 0x5a, 0xbc, 0x90, 0xd6,
+	0x87, 0xb4, 0x6b, 0x9e, 0x49, 0xeb, 0x85, 0xbf, 0x75, 0x19, 0x0d, 0x3a, 0x87, 0xb3, 0x4f, 0x27,
+	0x7d, 0x1d, 0x64, 0xb7, 0x0e, 0x4f, 0x95, 0x77, 0xfe, 0x1b, 0x00, 0x9c, 0xed, 0xb1, 0xf5, 0x5f,
+	0x09, 0x00, 0x00,
 }
 
 func (this *VirtualStakingMaxCapInfo) Equal(that interface{}) bool {
@@ -181,16 +511,19 @@ func (this *VirtualStakingMaxCapInfo) Equal(that interface{}) bool {
 	if !this.Cap.Equal(&that1.Cap) {
 		return false
 	}
+	if !this.Config.Equal(&that1.Config) {
+		return false
+	}
 	return true
 }
-func (this *Params) Equal(that interface{}) bool {
+func (this *ContractConfig) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Params)
+	that1, ok := that.(*ContractConfig)
 	if !ok {
-		that2, ok := that.(Params)
+		that2, ok := that.(ContractConfig)
 		if ok {
 			that1 = &that2
 		} else {
@@ -202,162 +535,1807 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.TotalContractsMaxCap.Equal(&that1.TotalContractsMaxCap) {
-		return false
-	}
 	if this.EpochLength != that1.EpochLength {
 		return false
 	}
 	if this.MaxGasEndBlocker != that1.MaxGasEndBlocker {
 		return false
 	}
+	if this.LegacySlashes != that1.LegacySlashes {
+		return false
+	}
+	if this.LegacyTombstones != that1.LegacyTombstones {
+		return false
+	}
+	if this.LegacyValidators != that1.LegacyValidators {
+		return false
+	}
+	if this.DisableValsetSnapshots != that1.DisableValsetSnapshots {
+		return false
+	}
 	return true
 }
-func (m *VirtualStakingMaxCapInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ValsetOutboxEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *VirtualStakingMaxCapInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *VirtualStakingMaxCapInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*ValsetOutboxEntry)
+	if !ok {
+		that2, ok := that.(ValsetOutboxEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintMeshsecurity(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Delegated.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMeshsecurity(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintMeshsecurity(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+	if this.Contract != that1.Contract {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Sequence != that1.Sequence {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxGasEndBlocker != 0 {
-		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.MaxGasEndBlocker))
-		i--
-		dAtA[i] = 0x18
+	if this.Height != that1.Height {
+		return false
 	}
-	if m.EpochLength != 0 {
-		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.EpochLength))
-		i--
-		dAtA[i] = 0x10
+	if this.Time != that1.Time {
+		return false
 	}
-	{
-		size, err := m.TotalContractsMaxCap.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMeshsecurity(dAtA, i, uint64(size))
+	if this.Operation != that1.Operation {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintMeshsecurity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMeshsecurity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.Validator != that1.Validator {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if !this.SlashInfo.Equal(that1.SlashInfo) {
+		return false
+	}
+	if !this.TombstoneInfo.Equal(that1.TombstoneInfo) {
+		return false
+	}
+	if !this.PowerInfo.Equal(that1.PowerInfo) {
+		return false
+	}
+	return true
 }
-func (m *VirtualStakingMaxCapInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *SlashInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovMeshsecurity(uint64(l))
+
+	that1, ok := that.(*SlashInfo)
+	if !ok {
+		that2, ok := that.(SlashInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InfractionHeight != that1.InfractionHeight {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if this.TotalSlashAmount != that1.TotalSlashAmount {
+		return false
+	}
+	if this.SlashFraction != that1.SlashFraction {
+		return false
+	}
+	if this.InfractionTime != that1.InfractionTime {
+		return false
+	}
+	if this.DelegatedAmount != that1.DelegatedAmount {
+		return false
+	}
+	if this.SlashAmount != that1.SlashAmount {
+		return false
+	}
+	if this.InfractionTimeKnown != that1.InfractionTimeKnown {
+		return false
+	}
+	return true
+}
+func (this *TombstoneInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TombstoneInfo)
+	if !ok {
+		that2, ok := that.(TombstoneInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ConsAddress != that1.ConsAddress {
+		return false
+	}
+	if this.EvidenceType != that1.EvidenceType {
+		return false
+	}
+	if this.InfractionHeight != that1.InfractionHeight {
+		return false
+	}
+	if this.InfractionTime != that1.InfractionTime {
+		return false
+	}
+	if this.InfractionTimeKnown != that1.InfractionTimeKnown {
+		return false
+	}
+	return true
+}
+func (this *PowerInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PowerInfo)
+	if !ok {
+		that2, ok := that.(PowerInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if this.PreviousPower != that1.PreviousPower {
+		return false
+	}
+	if this.Tokens != that1.Tokens {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TotalContractsMaxCap.Equal(&that1.TotalContractsMaxCap) {
+		return false
+	}
+	if this.EpochLength != that1.EpochLength {
+		return false
+	}
+	if this.MaxGasEndBlocker != that1.MaxGasEndBlocker {
+		return false
+	}
+	if this.MaxGasSchedulerPerBlock != that1.MaxGasSchedulerPerBlock {
+		return false
+	}
+	if this.MaxRetryAttempts != that1.MaxRetryAttempts {
+		return false
+	}
+	if this.RetryBackoffBlocks != that1.RetryBackoffBlocks {
+		return false
+	}
+	if this.MaxCapGracePeriod != that1.MaxCapGracePeriod {
+		return false
+	}
+	return true
+}
+func (m *VirtualStakingMaxCapInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VirtualStakingMaxCapInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VirtualStakingMaxCapInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Delegated.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisableValsetSnapshots {
+		i--
+		if m.DisableValsetSnapshots {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LegacyValidators {
+		i--
+		if m.LegacyValidators {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LegacyTombstones {
+		i--
+		if m.LegacyTombstones {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LegacySlashes {
+		i--
+		if m.LegacySlashes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxGasEndBlocker != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.MaxGasEndBlocker))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValsetOutboxEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValsetOutboxEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValsetOutboxEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PowerInfo != nil {
+		{
+			size, err := m.PowerInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMeshsecurity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TombstoneInfo != nil {
+		{
+			size, err := m.TombstoneInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMeshsecurity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SlashInfo != nil {
+		{
+			size, err := m.SlashInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMeshsecurity(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Operation != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Time != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InfractionTimeKnown {
+		i--
+		if m.InfractionTimeKnown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SlashAmount) > 0 {
+		i -= len(m.SlashAmount)
+		copy(dAtA[i:], m.SlashAmount)
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(len(m.SlashAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DelegatedAmount) > 0 {
+		i -= len(m.DelegatedAmount)
+		copy(dAtA[i:], m.DelegatedAmount)
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(len(m.DelegatedAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.InfractionTime != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.InfractionTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SlashFraction) > 0 {
+		i -= len(m.SlashFraction)
+		copy(dAtA[i:], m.SlashFraction)
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(len(m.SlashFraction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TotalSlashAmount) > 0 {
+		i -= len(m.TotalSlashAmount)
+		copy(dAtA[i:], m.TotalSlashAmount)
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(len(m.TotalSlashAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Power != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InfractionHeight != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TombstoneInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TombstoneInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TombstoneInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InfractionTimeKnown {
+		i--
+		if m.InfractionTimeKnown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InfractionTime != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.InfractionTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InfractionHeight != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EvidenceType) > 0 {
+		i -= len(m.EvidenceType)
+		copy(dAtA[i:], m.EvidenceType)
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(len(m.EvidenceType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PowerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		i -= len(m.Tokens)
+		copy(dAtA[i:], m.Tokens)
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(len(m.Tokens)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PreviousPower != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.PreviousPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Power != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxCapGracePeriod != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.MaxCapGracePeriod))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RetryBackoffBlocks != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.RetryBackoffBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxRetryAttempts != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.MaxRetryAttempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxGasSchedulerPerBlock != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.MaxGasSchedulerPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxGasEndBlocker != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.MaxGasEndBlocker))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.TotalContractsMaxCap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMeshsecurity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMeshsecurity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMeshsecurity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VirtualStakingMaxCapInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	l = m.Delegated.Size()
+	n += 1 + l + sovMeshsecurity(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovMeshsecurity(uint64(l))
+	l = m.Config.Size()
+	n += 1 + l + sovMeshsecurity(uint64(l))
+	return n
+}
+
+func (m *ContractConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.EpochLength))
+	}
+	if m.MaxGasEndBlocker != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.MaxGasEndBlocker))
+	}
+	if m.LegacySlashes {
+		n += 2
+	}
+	if m.LegacyTombstones {
+		n += 2
+	}
+	if m.LegacyValidators {
+		n += 2
+	}
+	if m.DisableValsetSnapshots {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValsetOutboxEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.Time))
+	}
+	if m.Operation != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.Operation))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	if m.SlashInfo != nil {
+		l = m.SlashInfo.Size()
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	if m.TombstoneInfo != nil {
+		l = m.TombstoneInfo.Size()
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	if m.PowerInfo != nil {
+		l = m.PowerInfo.Size()
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InfractionHeight != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.InfractionHeight))
+	}
+	if m.Power != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.Power))
+	}
+	l = len(m.TotalSlashAmount)
+	if l > 0 {
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	l = len(m.SlashFraction)
+	if l > 0 {
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	if m.InfractionTime != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.InfractionTime))
+	}
+	l = len(m.DelegatedAmount)
+	if l > 0 {
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	l = len(m.SlashAmount)
+	if l > 0 {
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	if m.InfractionTimeKnown {
+		n += 2
+	}
+	return n
+}
+
+func (m *TombstoneInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	l = len(m.EvidenceType)
+	if l > 0 {
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.InfractionHeight))
+	}
+	if m.InfractionTime != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.InfractionTime))
+	}
+	if m.InfractionTimeKnown {
+		n += 2
+	}
+	return n
+}
+
+func (m *PowerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.Power))
+	}
+	if m.PreviousPower != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.PreviousPower))
+	}
+	l = len(m.Tokens)
+	if l > 0 {
+		n += 1 + l + sovMeshsecurity(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalContractsMaxCap.Size()
+	n += 1 + l + sovMeshsecurity(uint64(l))
+	if m.EpochLength != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.EpochLength))
+	}
+	if m.MaxGasEndBlocker != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.MaxGasEndBlocker))
+	}
+	if m.MaxGasSchedulerPerBlock != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.MaxGasSchedulerPerBlock))
+	}
+	if m.MaxRetryAttempts != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.MaxRetryAttempts))
+	}
+	if m.RetryBackoffBlocks != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.RetryBackoffBlocks))
+	}
+	if m.MaxCapGracePeriod != 0 {
+		n += 1 + sovMeshsecurity(uint64(m.MaxCapGracePeriod))
+	}
+	return n
+}
+
+func sovMeshsecurity(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMeshsecurity(x uint64) (n int) {
+	return sovMeshsecurity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VirtualStakingMaxCapInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeshsecurity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VirtualStakingMaxCapInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VirtualStakingMaxCapInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeshsecurity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeshsecurity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasEndBlocker", wireType)
+			}
+			m.MaxGasEndBlocker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasEndBlocker |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacySlashes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LegacySlashes = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyTombstones", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LegacyTombstones = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyValidators", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LegacyValidators = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableValsetSnapshots", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableValsetSnapshots = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeshsecurity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValsetOutboxEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeshsecurity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValsetOutboxEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValsetOutboxEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashInfo == nil {
+				m.SlashInfo = &SlashInfo{}
+			}
+			if err := m.SlashInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstoneInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TombstoneInfo == nil {
+				m.TombstoneInfo = &TombstoneInfo{}
+			}
+			if err := m.TombstoneInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PowerInfo == nil {
+				m.PowerInfo = &PowerInfo{}
+			}
+			if err := m.PowerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeshsecurity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeshsecurity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSlashAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionTime", wireType)
+			}
+			m.InfractionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionTimeKnown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InfractionTimeKnown = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeshsecurity(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Delegated.Size()
-	n += 1 + l + sovMeshsecurity(uint64(l))
-	l = m.Cap.Size()
-	n += 1 + l + sovMeshsecurity(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TotalContractsMaxCap.Size()
-	n += 1 + l + sovMeshsecurity(uint64(l))
-	if m.EpochLength != 0 {
-		n += 1 + sovMeshsecurity(uint64(m.EpochLength))
-	}
-	if m.MaxGasEndBlocker != 0 {
-		n += 1 + sovMeshsecurity(uint64(m.MaxGasEndBlocker))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMeshsecurity(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMeshsecurity(x uint64) (n int) {
-	return sovMeshsecurity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *VirtualStakingMaxCapInfo) Unmarshal(dAtA []byte) error {
+func (m *TombstoneInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -380,15 +2358,15 @@ func (m *VirtualStakingMaxCapInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VirtualStakingMaxCapInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TombstoneInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VirtualStakingMaxCapInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TombstoneInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -416,13 +2394,13 @@ func (m *VirtualStakingMaxCapInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeshsecurity
@@ -432,30 +2410,175 @@ func (m *VirtualStakingMaxCapInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMeshsecurity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMeshsecurity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EvidenceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionTime", wireType)
+			}
+			m.InfractionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionTimeKnown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InfractionTimeKnown = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMeshsecurity(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMeshsecurity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PowerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMeshsecurity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPower", wireType)
+			}
+			m.PreviousPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMeshsecurity
@@ -465,24 +2588,23 @@ func (m *VirtualStakingMaxCapInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMeshsecurity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMeshsecurity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tokens = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -605,6 +2727,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasSchedulerPerBlock", wireType)
+			}
+			m.MaxGasSchedulerPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasSchedulerPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryAttempts", wireType)
+			}
+			m.MaxRetryAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryBackoffBlocks", wireType)
+			}
+			m.RetryBackoffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryBackoffBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCapGracePeriod", wireType)
+			}
+			m.MaxCapGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeshsecurity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCapGracePeriod |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeshsecurity(dAtA[iNdEx:])
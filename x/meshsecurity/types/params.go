@@ -13,6 +13,11 @@ func DefaultParams(denom string) Params {
 		TotalContractsMaxCap: sdk.NewCoin(denom, math.NewInt(10_000_000_000)),
 		EpochLength:          1_000,
 		MaxGasEndBlocker:     500_000,
+		// budget for 20 tasks at max gas per block
+		MaxGasSchedulerPerBlock: 10_000_000,
+		MaxRetryAttempts:        5,
+		RetryBackoffBlocks:      10,
+		MaxCapGracePeriod:       100,
 	}
 }
 
@@ -27,5 +32,11 @@ func (p Params) ValidateBasic() error {
 	if p.MaxGasEndBlocker == 0 {
 		return ErrInvalid.Wrap("empty max gas end-blocker setting")
 	}
+	if p.MaxGasSchedulerPerBlock != 0 && p.MaxGasSchedulerPerBlock < p.MaxGasEndBlocker {
+		return ErrInvalid.Wrap("max gas scheduler per block must not be lower than max gas end-blocker setting")
+	}
+	if p.MaxRetryAttempts != 0 && p.RetryBackoffBlocks == 0 {
+		return ErrInvalid.Wrap("empty retry backoff blocks setting")
+	}
 	return nil
 }
@@ -6,8 +6,12 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -76,6 +80,8 @@ var xxx_messageInfo_QueryVirtualStakingMaxCapLimitRequest proto.InternalMessageI
 type QueryVirtualStakingMaxCapLimitResponse struct {
 	Delegated types.Coin `protobuf:"bytes,1,opt,name=delegated,proto3" json:"delegated"`
 	Cap       types.Coin `protobuf:"bytes,2,opt,name=cap,proto3" json:"cap"`
+	// Config contains the contract specific overrides of the module params
+	Config ContractConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
 }
 
 func (m *QueryVirtualStakingMaxCapLimitResponse) Reset() {
@@ -116,6 +122,12 @@ var xxx_messageInfo_QueryVirtualStakingMaxCapLimitResponse proto.InternalMessage
 // QueryVirtualStakingMaxCapLimitsRequest is the request type for the
 // Query/VirtualStakingMaxCapLimits RPC method
 type QueryVirtualStakingMaxCapLimitsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// only_non_zero_cap filters out contracts with a zero max cap
+	OnlyNonZeroCap bool `protobuf:"varint,2,opt,name=only_non_zero_cap,json=onlyNonZeroCap,proto3" json:"only_non_zero_cap,omitempty"`
+	// only_with_delegations filters out contracts without delegated amount
+	OnlyWithDelegations bool `protobuf:"varint,3,opt,name=only_with_delegations,json=onlyWithDelegations,proto3" json:"only_with_delegations,omitempty"`
 }
 
 func (m *QueryVirtualStakingMaxCapLimitsRequest) Reset() {
@@ -157,6 +169,8 @@ var xxx_messageInfo_QueryVirtualStakingMaxCapLimitsRequest proto.InternalMessage
 // Query/VirtualStakingMaxCapLimits RPC method
 type QueryVirtualStakingMaxCapLimitsResponse struct {
 	MaxCapInfos []VirtualStakingMaxCapInfo `protobuf:"bytes,1,rep,name=max_cap_infos,json=maxCapInfos,proto3" json:"max_cap_infos"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryVirtualStakingMaxCapLimitsResponse) Reset() {
@@ -194,6 +208,392 @@ func (m *QueryVirtualStakingMaxCapLimitsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryVirtualStakingMaxCapLimitsResponse proto.InternalMessageInfo
 
+// QueryVirtualStakingDelegationsRequest is the request type for the
+// Query/VirtualStakingDelegations RPC method
+type QueryVirtualStakingDelegationsRequest struct {
+	// Address is the address of the contract to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryVirtualStakingDelegationsRequest) Reset()         { *m = QueryVirtualStakingDelegationsRequest{} }
+func (m *QueryVirtualStakingDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVirtualStakingDelegationsRequest) ProtoMessage()    {}
+func (*QueryVirtualStakingDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c89ba006eed4fb, []int{4}
+}
+func (m *QueryVirtualStakingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVirtualStakingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVirtualStakingDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVirtualStakingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVirtualStakingDelegationsRequest.Merge(m, src)
+}
+func (m *QueryVirtualStakingDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVirtualStakingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVirtualStakingDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVirtualStakingDelegationsRequest proto.InternalMessageInfo
+
+// QueryVirtualStakingDelegationsResponse is the response type for the
+// Query/VirtualStakingDelegations RPC method
+type QueryVirtualStakingDelegationsResponse struct {
+	Delegations []VirtualStakingDelegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+}
+
+func (m *QueryVirtualStakingDelegationsResponse) Reset() {
+	*m = QueryVirtualStakingDelegationsResponse{}
+}
+func (m *QueryVirtualStakingDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVirtualStakingDelegationsResponse) ProtoMessage()    {}
+func (*QueryVirtualStakingDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c89ba006eed4fb, []int{5}
+}
+func (m *QueryVirtualStakingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVirtualStakingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVirtualStakingDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVirtualStakingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVirtualStakingDelegationsResponse.Merge(m, src)
+}
+func (m *QueryVirtualStakingDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVirtualStakingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVirtualStakingDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVirtualStakingDelegationsResponse proto.InternalMessageInfo
+
+// VirtualStakingDelegation is a delegation of a virtual staking contract to a
+// validator
+type VirtualStakingDelegation struct {
+	// Validator is the operator address of the validator
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Shares are the delegation shares
+	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// Amount is the token value of the shares
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// Status is the bond status of the validator
+	Status types1.BondStatus `protobuf:"varint,4,opt,name=status,proto3,enum=cosmos.staking.v1beta1.BondStatus" json:"status,omitempty"`
+}
+
+func (m *VirtualStakingDelegation) Reset()         { *m = VirtualStakingDelegation{} }
+func (m *VirtualStakingDelegation) String() string { return proto.CompactTextString(m) }
+func (*VirtualStakingDelegation) ProtoMessage()    {}
+func (*VirtualStakingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c89ba006eed4fb, []int{6}
+}
+func (m *VirtualStakingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VirtualStakingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VirtualStakingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VirtualStakingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VirtualStakingDelegation.Merge(m, src)
+}
+func (m *VirtualStakingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VirtualStakingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VirtualStakingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VirtualStakingDelegation proto.InternalMessageInfo
+
+// QueryScheduledTasksRequest is the request type for the
+// Query/ScheduledTasks RPC method
+type QueryScheduledTasksRequest struct {
+	// Type is the optional scheduler task type filter. All types when not set.
+	Type uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Contract is the optional contract address filter
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// MinHeight is the optional minimum execution height (inclusive)
+	MinHeight uint64 `protobuf:"varint,3,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// MaxHeight is the optional maximum execution height (inclusive). Unbounded
+	// when not set.
+	MaxHeight uint64 `protobuf:"varint,4,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledTasksRequest) Reset()         { *m = QueryScheduledTasksRequest{} }
+func (m *QueryScheduledTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledTasksRequest) ProtoMessage()    {}
+func (*QueryScheduledTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c89ba006eed4fb, []int{7}
+}
+func (m *QueryScheduledTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledTasksRequest.Merge(m, src)
+}
+func (m *QueryScheduledTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledTasksRequest proto.InternalMessageInfo
+
+// QueryScheduledTasksResponse is the response type for the
+// Query/ScheduledTasks RPC method
+type QueryScheduledTasksResponse struct {
+	// Tasks are the scheduled tasks. The height is the next run height.
+	Tasks []ScheduledTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledTasksResponse) Reset()         { *m = QueryScheduledTasksResponse{} }
+func (m *QueryScheduledTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledTasksResponse) ProtoMessage()    {}
+func (*QueryScheduledTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c89ba006eed4fb, []int{8}
+}
+func (m *QueryScheduledTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledTasksResponse.Merge(m, src)
+}
+func (m *QueryScheduledTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledTasksResponse proto.InternalMessageInfo
+
+// QueryDeadLetterTasksRequest is the request type for the
+// Query/DeadLetterTasks RPC method
+type QueryDeadLetterTasksRequest struct {
+	// Contract is the optional contract address filter
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeadLetterTasksRequest) Reset()         { *m = QueryDeadLetterTasksRequest{} }
+func (m *QueryDeadLetterTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeadLetterTasksRequest) ProtoMessage()    {}
+func (*QueryDeadLetterTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c89ba006eed4fb, []int{9}
+}
+func (m *QueryDeadLetterTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeadLetterTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeadLetterTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeadLetterTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeadLetterTasksRequest.Merge(m, src)
+}
+func (m *QueryDeadLetterTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeadLetterTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeadLetterTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeadLetterTasksRequest proto.InternalMessageInfo
+
+// QueryDeadLetterTasksResponse is the response type for the
+// Query/DeadLetterTasks RPC method
+type QueryDeadLetterTasksResponse struct {
+	// Tasks are the failed tasks with the payload and last error
+	Tasks []FailedTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeadLetterTasksResponse) Reset()         { *m = QueryDeadLetterTasksResponse{} }
+func (m *QueryDeadLetterTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeadLetterTasksResponse) ProtoMessage()    {}
+func (*QueryDeadLetterTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c89ba006eed4fb, []int{10}
+}
+func (m *QueryDeadLetterTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeadLetterTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeadLetterTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeadLetterTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeadLetterTasksResponse.Merge(m, src)
+}
+func (m *QueryDeadLetterTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeadLetterTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeadLetterTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeadLetterTasksResponse proto.InternalMessageInfo
+
+// QueryValsetOutboxRequest is the request type for the
+// Query/ValsetOutbox RPC method
+type QueryValsetOutboxRequest struct {
+	// Address is the address of the contract to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValsetOutboxRequest) Reset()         { *m = QueryValsetOutboxRequest{} }
+func (m *QueryValsetOutboxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetOutboxRequest) ProtoMessage()    {}
+func (*QueryValsetOutboxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c89ba006eed4fb, []int{11}
+}
+func (m *QueryValsetOutboxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValsetOutboxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetOutboxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValsetOutboxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetOutboxRequest.Merge(m, src)
+}
+func (m *QueryValsetOutboxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValsetOutboxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetOutboxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValsetOutboxRequest proto.InternalMessageInfo
+
+// QueryValsetOutboxResponse is the response type for the
+// Query/ValsetOutbox RPC method
+type QueryValsetOutboxResponse struct {
+	// Entries are the pending entries ordered by sequence
+	Entries []ValsetOutboxEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValsetOutboxResponse) Reset()         { *m = QueryValsetOutboxResponse{} }
+func (m *QueryValsetOutboxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetOutboxResponse) ProtoMessage()    {}
+func (*QueryValsetOutboxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50c89ba006eed4fb, []int{12}
+}
+func (m *QueryValsetOutboxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValsetOutboxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetOutboxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValsetOutboxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetOutboxResponse.Merge(m, src)
+}
+func (m *QueryValsetOutboxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValsetOutboxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetOutboxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValsetOutboxResponse proto.InternalMessageInfo
+
 // QueryParamsRequest is the request type for the
 // Query/Params RPC method
 type QueryParamsRequest struct {
@@ -203,7 +603,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c89ba006eed4fb, []int{4}
+	return fileDescriptor_50c89ba006eed4fb, []int{13}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,7 +642,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50c89ba006eed4fb, []int{5}
+	return fileDescriptor_50c89ba006eed4fb, []int{14}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,6 +676,15 @@ func init() {
 	proto.RegisterType((*QueryVirtualStakingMaxCapLimitResponse)(nil), "osmosis.meshsecurity.v1beta1.QueryVirtualStakingMaxCapLimitResponse")
 	proto.RegisterType((*QueryVirtualStakingMaxCapLimitsRequest)(nil), "osmosis.meshsecurity.v1beta1.QueryVirtualStakingMaxCapLimitsRequest")
 	proto.RegisterType((*QueryVirtualStakingMaxCapLimitsResponse)(nil), "osmosis.meshsecurity.v1beta1.QueryVirtualStakingMaxCapLimitsResponse")
+	proto.RegisterType((*QueryVirtualStakingDelegationsRequest)(nil), "osmosis.meshsecurity.v1beta1.QueryVirtualStakingDelegationsRequest")
+	proto.RegisterType((*QueryVirtualStakingDelegationsResponse)(nil), "osmosis.meshsecurity.v1beta1.QueryVirtualStakingDelegationsResponse")
+	proto.RegisterType((*VirtualStakingDelegation)(nil), "osmosis.meshsecurity.v1beta1.VirtualStakingDelegation")
+	proto.RegisterType((*QueryScheduledTasksRequest)(nil), "osmosis.meshsecurity.v1beta1.QueryScheduledTasksRequest")
+	proto.RegisterType((*QueryScheduledTasksResponse)(nil), "osmosis.meshsecurity.v1beta1.QueryScheduledTasksResponse")
+	proto.RegisterType((*QueryDeadLetterTasksRequest)(nil), "osmosis.meshsecurity.v1beta1.QueryDeadLetterTasksRequest")
+	proto.RegisterType((*QueryDeadLetterTasksResponse)(nil), "osmosis.meshsecurity.v1beta1.QueryDeadLetterTasksResponse")
+	proto.RegisterType((*QueryValsetOutboxRequest)(nil), "osmosis.meshsecurity.v1beta1.QueryValsetOutboxRequest")
+	proto.RegisterType((*QueryValsetOutboxResponse)(nil), "osmosis.meshsecurity.v1beta1.QueryValsetOutboxResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.meshsecurity.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.meshsecurity.v1beta1.QueryParamsResponse")
 }
@@ -285,43 +694,85 @@ func init() {
 }
 
 var fileDescriptor_50c89ba006eed4fb = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0x69, 0x63, 0x24, 0x13, 0x3c, 0x38, 0xf6, 0x90, 0x2e, 0x65, 0x2b, 0x4b, 0xad, 0x41,
-	0x9a, 0x1d, 0x13, 0x7f, 0x81, 0x50, 0xc1, 0x44, 0x11, 0x41, 0x41, 0x23, 0x78, 0xf0, 0x60, 0x9d,
-	0x6c, 0xa6, 0xdb, 0xa1, 0xd9, 0x99, 0xed, 0xce, 0x44, 0x12, 0xc4, 0x8b, 0x7f, 0x41, 0xc1, 0xa3,
-	0x97, 0x1e, 0x7b, 0xf4, 0xcf, 0xc8, 0xb1, 0xe0, 0xc5, 0x93, 0x3f, 0x12, 0x45, 0x6f, 0xfe, 0x0b,
-	0xb2, 0xb3, 0x93, 0xd8, 0x80, 0x4d, 0x52, 0x72, 0x59, 0x66, 0xdf, 0xbc, 0xef, 0x7b, 0xdf, 0xf7,
-	0xde, 0xdb, 0x85, 0x45, 0x21, 0x43, 0x21, 0x99, 0xc4, 0x21, 0x95, 0x3b, 0x92, 0xfa, 0xed, 0x98,
-	0xa9, 0x2e, 0x7e, 0x5d, 0x6e, 0x50, 0x45, 0xca, 0x78, 0xaf, 0x4d, 0xe3, 0xae, 0x17, 0xc5, 0x42,
-	0x09, 0xb4, 0x62, 0x32, 0xbd, 0xe3, 0x99, 0x9e, 0xc9, 0xb4, 0x1d, 0x5f, 0x5f, 0xe3, 0x06, 0x91,
-	0x74, 0x04, 0xf7, 0x05, 0xe3, 0x29, 0xda, 0xc6, 0x13, 0xeb, 0x8c, 0x51, 0xa6, 0x80, 0xa5, 0x40,
-	0x04, 0x42, 0x1f, 0x71, 0x72, 0x32, 0xd1, 0x95, 0x40, 0x88, 0xa0, 0x45, 0x31, 0x89, 0x18, 0x26,
-	0x9c, 0x0b, 0x45, 0x14, 0x13, 0x5c, 0x9a, 0xdb, 0xf3, 0x24, 0x64, 0x5c, 0x60, 0xfd, 0x4c, 0x43,
-	0xee, 0x5d, 0x78, 0xe9, 0x69, 0x62, 0xe2, 0x39, 0x8b, 0x55, 0x9b, 0xb4, 0x9e, 0x29, 0xb2, 0xcb,
-	0x78, 0xf0, 0x98, 0x74, 0x6a, 0x24, 0x7a, 0xc4, 0x42, 0xa6, 0xea, 0x74, 0xaf, 0x4d, 0xa5, 0x42,
-	0x05, 0x78, 0x96, 0x34, 0x9b, 0x31, 0x95, 0xb2, 0x00, 0x2e, 0x82, 0x62, 0xae, 0x3e, 0x7c, 0x75,
-	0x0f, 0x00, 0x5c, 0x9f, 0xc6, 0x21, 0x23, 0xc1, 0x25, 0x45, 0x9b, 0x30, 0xd7, 0xa4, 0x2d, 0x1a,
-	0x10, 0x45, 0x9b, 0x9a, 0x26, 0x5f, 0x59, 0xf6, 0xd2, 0xce, 0x78, 0x49, 0x67, 0x86, 0xed, 0xf2,
-	0x6a, 0x82, 0xf1, 0x6a, 0xa6, 0xf7, 0x65, 0xd5, 0xaa, 0xff, 0x43, 0xa0, 0x32, 0x5c, 0xf4, 0x49,
-	0x54, 0x58, 0x98, 0x0d, 0x98, 0xe4, 0xde, 0xce, 0xfc, 0x3e, 0x58, 0x05, 0x6e, 0x71, 0x9a, 0x42,
-	0x69, 0x6c, 0xba, 0xfb, 0x00, 0x5e, 0x9e, 0x9a, 0x6a, 0xdc, 0x50, 0x78, 0x2e, 0x24, 0x9d, 0x2d,
-	0x9f, 0x44, 0x5b, 0x8c, 0x6f, 0x8b, 0xa4, 0x31, 0x8b, 0xc5, 0x7c, 0xe5, 0xa6, 0x37, 0x69, 0x13,
-	0xbc, 0xff, 0x11, 0x3f, 0xe4, 0xdb, 0xa2, 0x9a, 0x4b, 0x54, 0x1f, 0xfe, 0xfa, 0x78, 0x05, 0xd4,
-	0xf3, 0xe1, 0x28, 0x2c, 0xdd, 0x25, 0x88, 0xb4, 0xa2, 0x27, 0x24, 0x26, 0xe1, 0x48, 0xe8, 0x4b,
-	0x78, 0x61, 0x2c, 0x6a, 0x34, 0x3d, 0x80, 0xd9, 0x48, 0x47, 0x4c, 0x7b, 0xd7, 0x26, 0x8b, 0x49,
-	0xd1, 0xc7, 0x4b, 0x1b, 0x78, 0xe5, 0x4f, 0x06, 0x9e, 0xd1, 0x05, 0xd0, 0x4f, 0x00, 0x97, 0x4f,
-	0xec, 0x06, 0xaa, 0x4d, 0x2e, 0x30, 0xd3, 0x72, 0xd9, 0xf7, 0xe6, 0x23, 0x49, 0xbd, 0xbb, 0x9b,
-	0xef, 0x3e, 0xfd, 0x78, 0xbf, 0x70, 0x0b, 0xdd, 0x98, 0xf2, 0x31, 0x99, 0x99, 0xb5, 0x12, 0x30,
-	0x7e, 0x63, 0xd6, 0xf8, 0x2d, 0xfa, 0x0a, 0xa0, 0x7d, 0xf2, 0xd4, 0xd1, 0x5c, 0x1a, 0x87, 0x63,
-	0xb3, 0xef, 0xcf, 0xc9, 0x62, 0xac, 0x5e, 0xd7, 0x56, 0x3d, 0xb4, 0x71, 0x0a, 0xab, 0x12, 0x7d,
-	0x00, 0x30, 0x9b, 0x4e, 0x1c, 0x5d, 0x9d, 0x41, 0xc7, 0xd8, 0xc2, 0xd9, 0xe5, 0x53, 0x20, 0x8c,
-	0xca, 0x0d, 0xad, 0x72, 0x1d, 0xad, 0x4d, 0x56, 0x99, 0x6e, 0x5c, 0xf5, 0x55, 0xef, 0xbb, 0x63,
-	0x1d, 0xf6, 0x1d, 0xab, 0xd7, 0x77, 0xc0, 0x51, 0xdf, 0x01, 0xdf, 0xfa, 0x0e, 0xd8, 0x1f, 0x38,
-	0xd6, 0xd1, 0xc0, 0xb1, 0x3e, 0x0f, 0x1c, 0xeb, 0xc5, 0x9d, 0x80, 0xa9, 0x9d, 0x76, 0xc3, 0xf3,
-	0x45, 0x38, 0x64, 0x2c, 0xb5, 0x48, 0x23, 0xa5, 0x2d, 0x0d, 0x79, 0x4b, 0xb2, 0xb9, 0x8b, 0x3b,
-	0xe3, 0xa5, 0x54, 0x37, 0xa2, 0xb2, 0x91, 0xd5, 0xff, 0xbc, 0x6b, 0x7f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x8d, 0xb8, 0x43, 0x96, 0xd5, 0x05, 0x00, 0x00,
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf6, 0x26, 0xae, 0x1b, 0x4f, 0x7e, 0xcd, 0x4f, 0x9d, 0x06, 0xc9, 0x31, 0xc1, 0xa9, 0xac,
+	0x90, 0x86, 0x12, 0x7b, 0x89, 0xdb, 0x24, 0x10, 0x28, 0x52, 0x6d, 0x37, 0xa5, 0x28, 0x40, 0xd9,
+	0x44, 0x20, 0xf5, 0x80, 0x19, 0xef, 0x4e, 0xd6, 0xa3, 0xd8, 0x33, 0xee, 0xce, 0x38, 0x38, 0x20,
+	0x2e, 0xdc, 0x91, 0x90, 0x90, 0x90, 0x50, 0x85, 0xc4, 0xb1, 0x47, 0x84, 0x72, 0x42, 0x08, 0x89,
+	0x5b, 0x6e, 0x54, 0xe5, 0x02, 0x1c, 0x0a, 0x24, 0x20, 0x38, 0xf0, 0x47, 0xa0, 0x9d, 0x9d, 0x75,
+	0x76, 0x1b, 0x67, 0xed, 0x38, 0x11, 0x97, 0x64, 0x77, 0xde, 0xfb, 0xde, 0xbc, 0xef, 0x7b, 0x6f,
+	0xdf, 0x8c, 0xc1, 0x2c, 0xe3, 0x0d, 0xc6, 0x09, 0xd7, 0x1b, 0x98, 0xd7, 0x38, 0x36, 0x5b, 0x0e,
+	0x11, 0xdb, 0xfa, 0xd6, 0x7c, 0x15, 0x0b, 0x34, 0xaf, 0xdf, 0x6d, 0x61, 0x67, 0x3b, 0xdf, 0x74,
+	0x98, 0x60, 0x70, 0x52, 0x79, 0xe6, 0x83, 0x9e, 0x79, 0xe5, 0x99, 0xce, 0x98, 0xd2, 0xac, 0x57,
+	0x11, 0xc7, 0x1d, 0xb8, 0xc9, 0x08, 0xf5, 0xd0, 0xe9, 0xcb, 0x41, 0xbb, 0x0c, 0xdb, 0xf1, 0x6a,
+	0x22, 0x9b, 0x50, 0x24, 0x08, 0xf3, 0x7d, 0xf5, 0xc8, 0x9c, 0x42, 0xdb, 0x7b, 0x80, 0x71, 0x9b,
+	0xd9, 0x4c, 0x3e, 0xea, 0xee, 0x93, 0x5a, 0x9d, 0xb4, 0x19, 0xb3, 0xeb, 0x58, 0x47, 0x4d, 0xa2,
+	0x23, 0x4a, 0x99, 0x90, 0x7b, 0x70, 0x65, 0x3d, 0x8f, 0x1a, 0x84, 0x32, 0x5d, 0xfe, 0x55, 0x4b,
+	0x13, 0x5e, 0x8e, 0x15, 0x2f, 0x92, 0xf7, 0xa2, 0x4c, 0xd3, 0x2a, 0x7d, 0x2e, 0xd0, 0x26, 0xa1,
+	0x76, 0x27, 0x19, 0xf5, 0xae, 0xbc, 0xe6, 0x22, 0x13, 0xe7, 0x66, 0x0d, 0x5b, 0xad, 0x3a, 0x76,
+	0x3c, 0xef, 0xec, 0x75, 0xf0, 0xf4, 0x9b, 0xae, 0x10, 0x6f, 0x11, 0x47, 0xb4, 0x50, 0x7d, 0xcd,
+	0x0b, 0xf5, 0x1a, 0x6a, 0x97, 0x50, 0x73, 0x95, 0x34, 0x88, 0x30, 0xf0, 0xdd, 0x16, 0xe6, 0x02,
+	0xa6, 0xc0, 0x59, 0x64, 0x59, 0x0e, 0xe6, 0x3c, 0xa5, 0x5d, 0xd4, 0x66, 0x93, 0x86, 0xff, 0x9a,
+	0xfd, 0x47, 0x03, 0x33, 0xbd, 0x62, 0xf0, 0x26, 0xa3, 0x1c, 0xc3, 0x6b, 0x20, 0x69, 0xe1, 0x3a,
+	0xb6, 0x91, 0xc0, 0x96, 0x0c, 0x33, 0x5a, 0x98, 0xc8, 0x2b, 0x8e, 0x6e, 0x51, 0xfc, 0x4a, 0xe6,
+	0x4b, 0x8c, 0xd0, 0x62, 0x7c, 0xf7, 0xd1, 0x54, 0xcc, 0x38, 0x40, 0xc0, 0x79, 0x30, 0x6c, 0xa2,
+	0x66, 0x6a, 0xa8, 0x3f, 0xa0, 0xeb, 0x0b, 0x5f, 0x05, 0x09, 0x93, 0xd1, 0x0d, 0x62, 0xa7, 0x86,
+	0x25, 0x6a, 0x2e, 0x1f, 0xd5, 0x41, 0xf9, 0x12, 0xa3, 0xc2, 0x41, 0xa6, 0x28, 0x49, 0x8c, 0x0a,
+	0xa4, 0x22, 0x2c, 0xc7, 0xff, 0xfe, 0x72, 0x4a, 0xcb, 0xfe, 0xd0, 0x93, 0x2e, 0xf7, 0x35, 0x5b,
+	0x01, 0xe0, 0xa0, 0xaf, 0x14, 0xdf, 0x99, 0x50, 0xda, 0x5e, 0x6f, 0xfb, 0xbb, 0xdf, 0x46, 0x36,
+	0x56, 0x58, 0x23, 0x80, 0x84, 0xcf, 0x80, 0xf3, 0x8c, 0xd6, 0xb7, 0x2b, 0x94, 0xd1, 0xca, 0xfb,
+	0xd8, 0x61, 0x15, 0x5f, 0x85, 0x11, 0x63, 0xcc, 0x35, 0xbc, 0xce, 0xe8, 0x1d, 0xec, 0xb0, 0x12,
+	0x6a, 0xc2, 0x02, 0x78, 0x42, 0xba, 0xbe, 0x47, 0x44, 0xad, 0xa2, 0x94, 0x73, 0x1b, 0x4e, 0xd2,
+	0x1f, 0x31, 0x2e, 0xb8, 0xc6, 0xb7, 0x89, 0xa8, 0x95, 0x0f, 0x4c, 0xd9, 0x9f, 0x35, 0x70, 0xa9,
+	0x27, 0x23, 0x55, 0x41, 0x0c, 0xce, 0x35, 0x50, 0xdb, 0x4d, 0xa0, 0x42, 0xe8, 0x06, 0x73, 0x9b,
+	0x61, 0x78, 0x76, 0xb4, 0xb0, 0x18, 0x2d, 0x6b, 0xb7, 0xc0, 0xb7, 0xe8, 0x06, 0x2b, 0x26, 0x5d,
+	0x81, 0xef, 0xff, 0xf5, 0xd5, 0x65, 0xcd, 0x18, 0x6d, 0x74, 0x96, 0x39, 0xbc, 0x19, 0x52, 0xce,
+	0x2b, 0xf8, 0xa5, 0x9e, 0xca, 0x79, 0x39, 0x06, 0xa5, 0x3b, 0xa2, 0xbf, 0x03, 0xec, 0x7b, 0xf7,
+	0xf7, 0xc7, 0xdd, 0x0b, 0x1e, 0x8a, 0xa1, 0xd4, 0x31, 0xc1, 0x68, 0x50, 0xf3, 0x01, 0xb4, 0x39,
+	0x88, 0x1a, 0xd2, 0x26, 0x10, 0x35, 0xfb, 0xc5, 0x10, 0x48, 0x1d, 0x05, 0x82, 0x8b, 0x20, 0xb9,
+	0x85, 0xea, 0xc4, 0x42, 0x82, 0x39, 0x1e, 0x91, 0x62, 0xea, 0xe1, 0x4e, 0x6e, 0x5c, 0x49, 0x77,
+	0xdd, 0xe3, 0xb4, 0x26, 0x1c, 0x42, 0x6d, 0xe3, 0xc0, 0x15, 0xae, 0x83, 0x04, 0xaf, 0x21, 0x07,
+	0x73, 0x29, 0x76, 0xb2, 0xf8, 0x92, 0xbb, 0xf9, 0x2f, 0x8f, 0xa6, 0x66, 0x6c, 0x22, 0x6a, 0xad,
+	0x6a, 0xde, 0x64, 0x0d, 0x35, 0x8c, 0xd4, 0xbf, 0x1c, 0xb7, 0x36, 0x75, 0xb1, 0xdd, 0xc4, 0x3c,
+	0x5f, 0xc6, 0xe6, 0xc3, 0x9d, 0x1c, 0x50, 0x5b, 0x94, 0xb1, 0x69, 0xa8, 0x58, 0x70, 0x09, 0x24,
+	0x50, 0x83, 0xb5, 0xa8, 0x48, 0x0d, 0xf7, 0xf7, 0xcd, 0x2a, 0x77, 0xb8, 0x0c, 0x12, 0x5c, 0x20,
+	0xd1, 0xe2, 0xa9, 0xf8, 0x45, 0x6d, 0x76, 0xac, 0x90, 0xf5, 0x81, 0xfe, 0xac, 0xf3, 0xb1, 0x45,
+	0x46, 0xad, 0x35, 0xe9, 0x69, 0x28, 0x84, 0x3b, 0x8f, 0xd2, 0xb2, 0x5e, 0x6b, 0x6a, 0xd6, 0x59,
+	0xeb, 0x88, 0x6f, 0x76, 0x0a, 0x0d, 0x41, 0xdc, 0x4d, 0x5b, 0x8a, 0x73, 0xce, 0x90, 0xcf, 0xf0,
+	0x2a, 0x18, 0x31, 0xd5, 0x97, 0x9f, 0x1a, 0xea, 0x21, 0x5a, 0xc7, 0x13, 0x3e, 0x05, 0x40, 0x83,
+	0xd0, 0x4a, 0x0d, 0x13, 0xbb, 0xe6, 0x31, 0x8c, 0x1b, 0xc9, 0x06, 0xa1, 0xaf, 0xc8, 0x05, 0x69,
+	0x46, 0x6d, 0xdf, 0x1c, 0x57, 0x66, 0xd4, 0x56, 0xe6, 0xf0, 0x70, 0x38, 0x33, 0xe8, 0x70, 0xc8,
+	0xee, 0x68, 0xe0, 0xc9, 0xae, 0x74, 0x55, 0x4f, 0xae, 0x82, 0x33, 0xc2, 0x5d, 0x50, 0xdd, 0xf8,
+	0x6c, 0x74, 0x37, 0x86, 0x82, 0x04, 0x5b, 0xd0, 0x0b, 0x72, 0x7a, 0x1f, 0xe6, 0x3d, 0x3f, 0xed,
+	0x32, 0x46, 0xd6, 0x2a, 0x16, 0x02, 0x3b, 0xa1, 0x32, 0x05, 0x4b, 0xa2, 0xf5, 0x5d, 0x92, 0x95,
+	0x2e, 0xe9, 0x0d, 0x22, 0xea, 0xd7, 0x1a, 0x98, 0xec, 0x9e, 0x9d, 0x52, 0xf5, 0x56, 0x58, 0xd5,
+	0xd9, 0x68, 0x55, 0x57, 0x10, 0xf9, 0x0f, 0x24, 0xfd, 0x4c, 0x03, 0x29, 0x6f, 0x50, 0xa1, 0x3a,
+	0xc7, 0xe2, 0x8d, 0x96, 0xa8, 0xb2, 0xb6, 0xaf, 0x67, 0xe1, 0xb1, 0xf9, 0x16, 0x21, 0xa7, 0xef,
+	0x78, 0x6a, 0x6a, 0x7e, 0xa3, 0x81, 0x89, 0x2e, 0x89, 0x29, 0x29, 0xd7, 0xc1, 0x59, 0x4c, 0x85,
+	0x43, 0xb0, 0x2f, 0xa6, 0xde, 0x63, 0x60, 0x06, 0x82, 0xdc, 0xa0, 0xc2, 0xd9, 0x0e, 0x6a, 0xea,
+	0x87, 0x3a, 0x3d, 0x55, 0xc7, 0x01, 0x94, 0xb9, 0xdf, 0x46, 0x0e, 0x6a, 0xf8, 0xed, 0x99, 0x7d,
+	0x07, 0x5c, 0x08, 0xad, 0x2a, 0x2e, 0x37, 0x41, 0xa2, 0x29, 0x57, 0xd4, 0x69, 0x3f, 0x1d, 0x4d,
+	0xc5, 0x43, 0x07, 0xf3, 0x57, 0xf0, 0xc2, 0xe7, 0xa3, 0xe0, 0x8c, 0xdc, 0x00, 0xfe, 0xa9, 0x81,
+	0x89, 0x23, 0x0f, 0x66, 0x58, 0x8a, 0xde, 0xa0, 0xaf, 0xbb, 0x5d, 0xba, 0x7c, 0xb2, 0x20, 0x1e,
+	0xf7, 0xec, 0xb5, 0x8f, 0x7e, 0xfc, 0xe3, 0xd3, 0xa1, 0x25, 0xb8, 0xd0, 0xe3, 0xea, 0xac, 0xae,
+	0x0f, 0x75, 0x17, 0xac, 0x7f, 0xa0, 0x7a, 0xed, 0x43, 0xf8, 0xab, 0x06, 0xd2, 0x47, 0x6e, 0xc2,
+	0xe1, 0x89, 0x72, 0xf4, 0xcb, 0x96, 0xbe, 0x71, 0xc2, 0x28, 0x8a, 0xea, 0x55, 0x49, 0x35, 0x0f,
+	0xe7, 0x8e, 0x41, 0x95, 0xc3, 0xfd, 0x43, 0x95, 0x0c, 0xdc, 0x21, 0x06, 0xa8, 0xe4, 0xe1, 0x5b,
+	0x4c, 0xba, 0x7c, 0xb2, 0x20, 0x8a, 0xde, 0x8b, 0x92, 0xde, 0x02, 0xbc, 0x12, 0x4d, 0x2f, 0x70,
+	0x29, 0x09, 0xd4, 0xf1, 0x5b, 0x0d, 0x8c, 0x85, 0x8f, 0x22, 0xf8, 0x7c, 0x1f, 0x59, 0x75, 0x3d,
+	0xac, 0xd3, 0x2f, 0x0c, 0x80, 0x54, 0x24, 0x16, 0x24, 0x09, 0x1d, 0xe6, 0xf4, 0xbe, 0x7e, 0x10,
+	0x59, 0x15, 0x6f, 0x1a, 0x7f, 0xaf, 0x81, 0xff, 0x3f, 0x36, 0xf4, 0x61, 0x3f, 0x59, 0x74, 0x3f,
+	0xc6, 0xd2, 0xcb, 0x83, 0x40, 0x15, 0x83, 0x25, 0xc9, 0x60, 0x1e, 0xea, 0xbd, 0xca, 0x80, 0xac,
+	0x4a, 0x5d, 0xe2, 0x15, 0x87, 0xef, 0x34, 0xf0, 0xbf, 0xe0, 0x94, 0x84, 0x8b, 0xfd, 0xb4, 0xc5,
+	0xe1, 0x43, 0x23, 0xbd, 0x74, 0x6c, 0xdc, 0xf1, 0x66, 0xc1, 0x96, 0xc4, 0x56, 0x98, 0x04, 0x07,
+	0x7a, 0xe8, 0x9e, 0x06, 0x12, 0xde, 0x6c, 0x84, 0xcf, 0xf5, 0x91, 0x42, 0x68, 0x34, 0xa7, 0xe7,
+	0x8f, 0x81, 0x50, 0xe9, 0xce, 0xc9, 0x74, 0x67, 0xe0, 0x74, 0x74, 0xba, 0xde, 0x6c, 0x2e, 0xbe,
+	0xbb, 0xfb, 0x7b, 0x26, 0x76, 0x7f, 0x2f, 0x13, 0xdb, 0xdd, 0xcb, 0x68, 0x0f, 0xf6, 0x32, 0xda,
+	0x6f, 0x7b, 0x19, 0xed, 0x93, 0xfd, 0x4c, 0xec, 0xc1, 0x7e, 0x26, 0xf6, 0xd3, 0x7e, 0x26, 0x76,
+	0xe7, 0xe5, 0xc0, 0xad, 0x59, 0x45, 0xcc, 0xd5, 0x51, 0xd5, 0x0b, 0x9b, 0xf3, 0xe3, 0xca, 0x2b,
+	0x74, 0x3b, 0xbc, 0x95, 0xbc, 0x51, 0x57, 0x13, 0xf2, 0xc7, 0xf9, 0x95, 0x7f, 0x07, 0x00, 0x4e,
+	0x0c, 0x45, 0x5b, 0x19, 0x11, 0x00, 0x00,
 }
 
 func (this *QueryVirtualStakingMaxCapLimitResponse) Equal(that interface{}) bool {
@@ -349,6 +800,9 @@ func (this *QueryVirtualStakingMaxCapLimitResponse) Equal(that interface{}) bool
 	if !this.Cap.Equal(&that1.Cap) {
 		return false
 	}
+	if !this.Config.Equal(&that1.Config) {
+		return false
+	}
 	return true
 }
 
@@ -368,6 +822,18 @@ type QueryClient interface {
 	VirtualStakingMaxCapLimit(ctx context.Context, in *QueryVirtualStakingMaxCapLimitRequest, opts ...grpc.CallOption) (*QueryVirtualStakingMaxCapLimitResponse, error)
 	// VirtualStakingMaxCapLimits gets max cap limits
 	VirtualStakingMaxCapLimits(ctx context.Context, in *QueryVirtualStakingMaxCapLimitsRequest, opts ...grpc.CallOption) (*QueryVirtualStakingMaxCapLimitsResponse, error)
+	// VirtualStakingDelegations gets the delegations per validator of the given
+	// virtual staking contract
+	VirtualStakingDelegations(ctx context.Context, in *QueryVirtualStakingDelegationsRequest, opts ...grpc.CallOption) (*QueryVirtualStakingDelegationsResponse, error)
+	// ScheduledTasks gets the scheduled tasks filtered by type, contract and
+	// height range
+	ScheduledTasks(ctx context.Context, in *QueryScheduledTasksRequest, opts ...grpc.CallOption) (*QueryScheduledTasksResponse, error)
+	// DeadLetterTasks gets the failed scheduler tasks that exceeded the max
+	// retry attempts
+	DeadLetterTasks(ctx context.Context, in *QueryDeadLetterTasksRequest, opts ...grpc.CallOption) (*QueryDeadLetterTasksResponse, error)
+	// ValsetOutbox gets the validator set operations that are pending for
+	// delivery to the given virtual staking contract
+	ValsetOutbox(ctx context.Context, in *QueryValsetOutboxRequest, opts ...grpc.CallOption) (*QueryValsetOutboxResponse, error)
 	// Params queries the parameters of x/meshsecurity module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -398,6 +864,42 @@ func (c *queryClient) VirtualStakingMaxCapLimits(ctx context.Context, in *QueryV
 	return out, nil
 }
 
+func (c *queryClient) VirtualStakingDelegations(ctx context.Context, in *QueryVirtualStakingDelegationsRequest, opts ...grpc.CallOption) (*QueryVirtualStakingDelegationsResponse, error) {
+	out := new(QueryVirtualStakingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Query/VirtualStakingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledTasks(ctx context.Context, in *QueryScheduledTasksRequest, opts ...grpc.CallOption) (*QueryScheduledTasksResponse, error) {
+	out := new(QueryScheduledTasksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Query/ScheduledTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeadLetterTasks(ctx context.Context, in *QueryDeadLetterTasksRequest, opts ...grpc.CallOption) (*QueryDeadLetterTasksResponse, error) {
+	out := new(QueryDeadLetterTasksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Query/DeadLetterTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValsetOutbox(ctx context.Context, in *QueryValsetOutboxRequest, opts ...grpc.CallOption) (*QueryValsetOutboxResponse, error) {
+	out := new(QueryValsetOutboxResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Query/ValsetOutbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Query/Params", in, out, opts...)
@@ -413,6 +915,18 @@ type QueryServer interface {
 	VirtualStakingMaxCapLimit(context.Context, *QueryVirtualStakingMaxCapLimitRequest) (*QueryVirtualStakingMaxCapLimitResponse, error)
 	// VirtualStakingMaxCapLimits gets max cap limits
 	VirtualStakingMaxCapLimits(context.Context, *QueryVirtualStakingMaxCapLimitsRequest) (*QueryVirtualStakingMaxCapLimitsResponse, error)
+	// VirtualStakingDelegations gets the delegations per validator of the given
+	// virtual staking contract
+	VirtualStakingDelegations(context.Context, *QueryVirtualStakingDelegationsRequest) (*QueryVirtualStakingDelegationsResponse, error)
+	// ScheduledTasks gets the scheduled tasks filtered by type, contract and
+	// height range
+	ScheduledTasks(context.Context, *QueryScheduledTasksRequest) (*QueryScheduledTasksResponse, error)
+	// DeadLetterTasks gets the failed scheduler tasks that exceeded the max
+	// retry attempts
+	DeadLetterTasks(context.Context, *QueryDeadLetterTasksRequest) (*QueryDeadLetterTasksResponse, error)
+	// ValsetOutbox gets the validator set operations that are pending for
+	// delivery to the given virtual staking contract
+	ValsetOutbox(context.Context, *QueryValsetOutboxRequest) (*QueryValsetOutboxResponse, error)
 	// Params queries the parameters of x/meshsecurity module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -427,6 +941,18 @@ func (*UnimplementedQueryServer) VirtualStakingMaxCapLimit(ctx context.Context,
 func (*UnimplementedQueryServer) VirtualStakingMaxCapLimits(ctx context.Context, req *QueryVirtualStakingMaxCapLimitsRequest) (*QueryVirtualStakingMaxCapLimitsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VirtualStakingMaxCapLimits not implemented")
 }
+func (*UnimplementedQueryServer) VirtualStakingDelegations(ctx context.Context, req *QueryVirtualStakingDelegationsRequest) (*QueryVirtualStakingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VirtualStakingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledTasks(ctx context.Context, req *QueryScheduledTasksRequest) (*QueryScheduledTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledTasks not implemented")
+}
+func (*UnimplementedQueryServer) DeadLetterTasks(ctx context.Context, req *QueryDeadLetterTasksRequest) (*QueryDeadLetterTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeadLetterTasks not implemented")
+}
+func (*UnimplementedQueryServer) ValsetOutbox(ctx context.Context, req *QueryValsetOutboxRequest) (*QueryValsetOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetOutbox not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -471,47 +997,135 @@ func _Query_VirtualStakingMaxCapLimits_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_VirtualStakingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVirtualStakingDelegationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).VirtualStakingDelegations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.meshsecurity.v1beta1.Query/Params",
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Query/VirtualStakingDelegations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).VirtualStakingDelegations(ctx, req.(*QueryVirtualStakingDelegationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.meshsecurity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "VirtualStakingMaxCapLimit",
-			Handler:    _Query_VirtualStakingMaxCapLimit_Handler,
-		},
-		{
-			MethodName: "VirtualStakingMaxCapLimits",
-			Handler:    _Query_VirtualStakingMaxCapLimits_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/meshsecurity/v1beta1/query.proto",
-}
-
-func (m *QueryVirtualStakingMaxCapLimitRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+func _Query_ScheduledTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Query/ScheduledTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledTasks(ctx, req.(*QueryScheduledTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeadLetterTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeadLetterTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeadLetterTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Query/DeadLetterTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeadLetterTasks(ctx, req.(*QueryDeadLetterTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValsetOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValsetOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Query/ValsetOutbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValsetOutbox(ctx, req.(*QueryValsetOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.meshsecurity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VirtualStakingMaxCapLimit",
+			Handler:    _Query_VirtualStakingMaxCapLimit_Handler,
+		},
+		{
+			MethodName: "VirtualStakingMaxCapLimits",
+			Handler:    _Query_VirtualStakingMaxCapLimits_Handler,
+		},
+		{
+			MethodName: "VirtualStakingDelegations",
+			Handler:    _Query_VirtualStakingDelegations_Handler,
+		},
+		{
+			MethodName: "ScheduledTasks",
+			Handler:    _Query_ScheduledTasks_Handler,
+		},
+		{
+			MethodName: "DeadLetterTasks",
+			Handler:    _Query_DeadLetterTasks_Handler,
+		},
+		{
+			MethodName: "ValsetOutbox",
+			Handler:    _Query_ValsetOutbox_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/meshsecurity/v1beta1/query.proto",
+}
+
+func (m *QueryVirtualStakingMaxCapLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -560,6 +1174,16 @@ func (m *QueryVirtualStakingMaxCapLimitResponse) MarshalToSizedBuffer(dAtA []byt
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Cap.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -603,6 +1227,38 @@ func (m *QueryVirtualStakingMaxCapLimitsRequest) MarshalToSizedBuffer(dAtA []byt
 	_ = i
 	var l int
 	_ = l
+	if m.OnlyWithDelegations {
+		i--
+		if m.OnlyWithDelegations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OnlyNonZeroCap {
+		i--
+		if m.OnlyNonZeroCap {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -626,6 +1282,18 @@ func (m *QueryVirtualStakingMaxCapLimitsResponse) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.MaxCapInfos) > 0 {
 		for iNdEx := len(m.MaxCapInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -643,7 +1311,7 @@ func (m *QueryVirtualStakingMaxCapLimitsResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVirtualStakingDelegationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -653,20 +1321,64 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVirtualStakingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVirtualStakingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVirtualStakingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVirtualStakingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVirtualStakingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *VirtualStakingDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -676,18 +1388,23 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *VirtualStakingDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VirtualStakingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -695,98 +1412,1746 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryScheduledTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryVirtualStakingMaxCapLimitRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryScheduledTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVirtualStakingMaxCapLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScheduledTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Delegated.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Cap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryVirtualStakingMaxCapLimitsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryScheduledTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVirtualStakingMaxCapLimitsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryScheduledTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MaxCapInfos) > 0 {
-		for _, e := range m.MaxCapInfos {
-			l = e.Size()
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeadLetterTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeadLetterTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeadLetterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeadLetterTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeadLetterTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeadLetterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetOutboxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetOutboxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetOutboxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetOutboxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetOutboxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetOutboxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVirtualStakingMaxCapLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVirtualStakingMaxCapLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVirtualStakingMaxCapLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OnlyNonZeroCap {
+		n += 2
+	}
+	if m.OnlyWithDelegations {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryVirtualStakingMaxCapLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MaxCapInfos) > 0 {
+		for _, e := range m.MaxCapInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVirtualStakingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVirtualStakingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VirtualStakingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryScheduledTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeadLetterTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeadLetterTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValsetOutboxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValsetOutboxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVirtualStakingMaxCapLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVirtualStakingMaxCapLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVirtualStakingMaxCapLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyNonZeroCap", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyNonZeroCap = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyWithDelegations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyWithDelegations = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVirtualStakingMaxCapLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCapInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxCapInfos = append(m.MaxCapInfos, VirtualStakingMaxCapInfo{})
+			if err := m.MaxCapInfos[len(m.MaxCapInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVirtualStakingDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVirtualStakingDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVirtualStakingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVirtualStakingDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVirtualStakingDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVirtualStakingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, VirtualStakingDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VirtualStakingDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VirtualStakingDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VirtualStakingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, ScheduledTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryVirtualStakingMaxCapLimitRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeadLetterTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -809,15 +3174,15 @@ func (m *QueryVirtualStakingMaxCapLimitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeadLetterTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeadLetterTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -845,7 +3210,43 @@ func (m *QueryVirtualStakingMaxCapLimitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -868,7 +3269,7 @@ func (m *QueryVirtualStakingMaxCapLimitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVirtualStakingMaxCapLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeadLetterTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -891,15 +3292,15 @@ func (m *QueryVirtualStakingMaxCapLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeadLetterTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeadLetterTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -926,13 +3327,14 @@ func (m *QueryVirtualStakingMaxCapLimitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, FailedTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -959,7 +3361,10 @@ func (m *QueryVirtualStakingMaxCapLimitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -984,7 +3389,7 @@ func (m *QueryVirtualStakingMaxCapLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVirtualStakingMaxCapLimitsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetOutboxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1007,12 +3412,80 @@ func (m *QueryVirtualStakingMaxCapLimitsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetOutboxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetOutboxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1034,7 +3507,7 @@ func (m *QueryVirtualStakingMaxCapLimitsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVirtualStakingMaxCapLimitsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetOutboxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1057,15 +3530,15 @@ func (m *QueryVirtualStakingMaxCapLimitsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetOutboxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVirtualStakingMaxCapLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetOutboxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCapInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1092,8 +3565,44 @@ func (m *QueryVirtualStakingMaxCapLimitsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxCapInfos = append(m.MaxCapInfos, VirtualStakingMaxCapInfo{})
-			if err := m.MaxCapInfos[len(m.MaxCapInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, ValsetOutboxEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
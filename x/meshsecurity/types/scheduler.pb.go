@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -100,9 +101,153 @@ func (m *ValidatorAddress) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorAddress proto.InternalMessageInfo
 
+// ScheduledTask is a pending scheduler entry for a virtual staking contract
+type ScheduledTask struct {
+	// Type is the scheduler task type
+	Type uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Height is the block height the task is executed at
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Repeat is set for tasks that are re-scheduled after execution
+	Repeat bool `protobuf:"varint,4,opt,name=repeat,proto3" json:"repeat,omitempty"`
+}
+
+func (m *ScheduledTask) Reset()         { *m = ScheduledTask{} }
+func (m *ScheduledTask) String() string { return proto.CompactTextString(m) }
+func (*ScheduledTask) ProtoMessage()    {}
+func (*ScheduledTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de3814df630b6218, []int{2}
+}
+func (m *ScheduledTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTask.Merge(m, src)
+}
+func (m *ScheduledTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTask proto.InternalMessageInfo
+
+// PausedTasks marks the scheduled tasks of a type for a virtual staking
+// contract as paused
+type PausedTasks struct {
+	// Type is the scheduler task type
+	Type uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *PausedTasks) Reset()         { *m = PausedTasks{} }
+func (m *PausedTasks) String() string { return proto.CompactTextString(m) }
+func (*PausedTasks) ProtoMessage()    {}
+func (*PausedTasks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de3814df630b6218, []int{3}
+}
+func (m *PausedTasks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedTasks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedTasks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedTasks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedTasks.Merge(m, src)
+}
+func (m *PausedTasks) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedTasks) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedTasks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedTasks proto.InternalMessageInfo
+
+// FailedTask is a failed one-shot scheduler task with the payload that was
+// sent to the virtual staking contract
+type FailedTask struct {
+	// ID is the unique identifier of the failed task
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Type is the scheduler task type
+	Type uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Payload is the json encoded sudo message for the contract. It is empty
+	// for valset tasks as their reports are rebuilt on retry.
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Attempts is the number of failed executions
+	Attempts uint32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Height is the block height of the next retry. For dead letters, it is the
+	// height of the last attempt.
+	Height uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// LastError is the error of the last failed execution
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *FailedTask) Reset()         { *m = FailedTask{} }
+func (m *FailedTask) String() string { return proto.CompactTextString(m) }
+func (*FailedTask) ProtoMessage()    {}
+func (*FailedTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de3814df630b6218, []int{4}
+}
+func (m *FailedTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedTask.Merge(m, src)
+}
+func (m *FailedTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedTask proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ScheduledWork)(nil), "osmosis.meshsecurity.v1beta1.ScheduledWork")
 	proto.RegisterType((*ValidatorAddress)(nil), "osmosis.meshsecurity.v1beta1.ValidatorAddress")
+	proto.RegisterType((*ScheduledTask)(nil), "osmosis.meshsecurity.v1beta1.ScheduledTask")
+	proto.RegisterType((*PausedTasks)(nil), "osmosis.meshsecurity.v1beta1.PausedTasks")
+	proto.RegisterType((*FailedTask)(nil), "osmosis.meshsecurity.v1beta1.FailedTask")
 }
 
 func init() {
@@ -110,26 +255,139 @@ func init() {
 }
 
 var fileDescriptor_de3814df630b6218 = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4d, 0x2d, 0xce, 0x28, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x4e, 0xce, 0x48, 0x4d, 0x29, 0xcd,
-	0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd6, 0x43, 0x56, 0xad,
-	0x07, 0x55, 0x2d, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48,
-	0x49, 0x26, 0x83, 0x35, 0xc5, 0x43, 0x24, 0x20, 0x1c, 0x88, 0x94, 0x92, 0x3a, 0x17, 0x6f, 0x30,
-	0xd4, 0x86, 0x94, 0xf0, 0xfc, 0xa2, 0x6c, 0x21, 0x31, 0x2e, 0xb6, 0xa2, 0xd4, 0x82, 0xd4, 0xc4,
-	0x12, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x28, 0x4f, 0xc9, 0x8d, 0x4b, 0x20, 0x2c, 0x31,
-	0x27, 0x33, 0x25, 0xb1, 0x24, 0xbf, 0xc8, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x58, 0xc8, 0x88,
-	0x8b, 0x3d, 0x11, 0xc2, 0x04, 0x2b, 0xe6, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0x6a, 0x3e,
-	0x54, 0x51, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10, 0x4c, 0xa1, 0x53, 0xc2, 0x89, 0x87, 0x72,
-	0x0c, 0x2b, 0x1e, 0xc9, 0x31, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x5d, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0xb3, 0xba, 0x39,
-	0x89, 0x49, 0x90, 0xf0, 0xd1, 0x85, 0x79, 0x59, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0x02, 0x35, 0xcc,
-	0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x3e, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xf5, 0x6f, 0x77, 0x0e, 0x58, 0x01, 0x00, 0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xc1, 0x8a, 0xd4, 0x30,
+	0x18, 0x6e, 0xba, 0x75, 0x66, 0x36, 0xba, 0x20, 0x65, 0x59, 0xea, 0xa0, 0xd9, 0xa5, 0x17, 0xf7,
+	0x60, 0x5b, 0x56, 0x3d, 0x79, 0x10, 0x1c, 0x74, 0xc1, 0x9b, 0x74, 0x45, 0x41, 0x90, 0x35, 0x6d,
+	0x42, 0x1b, 0xa6, 0xdd, 0x94, 0x24, 0x15, 0xe7, 0x29, 0xf4, 0x11, 0x3c, 0xfa, 0x00, 0x3e, 0xc4,
+	0x1e, 0x17, 0x4f, 0x9e, 0xc4, 0xed, 0x5c, 0x7c, 0x0c, 0x69, 0x92, 0x0e, 0xdd, 0x83, 0xe8, 0xc1,
+	0xdb, 0xff, 0x25, 0x5f, 0xbe, 0xef, 0xff, 0x7e, 0xfe, 0xc0, 0x7b, 0x5c, 0xd6, 0x5c, 0x32, 0x99,
+	0xd4, 0x54, 0x96, 0x92, 0xe6, 0xad, 0x60, 0x6a, 0x95, 0xbc, 0x3f, 0xca, 0xa8, 0xc2, 0x47, 0x89,
+	0xcc, 0x4b, 0x4a, 0xda, 0x8a, 0x8a, 0xb8, 0x11, 0x5c, 0x71, 0xff, 0xb6, 0x65, 0xc7, 0x63, 0x76,
+	0x6c, 0xd9, 0xf3, 0xdd, 0x82, 0x17, 0x5c, 0x13, 0x93, 0xbe, 0x32, 0x6f, 0xe6, 0xb7, 0x72, 0xfd,
+	0xe8, 0xd4, 0x5c, 0x18, 0x60, 0xae, 0xc2, 0xbb, 0x70, 0xe7, 0xc4, 0x3a, 0x90, 0xd7, 0x5c, 0x2c,
+	0xfd, 0x3d, 0x38, 0x11, 0xb4, 0xa1, 0x58, 0x05, 0xe0, 0x00, 0x1c, 0xce, 0x52, 0x8b, 0xc2, 0x63,
+	0x78, 0xf3, 0x15, 0xae, 0x18, 0xc1, 0x8a, 0x8b, 0x27, 0x84, 0x08, 0x2a, 0xa5, 0x7f, 0x1f, 0x4e,
+	0xb1, 0x29, 0x35, 0x79, 0x7b, 0x11, 0x7c, 0xfb, 0x1a, 0xed, 0x5a, 0x7d, 0x4b, 0x3a, 0x51, 0x82,
+	0x9d, 0x15, 0xe9, 0x40, 0x0c, 0x3f, 0x82, 0x91, 0xe3, 0x4b, 0x2c, 0x97, 0xbe, 0x0f, 0x3d, 0xb5,
+	0x6a, 0xa8, 0x96, 0xd8, 0x49, 0x75, 0xed, 0x3f, 0x84, 0xb3, 0x9c, 0x9f, 0x29, 0x81, 0x73, 0x15,
+	0xb8, 0x7f, 0x91, 0xde, 0x30, 0xfb, 0xde, 0x4b, 0xca, 0x8a, 0x52, 0x05, 0x5b, 0x07, 0xe0, 0xd0,
+	0x4b, 0x2d, 0x1a, 0x65, 0xf2, 0xc6, 0x99, 0x1e, 0x79, 0xbf, 0x3e, 0xef, 0x83, 0xf0, 0x2d, 0xbc,
+	0xfe, 0x02, 0xb7, 0xd2, 0x74, 0x23, 0xff, 0x5f, 0x3b, 0x56, 0xfe, 0x12, 0x40, 0x78, 0x8c, 0xd9,
+	0x90, 0x76, 0x0f, 0xba, 0x8c, 0x68, 0x71, 0x6f, 0x31, 0xe9, 0x7e, 0xec, 0xbb, 0xcf, 0x9f, 0xa6,
+	0x2e, 0x23, 0x1b, 0x5b, 0xf7, 0x0f, 0xb6, 0x5b, 0xff, 0x3c, 0x85, 0x00, 0x4e, 0x1b, 0xbc, 0xaa,
+	0x38, 0x26, 0x3a, 0xee, 0x8d, 0x74, 0x80, 0xfe, 0x1c, 0xce, 0xb0, 0x52, 0xb4, 0x6e, 0x94, 0x0c,
+	0xae, 0x69, 0x9f, 0x0d, 0x1e, 0xcd, 0x6e, 0x72, 0x65, 0x76, 0x77, 0x20, 0xac, 0xb0, 0x54, 0xa7,
+	0x54, 0x08, 0x2e, 0x82, 0x69, 0xdf, 0x45, 0xba, 0xdd, 0x9f, 0x3c, 0xeb, 0x0f, 0x4c, 0xc6, 0xc5,
+	0xbb, 0xf3, 0x4b, 0xe4, 0x7c, 0xe9, 0x90, 0x73, 0xde, 0x21, 0x70, 0xd1, 0x21, 0xf0, 0xb3, 0x43,
+	0xe0, 0xd3, 0x1a, 0x39, 0x17, 0x6b, 0xe4, 0x7c, 0x5f, 0x23, 0xe7, 0xcd, 0xe3, 0x82, 0xa9, 0xb2,
+	0xcd, 0xe2, 0x9c, 0xd7, 0x89, 0xdd, 0xe0, 0xa8, 0xc2, 0x99, 0x59, 0xfa, 0x68, 0xd8, 0xe3, 0x48,
+	0x92, 0x65, 0xf2, 0xe1, 0xea, 0x47, 0xe8, 0x27, 0x21, 0xb3, 0x89, 0x5e, 0xd7, 0x07, 0xbf, 0x07,
+	0x00, 0xf7, 0x3e, 0xd4, 0xc0, 0x2d, 0x03, 0x00, 0x00,
+}
+
+func (this *ScheduledTask) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTask)
+	if !ok {
+		that2, ok := that.(ScheduledTask)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Repeat != that1.Repeat {
+		return false
+	}
+	return true
+}
+func (this *PausedTasks) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PausedTasks)
+	if !ok {
+		that2, ok := that.(PausedTasks)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	return true
 }
+func (this *FailedTask) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*FailedTask)
+	if !ok {
+		that2, ok := that.(FailedTask)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	return true
+}
 func (m *ScheduledWork) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -193,6 +451,155 @@ func (m *ValidatorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Repeat {
+		i--
+		if m.Repeat {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedTasks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedTasks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedTasks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Height != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintScheduler(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintScheduler(dAtA []byte, offset int, v uint64) int {
 	offset -= sovScheduler(v)
 	base := offset
@@ -229,6 +636,77 @@ func (m *ValidatorAddress) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovScheduler(uint64(m.Type))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovScheduler(uint64(m.Height))
+	}
+	if m.Repeat {
+		n += 2
+	}
+	return n
+}
+
+func (m *PausedTasks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovScheduler(uint64(m.Type))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	return n
+}
+
+func (m *FailedTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovScheduler(uint64(m.ID))
+	}
+	if m.Type != 0 {
+		n += 1 + sovScheduler(uint64(m.Type))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovScheduler(uint64(m.Attempts))
+	}
+	if m.Height != 0 {
+		n += 1 + sovScheduler(uint64(m.Height))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovScheduler(uint64(l))
+	}
+	return n
+}
+
 func sovScheduler(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -387,6 +865,471 @@ func (m *ValidatorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduledTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repeat", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Repeat = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PausedTasks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedTasks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedTasks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipScheduler(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -31,3 +31,267 @@ func (msg MsgSetVirtualStakingMaxCap) ValidateBasic() error {
 	}
 	return nil
 }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetVirtualStakingMaxCaps) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSetVirtualStakingMaxCaps.
+func (msg MsgSetVirtualStakingMaxCaps) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgSetVirtualStakingMaxCaps) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if len(msg.MaxCaps) == 0 {
+		return ErrInvalid.Wrap("empty max caps")
+	}
+	uniqueContracts := make(map[string]struct{}, len(msg.MaxCaps))
+	for i, c := range msg.MaxCaps {
+		contract, err := sdk.AccAddressFromBech32(c.Contract)
+		if err != nil {
+			return errorsmod.Wrapf(err, "contract at position %d", i)
+		}
+		if _, exists := uniqueContracts[string(contract)]; exists {
+			return ErrInvalid.Wrapf("duplicate contract: %s", c.Contract)
+		}
+		uniqueContracts[string(contract)] = struct{}{}
+		if err := c.MaxCap.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "max cap at position %d", i)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetContractConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSetContractConfig.
+func (msg MsgSetContractConfig) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgSetContractConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgScheduleTask) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgScheduleTask.
+func (msg MsgScheduleTask) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgScheduleTask) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return ValidateSchedulerTaskType(msg.TaskType)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRescheduleTask) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgRescheduleTask.
+func (msg MsgRescheduleTask) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgRescheduleTask) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if msg.NewHeight == 0 {
+		return ErrInvalid.Wrap("empty new height")
+	}
+	return ValidateSchedulerTaskType(msg.TaskType)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgPauseScheduledTasks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgPauseScheduledTasks.
+func (msg MsgPauseScheduledTasks) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgPauseScheduledTasks) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return ValidateSchedulerTaskType(msg.TaskType)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgResumeScheduledTasks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgResumeScheduledTasks.
+func (msg MsgResumeScheduledTasks) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgResumeScheduledTasks) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return ValidateSchedulerTaskType(msg.TaskType)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgDeleteScheduledTasks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgDeleteScheduledTasks.
+func (msg MsgDeleteScheduledTasks) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgDeleteScheduledTasks) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return ValidateSchedulerTaskType(msg.TaskType)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgDeleteDeadLetterTasks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgDeleteDeadLetterTasks.
+func (msg MsgDeleteDeadLetterTasks) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgDeleteDeadLetterTasks) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return ValidateSchedulerTaskType(msg.TaskType)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgUpdateParams.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return errorsmod.Wrap(msg.Params.ValidateBasic(), "params")
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRemoveVirtualStakingContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgRemoveVirtualStakingContract.
+func (msg MsgRemoveVirtualStakingContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgRemoveVirtualStakingContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgMigrateVirtualStakingContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgMigrateVirtualStakingContract.
+func (msg MsgMigrateVirtualStakingContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgMigrateVirtualStakingContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	contract, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	newContract, err := sdk.AccAddressFromBech32(msg.NewContract)
+	if err != nil {
+		return errorsmod.Wrap(err, "new contract")
+	}
+	if contract.Equals(newContract) {
+		return ErrInvalid.Wrap("new contract must not be the current contract")
+	}
+	return nil
+}
@@ -114,243 +114,5062 @@ func (m *MsgSetVirtualStakingMaxCapResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetVirtualStakingMaxCapResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetVirtualStakingMaxCap)(nil), "osmosis.meshsecurity.v1beta1.MsgSetVirtualStakingMaxCap")
-	proto.RegisterType((*MsgSetVirtualStakingMaxCapResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgSetVirtualStakingMaxCapResponse")
+// MsgSetVirtualStakingMaxCaps creates or updates the maximum cap limits for
+// virtual staking coins of multiple contracts. The limits are applied
+// atomically, the total is validated against the final state only.
+type MsgSetVirtualStakingMaxCaps struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// MaxCaps are the new limits by contract
+	MaxCaps []VirtualStakingMaxCap `protobuf:"bytes,2,rep,name=max_caps,json=maxCaps,proto3" json:"max_caps"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/meshsecurity/v1beta1/tx.proto", fileDescriptor_ca993316ec9770c4)
+func (m *MsgSetVirtualStakingMaxCaps) Reset()         { *m = MsgSetVirtualStakingMaxCaps{} }
+func (m *MsgSetVirtualStakingMaxCaps) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVirtualStakingMaxCaps) ProtoMessage()    {}
+func (*MsgSetVirtualStakingMaxCaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{2}
+}
+func (m *MsgSetVirtualStakingMaxCaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVirtualStakingMaxCaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVirtualStakingMaxCaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVirtualStakingMaxCaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVirtualStakingMaxCaps.Merge(m, src)
+}
+func (m *MsgSetVirtualStakingMaxCaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVirtualStakingMaxCaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVirtualStakingMaxCaps.DiscardUnknown(m)
 }
 
-var fileDescriptor_ca993316ec9770c4 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4d, 0x2d, 0xce, 0x28, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x81, 0x2a, 0xd3, 0x43, 0x56, 0xa6, 0x07, 0x55, 0x26, 0x25, 0x97, 0x0c, 0x96,
-	0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0x85, 0xeb, 0x4d, 0xce, 0xcf, 0xcc, 0x83, 0xe8, 0x96, 0x12, 0x87,
-	0xca, 0xe7, 0x16, 0xa7, 0xeb, 0x97, 0x19, 0x82, 0x28, 0xa8, 0x84, 0x48, 0x7a, 0x7e, 0x7a, 0x3e,
-	0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x05, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44,
-	0x48, 0xe9, 0x0c, 0x23, 0x97, 0x94, 0x6f, 0x71, 0x7a, 0x70, 0x6a, 0x49, 0x58, 0x66, 0x51, 0x49,
-	0x69, 0x62, 0x4e, 0x70, 0x49, 0x62, 0x76, 0x66, 0x5e, 0xba, 0x6f, 0x62, 0x85, 0x73, 0x62, 0x81,
-	0x90, 0x0c, 0x17, 0x67, 0x62, 0x69, 0x49, 0x46, 0x3e, 0xc8, 0x55, 0x12, 0x8c, 0x0a, 0x8c, 0x1a,
-	0x9c, 0x41, 0x08, 0x01, 0x21, 0x29, 0x2e, 0x8e, 0xe4, 0xfc, 0xbc, 0x92, 0xa2, 0xc4, 0xe4, 0x12,
-	0x09, 0x26, 0xb0, 0x24, 0x9c, 0x2f, 0x64, 0xc1, 0xc5, 0x9e, 0x9b, 0x58, 0x11, 0x9f, 0x9c, 0x58,
-	0x20, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa9, 0x07, 0x71, 0xac, 0x1e, 0xc8, 0x33, 0x30,
-	0x1f, 0xea, 0x39, 0xe7, 0x67, 0xe6, 0x39, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0xc4, 0x96, 0x0b,
-	0xb6, 0xd3, 0xca, 0xaa, 0xe9, 0xf9, 0x06, 0x2d, 0x84, 0x2d, 0x5d, 0xcf, 0x37, 0x68, 0xa9, 0xa3,
-	0x04, 0x22, 0x6e, 0xf7, 0x2a, 0xa9, 0x70, 0x29, 0xe1, 0x96, 0x0d, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf,
-	0x2b, 0x4e, 0x35, 0x9a, 0xcb, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x34, 0x95, 0x91, 0x4b, 0x1c,
-	0x97, 0xcf, 0x2d, 0xf4, 0xf0, 0xc5, 0x8c, 0x1e, 0x6e, 0x5b, 0xa4, 0x1c, 0xc8, 0xd5, 0x09, 0x73,
-	0x9f, 0x53, 0xcc, 0x89, 0x87, 0x72, 0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x65, 0x97, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xb5, 0x49,
-	0x37, 0x27, 0x31, 0x09, 0x92, 0xd2, 0x74, 0x61, 0xf6, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x57, 0xa0,
-	0xa6, 0xbe, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xcc, 0x1b, 0x03, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x7a, 0xbb, 0x3f, 0x0f, 0xa2, 0x02, 0x00, 0x00,
+var xxx_messageInfo_MsgSetVirtualStakingMaxCaps proto.InternalMessageInfo
+
+// VirtualStakingMaxCap is the maximum cap limit of a contract
+type VirtualStakingMaxCap struct {
+	// Contract is the address of the smart contract that is given permission
+	// do virtual staking which includes minting and burning staking tokens.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// MaxCap is the limit up this the virtual tokens can be minted.
+	MaxCap types.Coin `protobuf:"bytes,2,opt,name=max_cap,json=maxCap,proto3" json:"max_cap"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *VirtualStakingMaxCap) Reset()         { *m = VirtualStakingMaxCap{} }
+func (m *VirtualStakingMaxCap) String() string { return proto.CompactTextString(m) }
+func (*VirtualStakingMaxCap) ProtoMessage()    {}
+func (*VirtualStakingMaxCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{3}
+}
+func (m *VirtualStakingMaxCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VirtualStakingMaxCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VirtualStakingMaxCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VirtualStakingMaxCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VirtualStakingMaxCap.Merge(m, src)
+}
+func (m *VirtualStakingMaxCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *VirtualStakingMaxCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_VirtualStakingMaxCap.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_VirtualStakingMaxCap proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SetVirtualStakingMaxCap creates or updates a maximum cap limit for virtual
-	// staking coins
-	SetVirtualStakingMaxCap(ctx context.Context, in *MsgSetVirtualStakingMaxCap, opts ...grpc.CallOption) (*MsgSetVirtualStakingMaxCapResponse, error)
+// MsgSetVirtualStakingMaxCapsResponse returns result data.
+type MsgSetVirtualStakingMaxCapsResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetVirtualStakingMaxCapsResponse) Reset()         { *m = MsgSetVirtualStakingMaxCapsResponse{} }
+func (m *MsgSetVirtualStakingMaxCapsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVirtualStakingMaxCapsResponse) ProtoMessage()    {}
+func (*MsgSetVirtualStakingMaxCapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{4}
+}
+func (m *MsgSetVirtualStakingMaxCapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVirtualStakingMaxCapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVirtualStakingMaxCapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVirtualStakingMaxCapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVirtualStakingMaxCapsResponse.Merge(m, src)
+}
+func (m *MsgSetVirtualStakingMaxCapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVirtualStakingMaxCapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVirtualStakingMaxCapsResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgSetVirtualStakingMaxCapsResponse proto.InternalMessageInfo
+
+// MsgSetContractConfig sets the contract specific overrides of the module
+// params for a virtual staking contract
+type MsgSetContractConfig struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Config contains the overrides. Zero values fall back to the module params.
+	Config ContractConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
 }
 
-func (c *msgClient) SetVirtualStakingMaxCap(ctx context.Context, in *MsgSetVirtualStakingMaxCap, opts ...grpc.CallOption) (*MsgSetVirtualStakingMaxCapResponse, error) {
-	out := new(MsgSetVirtualStakingMaxCapResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/SetVirtualStakingMaxCap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetContractConfig) Reset()         { *m = MsgSetContractConfig{} }
+func (m *MsgSetContractConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractConfig) ProtoMessage()    {}
+func (*MsgSetContractConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{5}
+}
+func (m *MsgSetContractConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SetVirtualStakingMaxCap creates or updates a maximum cap limit for virtual
-	// staking coins
-	SetVirtualStakingMaxCap(context.Context, *MsgSetVirtualStakingMaxCap) (*MsgSetVirtualStakingMaxCapResponse, error)
+func (m *MsgSetContractConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractConfig.Merge(m, src)
+}
+func (m *MsgSetContractConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractConfig.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgSetContractConfig proto.InternalMessageInfo
+
+// MsgSetContractConfigResponse returns result data.
+type MsgSetContractConfigResponse struct {
 }
 
-func (*UnimplementedMsgServer) SetVirtualStakingMaxCap(ctx context.Context, req *MsgSetVirtualStakingMaxCap) (*MsgSetVirtualStakingMaxCapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetVirtualStakingMaxCap not implemented")
+func (m *MsgSetContractConfigResponse) Reset()         { *m = MsgSetContractConfigResponse{} }
+func (m *MsgSetContractConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractConfigResponse) ProtoMessage()    {}
+func (*MsgSetContractConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{6}
+}
+func (m *MsgSetContractConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetContractConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractConfigResponse.Merge(m, src)
+}
+func (m *MsgSetContractConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractConfigResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetContractConfigResponse proto.InternalMessageInfo
+
+// MsgScheduleTask schedules a new task for a virtual staking contract
+type MsgScheduleTask struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// TaskType is the scheduler task type
+	TaskType uint32 `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	// Height is the block height to execute the task at. Defaults to the next
+	// epoch when not set.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// Repeat is set for tasks that are re-scheduled after execution
+	Repeat bool `protobuf:"varint,5,opt,name=repeat,proto3" json:"repeat,omitempty"`
 }
 
-func _Msg_SetVirtualStakingMaxCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetVirtualStakingMaxCap)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetVirtualStakingMaxCap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/SetVirtualStakingMaxCap",
+func (m *MsgScheduleTask) Reset()         { *m = MsgScheduleTask{} }
+func (m *MsgScheduleTask) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleTask) ProtoMessage()    {}
+func (*MsgScheduleTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{7}
+}
+func (m *MsgScheduleTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetVirtualStakingMaxCap(ctx, req.(*MsgSetVirtualStakingMaxCap))
+}
+func (m *MsgScheduleTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleTask.Merge(m, src)
+}
+func (m *MsgScheduleTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleTask proto.InternalMessageInfo
+
+// MsgScheduleTaskResponse returns result data.
+type MsgScheduleTaskResponse struct {
+}
+
+func (m *MsgScheduleTaskResponse) Reset()         { *m = MsgScheduleTaskResponse{} }
+func (m *MsgScheduleTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleTaskResponse) ProtoMessage()    {}
+func (*MsgScheduleTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{8}
+}
+func (m *MsgScheduleTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgScheduleTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleTaskResponse.Merge(m, src)
+}
+func (m *MsgScheduleTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleTaskResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.meshsecurity.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetVirtualStakingMaxCap",
-			Handler:    _Msg_SetVirtualStakingMaxCap_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/meshsecurity/v1beta1/tx.proto",
+var xxx_messageInfo_MsgScheduleTaskResponse proto.InternalMessageInfo
+
+// MsgRescheduleTask moves a scheduled task of a virtual staking contract to a
+// new height
+type MsgRescheduleTask struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// TaskType is the scheduler task type
+	TaskType uint32 `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	// Height is the block height the task is currently scheduled for
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// NewHeight is the block height to execute the task at
+	NewHeight uint64 `protobuf:"varint,5,opt,name=new_height,json=newHeight,proto3" json:"new_height,omitempty"`
 }
 
-func (m *MsgSetVirtualStakingMaxCap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRescheduleTask) Reset()         { *m = MsgRescheduleTask{} }
+func (m *MsgRescheduleTask) String() string { return proto.CompactTextString(m) }
+func (*MsgRescheduleTask) ProtoMessage()    {}
+func (*MsgRescheduleTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{9}
+}
+func (m *MsgRescheduleTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRescheduleTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRescheduleTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRescheduleTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRescheduleTask.Merge(m, src)
+}
+func (m *MsgRescheduleTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRescheduleTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRescheduleTask.DiscardUnknown(m)
 }
 
-func (m *MsgSetVirtualStakingMaxCap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRescheduleTask proto.InternalMessageInfo
+
+// MsgRescheduleTaskResponse returns result data.
+type MsgRescheduleTaskResponse struct {
 }
 
-func (m *MsgSetVirtualStakingMaxCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MaxCap.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRescheduleTaskResponse) Reset()         { *m = MsgRescheduleTaskResponse{} }
+func (m *MsgRescheduleTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRescheduleTaskResponse) ProtoMessage()    {}
+func (*MsgRescheduleTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{10}
+}
+func (m *MsgRescheduleTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRescheduleTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRescheduleTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRescheduleTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRescheduleTaskResponse.Merge(m, src)
+}
+func (m *MsgRescheduleTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRescheduleTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRescheduleTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRescheduleTaskResponse proto.InternalMessageInfo
+
+// MsgPauseScheduledTasks pauses the execution of the scheduled tasks of a
+// virtual staking contract. Paused tasks are kept until resumed or deleted.
+type MsgPauseScheduledTasks struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// TaskType is the scheduler task type
+	TaskType uint32 `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+}
+
+func (m *MsgPauseScheduledTasks) Reset()         { *m = MsgPauseScheduledTasks{} }
+func (m *MsgPauseScheduledTasks) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseScheduledTasks) ProtoMessage()    {}
+func (*MsgPauseScheduledTasks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{11}
+}
+func (m *MsgPauseScheduledTasks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseScheduledTasks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseScheduledTasks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseScheduledTasks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseScheduledTasks.Merge(m, src)
+}
+func (m *MsgPauseScheduledTasks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseScheduledTasks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseScheduledTasks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseScheduledTasks proto.InternalMessageInfo
+
+// MsgPauseScheduledTasksResponse returns result data.
+type MsgPauseScheduledTasksResponse struct {
+}
+
+func (m *MsgPauseScheduledTasksResponse) Reset()         { *m = MsgPauseScheduledTasksResponse{} }
+func (m *MsgPauseScheduledTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseScheduledTasksResponse) ProtoMessage()    {}
+func (*MsgPauseScheduledTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{12}
+}
+func (m *MsgPauseScheduledTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseScheduledTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseScheduledTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseScheduledTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseScheduledTasksResponse.Merge(m, src)
+}
+func (m *MsgPauseScheduledTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseScheduledTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseScheduledTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseScheduledTasksResponse proto.InternalMessageInfo
+
+// MsgResumeScheduledTasks resumes the execution of paused tasks of a virtual
+// staking contract. Overdue tasks are executed with the next block.
+type MsgResumeScheduledTasks struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// TaskType is the scheduler task type
+	TaskType uint32 `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+}
+
+func (m *MsgResumeScheduledTasks) Reset()         { *m = MsgResumeScheduledTasks{} }
+func (m *MsgResumeScheduledTasks) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeScheduledTasks) ProtoMessage()    {}
+func (*MsgResumeScheduledTasks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{13}
+}
+func (m *MsgResumeScheduledTasks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeScheduledTasks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeScheduledTasks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeScheduledTasks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeScheduledTasks.Merge(m, src)
+}
+func (m *MsgResumeScheduledTasks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeScheduledTasks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeScheduledTasks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeScheduledTasks proto.InternalMessageInfo
+
+// MsgResumeScheduledTasksResponse returns result data.
+type MsgResumeScheduledTasksResponse struct {
+}
+
+func (m *MsgResumeScheduledTasksResponse) Reset()         { *m = MsgResumeScheduledTasksResponse{} }
+func (m *MsgResumeScheduledTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeScheduledTasksResponse) ProtoMessage()    {}
+func (*MsgResumeScheduledTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{14}
+}
+func (m *MsgResumeScheduledTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeScheduledTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeScheduledTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeScheduledTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeScheduledTasksResponse.Merge(m, src)
+}
+func (m *MsgResumeScheduledTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeScheduledTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeScheduledTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeScheduledTasksResponse proto.InternalMessageInfo
+
+// MsgDeleteScheduledTasks deletes scheduled tasks of a virtual staking
+// contract
+type MsgDeleteScheduledTasks struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// TaskType is the scheduler task type
+	TaskType uint32 `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	// Height is the block height of the task to delete. All tasks of the type
+	// are deleted when not set.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgDeleteScheduledTasks) Reset()         { *m = MsgDeleteScheduledTasks{} }
+func (m *MsgDeleteScheduledTasks) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteScheduledTasks) ProtoMessage()    {}
+func (*MsgDeleteScheduledTasks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{15}
+}
+func (m *MsgDeleteScheduledTasks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteScheduledTasks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteScheduledTasks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteScheduledTasks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteScheduledTasks.Merge(m, src)
+}
+func (m *MsgDeleteScheduledTasks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteScheduledTasks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteScheduledTasks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteScheduledTasks proto.InternalMessageInfo
+
+// MsgDeleteScheduledTasksResponse returns result data.
+type MsgDeleteScheduledTasksResponse struct {
+}
+
+func (m *MsgDeleteScheduledTasksResponse) Reset()         { *m = MsgDeleteScheduledTasksResponse{} }
+func (m *MsgDeleteScheduledTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteScheduledTasksResponse) ProtoMessage()    {}
+func (*MsgDeleteScheduledTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{16}
+}
+func (m *MsgDeleteScheduledTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteScheduledTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteScheduledTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteScheduledTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteScheduledTasksResponse.Merge(m, src)
+}
+func (m *MsgDeleteScheduledTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteScheduledTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteScheduledTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteScheduledTasksResponse proto.InternalMessageInfo
+
+// MsgDeleteDeadLetterTasks deletes the dead letter tasks of a type for a
+// virtual staking contract
+type MsgDeleteDeadLetterTasks struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// TaskType is the scheduler task type
+	TaskType uint32 `protobuf:"varint,3,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	// Reschedule schedules a one-shot task of the type for the current block, so
+	// that the failed task is executed again with the current state
+	Reschedule bool `protobuf:"varint,4,opt,name=reschedule,proto3" json:"reschedule,omitempty"`
+}
+
+func (m *MsgDeleteDeadLetterTasks) Reset()         { *m = MsgDeleteDeadLetterTasks{} }
+func (m *MsgDeleteDeadLetterTasks) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteDeadLetterTasks) ProtoMessage()    {}
+func (*MsgDeleteDeadLetterTasks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{17}
+}
+func (m *MsgDeleteDeadLetterTasks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteDeadLetterTasks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteDeadLetterTasks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteDeadLetterTasks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteDeadLetterTasks.Merge(m, src)
+}
+func (m *MsgDeleteDeadLetterTasks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteDeadLetterTasks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteDeadLetterTasks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteDeadLetterTasks proto.InternalMessageInfo
+
+// MsgDeleteDeadLetterTasksResponse returns result data.
+type MsgDeleteDeadLetterTasksResponse struct {
+}
+
+func (m *MsgDeleteDeadLetterTasksResponse) Reset()         { *m = MsgDeleteDeadLetterTasksResponse{} }
+func (m *MsgDeleteDeadLetterTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteDeadLetterTasksResponse) ProtoMessage()    {}
+func (*MsgDeleteDeadLetterTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{18}
+}
+func (m *MsgDeleteDeadLetterTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteDeadLetterTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteDeadLetterTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteDeadLetterTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteDeadLetterTasksResponse.Merge(m, src)
+}
+func (m *MsgDeleteDeadLetterTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteDeadLetterTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteDeadLetterTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteDeadLetterTasksResponse proto.InternalMessageInfo
+
+// MsgUpdateParams updates the module params
+type MsgUpdateParams struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params defines the new module params. All fields must be set.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{19}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse returns result data.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{20}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgRemoveVirtualStakingContract unbonds and burns all remaining virtual
+// stake of a contract and deletes all contract data from the module state
+type MsgRemoveVirtualStakingContract struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Contract is the address of the virtual staking contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *MsgRemoveVirtualStakingContract) Reset()         { *m = MsgRemoveVirtualStakingContract{} }
+func (m *MsgRemoveVirtualStakingContract) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveVirtualStakingContract) ProtoMessage()    {}
+func (*MsgRemoveVirtualStakingContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{21}
+}
+func (m *MsgRemoveVirtualStakingContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveVirtualStakingContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveVirtualStakingContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveVirtualStakingContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveVirtualStakingContract.Merge(m, src)
+}
+func (m *MsgRemoveVirtualStakingContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveVirtualStakingContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveVirtualStakingContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveVirtualStakingContract proto.InternalMessageInfo
+
+// MsgRemoveVirtualStakingContractResponse returns result data.
+type MsgRemoveVirtualStakingContractResponse struct {
+}
+
+func (m *MsgRemoveVirtualStakingContractResponse) Reset() {
+	*m = MsgRemoveVirtualStakingContractResponse{}
+}
+func (m *MsgRemoveVirtualStakingContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveVirtualStakingContractResponse) ProtoMessage()    {}
+func (*MsgRemoveVirtualStakingContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{22}
+}
+func (m *MsgRemoveVirtualStakingContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveVirtualStakingContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveVirtualStakingContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveVirtualStakingContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveVirtualStakingContractResponse.Merge(m, src)
+}
+func (m *MsgRemoveVirtualStakingContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveVirtualStakingContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveVirtualStakingContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveVirtualStakingContractResponse proto.InternalMessageInfo
+
+// MsgMigrateVirtualStakingContract moves the max cap limit, delegated total,
+// delegations, config and scheduled tasks of a virtual staking contract to a
+// new contract address. The delegated shares are transferred without
+// unbonding so that the validator set is not affected.
+type MsgMigrateVirtualStakingContract struct {
+	// Authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Contract is the address of the current virtual staking contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// NewContract is the address of the virtual staking contract that replaces
+	// the current one
+	NewContract string `protobuf:"bytes,3,opt,name=new_contract,json=newContract,proto3" json:"new_contract,omitempty"`
+}
+
+func (m *MsgMigrateVirtualStakingContract) Reset()         { *m = MsgMigrateVirtualStakingContract{} }
+func (m *MsgMigrateVirtualStakingContract) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateVirtualStakingContract) ProtoMessage()    {}
+func (*MsgMigrateVirtualStakingContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{23}
+}
+func (m *MsgMigrateVirtualStakingContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateVirtualStakingContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateVirtualStakingContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateVirtualStakingContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateVirtualStakingContract.Merge(m, src)
+}
+func (m *MsgMigrateVirtualStakingContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateVirtualStakingContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateVirtualStakingContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateVirtualStakingContract proto.InternalMessageInfo
+
+// MsgMigrateVirtualStakingContractResponse returns result data.
+type MsgMigrateVirtualStakingContractResponse struct {
+}
+
+func (m *MsgMigrateVirtualStakingContractResponse) Reset() {
+	*m = MsgMigrateVirtualStakingContractResponse{}
+}
+func (m *MsgMigrateVirtualStakingContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateVirtualStakingContractResponse) ProtoMessage()    {}
+func (*MsgMigrateVirtualStakingContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca993316ec9770c4, []int{24}
+}
+func (m *MsgMigrateVirtualStakingContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateVirtualStakingContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateVirtualStakingContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateVirtualStakingContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateVirtualStakingContractResponse.Merge(m, src)
+}
+func (m *MsgMigrateVirtualStakingContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateVirtualStakingContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateVirtualStakingContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateVirtualStakingContractResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetVirtualStakingMaxCap)(nil), "osmosis.meshsecurity.v1beta1.MsgSetVirtualStakingMaxCap")
+	proto.RegisterType((*MsgSetVirtualStakingMaxCapResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgSetVirtualStakingMaxCapResponse")
+	proto.RegisterType((*MsgSetVirtualStakingMaxCaps)(nil), "osmosis.meshsecurity.v1beta1.MsgSetVirtualStakingMaxCaps")
+	proto.RegisterType((*VirtualStakingMaxCap)(nil), "osmosis.meshsecurity.v1beta1.VirtualStakingMaxCap")
+	proto.RegisterType((*MsgSetVirtualStakingMaxCapsResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgSetVirtualStakingMaxCapsResponse")
+	proto.RegisterType((*MsgSetContractConfig)(nil), "osmosis.meshsecurity.v1beta1.MsgSetContractConfig")
+	proto.RegisterType((*MsgSetContractConfigResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgSetContractConfigResponse")
+	proto.RegisterType((*MsgScheduleTask)(nil), "osmosis.meshsecurity.v1beta1.MsgScheduleTask")
+	proto.RegisterType((*MsgScheduleTaskResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgScheduleTaskResponse")
+	proto.RegisterType((*MsgRescheduleTask)(nil), "osmosis.meshsecurity.v1beta1.MsgRescheduleTask")
+	proto.RegisterType((*MsgRescheduleTaskResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgRescheduleTaskResponse")
+	proto.RegisterType((*MsgPauseScheduledTasks)(nil), "osmosis.meshsecurity.v1beta1.MsgPauseScheduledTasks")
+	proto.RegisterType((*MsgPauseScheduledTasksResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgPauseScheduledTasksResponse")
+	proto.RegisterType((*MsgResumeScheduledTasks)(nil), "osmosis.meshsecurity.v1beta1.MsgResumeScheduledTasks")
+	proto.RegisterType((*MsgResumeScheduledTasksResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgResumeScheduledTasksResponse")
+	proto.RegisterType((*MsgDeleteScheduledTasks)(nil), "osmosis.meshsecurity.v1beta1.MsgDeleteScheduledTasks")
+	proto.RegisterType((*MsgDeleteScheduledTasksResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgDeleteScheduledTasksResponse")
+	proto.RegisterType((*MsgDeleteDeadLetterTasks)(nil), "osmosis.meshsecurity.v1beta1.MsgDeleteDeadLetterTasks")
+	proto.RegisterType((*MsgDeleteDeadLetterTasksResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgDeleteDeadLetterTasksResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "osmosis.meshsecurity.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRemoveVirtualStakingContract)(nil), "osmosis.meshsecurity.v1beta1.MsgRemoveVirtualStakingContract")
+	proto.RegisterType((*MsgRemoveVirtualStakingContractResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgRemoveVirtualStakingContractResponse")
+	proto.RegisterType((*MsgMigrateVirtualStakingContract)(nil), "osmosis.meshsecurity.v1beta1.MsgMigrateVirtualStakingContract")
+	proto.RegisterType((*MsgMigrateVirtualStakingContractResponse)(nil), "osmosis.meshsecurity.v1beta1.MsgMigrateVirtualStakingContractResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/meshsecurity/v1beta1/tx.proto", fileDescriptor_ca993316ec9770c4)
+}
+
+var fileDescriptor_ca993316ec9770c4 = []byte{
+	// 1089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x98, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0x33, 0x49, 0xea, 0xda, 0x4f, 0xda, 0xdf, 0x4f, 0x31, 0xa1, 0x71, 0x36, 0xee, 0xd6,
+	0xd9, 0x26, 0xaa, 0x89, 0x6a, 0xaf, 0x62, 0x9a, 0x36, 0x2c, 0xb4, 0x94, 0xa4, 0xbc, 0x08, 0x61,
+	0xa9, 0x72, 0x0b, 0x42, 0x08, 0x29, 0x1a, 0xdb, 0xc3, 0x7a, 0x15, 0xef, 0xae, 0xe5, 0x19, 0xe7,
+	0x85, 0x23, 0x9c, 0x40, 0x48, 0x70, 0x41, 0xe2, 0xc0, 0x11, 0xa9, 0x70, 0x8b, 0xc4, 0x5f, 0xc0,
+	0x2d, 0x08, 0x0e, 0x15, 0xe2, 0xc0, 0x09, 0x41, 0x72, 0xc8, 0xbf, 0x81, 0x76, 0xbd, 0x3b, 0xdd,
+	0xf5, 0xbe, 0xd9, 0x0e, 0x2d, 0x97, 0xc4, 0xfb, 0x3c, 0xcf, 0xf7, 0x99, 0xef, 0x67, 0x66, 0x77,
+	0x67, 0xb4, 0xb0, 0x62, 0x52, 0xdd, 0xa4, 0x1a, 0x95, 0x75, 0x42, 0x5b, 0x94, 0x34, 0x7a, 0x5d,
+	0x8d, 0x1d, 0xc8, 0xbb, 0x6b, 0x75, 0xc2, 0xf0, 0x9a, 0xcc, 0xf6, 0xcb, 0x9d, 0xae, 0xc9, 0xcc,
+	0x6c, 0xde, 0x29, 0x2b, 0x7b, 0xcb, 0xca, 0x4e, 0x99, 0x20, 0x36, 0xec, 0xb4, 0x5c, 0xc7, 0x94,
+	0x70, 0x6d, 0xc3, 0xd4, 0x8c, 0xbe, 0x5a, 0x98, 0x77, 0xf2, 0x3a, 0x55, 0xe5, 0xdd, 0x35, 0xeb,
+	0x9f, 0x93, 0x98, 0x53, 0x4d, 0xd5, 0xb4, 0x7f, 0xca, 0xd6, 0x2f, 0x27, 0x3a, 0x8b, 0x75, 0xcd,
+	0x30, 0x65, 0xfb, 0xaf, 0x13, 0x92, 0x63, 0x6d, 0xfa, 0x4c, 0xd9, 0x02, 0xe9, 0x57, 0x04, 0x42,
+	0x95, 0xaa, 0x0f, 0x08, 0x7b, 0x4f, 0xeb, 0xb2, 0x1e, 0x6e, 0x3f, 0x60, 0x78, 0x47, 0x33, 0xd4,
+	0x2a, 0xde, 0xdf, 0xc2, 0x9d, 0x6c, 0x1e, 0x32, 0xb8, 0xc7, 0x5a, 0xa6, 0xa5, 0xc8, 0xa1, 0x02,
+	0x2a, 0x66, 0x6a, 0x4f, 0x02, 0x59, 0x01, 0xd2, 0x0d, 0xd3, 0x60, 0x5d, 0xdc, 0x60, 0xb9, 0x49,
+	0x3b, 0xc9, 0xaf, 0xb3, 0x1b, 0x70, 0x5e, 0xc7, 0xfb, 0xdb, 0x0d, 0xdc, 0xc9, 0x4d, 0x15, 0x50,
+	0x71, 0xa6, 0xb2, 0x50, 0xee, 0xd3, 0x95, 0x2d, 0x7a, 0x77, 0x4a, 0xca, 0x5b, 0xa6, 0x66, 0x6c,
+	0x4e, 0x1f, 0xfd, 0x79, 0x65, 0xa2, 0x96, 0xd2, 0xed, 0x31, 0x15, 0xe5, 0x93, 0xd3, 0xc3, 0xd5,
+	0x27, 0xa3, 0x7c, 0x7e, 0x7a, 0xb8, 0x7a, 0xcd, 0x87, 0x13, 0xed, 0x57, 0x5a, 0x06, 0x29, 0x3a,
+	0x5b, 0x23, 0xb4, 0x63, 0x1a, 0x94, 0x48, 0xbf, 0x21, 0x58, 0x8c, 0x2e, 0xa3, 0x09, 0xd4, 0xef,
+	0x43, 0xda, 0x21, 0xa3, 0xb9, 0xc9, 0xc2, 0x54, 0x71, 0xa6, 0x52, 0x29, 0xc7, 0x2d, 0x7b, 0x39,
+	0x6c, 0x90, 0xcd, 0x8c, 0xc5, 0xfc, 0xfd, 0xe9, 0xe1, 0x2a, 0xaa, 0x9d, 0xef, 0x83, 0x53, 0xe5,
+	0xe5, 0x20, 0x79, 0x71, 0x48, 0x72, 0x2a, 0xb5, 0x61, 0x2e, 0x74, 0x09, 0xbd, 0x8b, 0x84, 0xa2,
+	0x17, 0x69, 0x72, 0xa4, 0x45, 0x92, 0x56, 0xe0, 0x6a, 0x8c, 0x19, 0xef, 0x4c, 0xcf, 0xf5, 0xeb,
+	0xb6, 0x9c, 0x31, 0xb7, 0x4c, 0xe3, 0x23, 0x4d, 0x3d, 0xc3, 0x8d, 0xf5, 0x36, 0xa4, 0x1a, 0x76,
+	0x0f, 0xe7, 0xbe, 0xba, 0x1e, 0x3f, 0xf9, 0xfe, 0x71, 0x5d, 0x8a, 0x7e, 0x07, 0xe5, 0x46, 0x70,
+	0xc2, 0x97, 0x42, 0x26, 0xdc, 0xdf, 0x43, 0x12, 0x21, 0x1f, 0x16, 0xe7, 0xd0, 0x3f, 0x23, 0xf8,
+	0xbf, 0x55, 0xd0, 0x68, 0x91, 0x66, 0xaf, 0x4d, 0x1e, 0x62, 0xba, 0x73, 0x06, 0xde, 0x45, 0xc8,
+	0x30, 0x4c, 0x77, 0xb6, 0xd9, 0x41, 0x87, 0xd8, 0xc8, 0x17, 0x6b, 0x69, 0x2b, 0xf0, 0xf0, 0xa0,
+	0x43, 0xb2, 0x97, 0x20, 0xd5, 0x22, 0x9a, 0xda, 0x62, 0xb9, 0xe9, 0x02, 0x2a, 0x4e, 0xd7, 0x9c,
+	0x2b, 0x2b, 0xde, 0x25, 0x1d, 0x82, 0x59, 0xee, 0x5c, 0x01, 0x15, 0xd3, 0x35, 0xe7, 0x4a, 0x91,
+	0x83, 0xc0, 0xf9, 0x00, 0xb0, 0xc7, 0xb7, 0xb4, 0x00, 0xf3, 0x03, 0x21, 0x8e, 0xf9, 0x3b, 0x82,
+	0xd9, 0x2a, 0xb5, 0xb0, 0xff, 0x43, 0xd0, 0xcb, 0x00, 0x06, 0xd9, 0xdb, 0x76, 0x72, 0xe7, 0xec,
+	0x5c, 0xc6, 0x20, 0x7b, 0x6f, 0xd9, 0x01, 0x65, 0x2d, 0xc8, 0x2b, 0x0e, 0xf2, 0xfa, 0x01, 0xa4,
+	0x45, 0x58, 0x08, 0x04, 0x39, 0xf3, 0x23, 0x04, 0x97, 0xaa, 0x54, 0xbd, 0x8f, 0x7b, 0x94, 0xb8,
+	0x93, 0xd2, 0xb4, 0x2a, 0xe8, 0x53, 0x02, 0x57, 0x6e, 0x06, 0x09, 0xae, 0x0e, 0x12, 0x84, 0xd8,
+	0x91, 0x0a, 0x20, 0x86, 0x67, 0x38, 0xcb, 0x0f, 0xc8, 0x5e, 0xdb, 0x1a, 0xa1, 0x3d, 0xfd, 0x19,
+	0xc1, 0xdc, 0x0a, 0xc2, 0x2c, 0x87, 0x2c, 0x47, 0xc0, 0x8f, 0xb4, 0x04, 0x57, 0x22, 0x52, 0x1c,
+	0xe7, 0xa7, 0x3e, 0xce, 0x3d, 0xd2, 0x26, 0xec, 0xd9, 0xe0, 0x44, 0xdd, 0x94, 0x43, 0x61, 0x86,
+	0xf9, 0x74, 0x30, 0xc3, 0x52, 0x1c, 0xf3, 0x17, 0x04, 0x39, 0x5e, 0x73, 0x8f, 0xe0, 0xe6, 0x3b,
+	0x84, 0x31, 0xd2, 0x7d, 0xaa, 0x9c, 0x22, 0x40, 0x97, 0x3f, 0x0f, 0x36, 0x6b, 0xba, 0xe6, 0x89,
+	0x28, 0x1b, 0x41, 0xde, 0x95, 0x70, 0xde, 0x01, 0xc3, 0x92, 0x04, 0x85, 0xa8, 0x9c, 0xf7, 0x3e,
+	0xb5, 0x5e, 0xa7, 0xef, 0x76, 0x9a, 0x98, 0x91, 0xfb, 0xb8, 0x8b, 0xf5, 0x24, 0xd0, 0x37, 0x21,
+	0xd5, 0xb1, 0xeb, 0x9c, 0x5d, 0x6d, 0x39, 0x7e, 0x8b, 0xe8, 0xf7, 0xf4, 0xee, 0xc8, 0x8e, 0x7c,
+	0xa8, 0xd7, 0xa5, 0xd7, 0x97, 0xf3, 0xba, 0xf4, 0x86, 0x38, 0xc6, 0xb7, 0xc8, 0xb9, 0x87, 0x75,
+	0x73, 0x97, 0xf8, 0x77, 0x4d, 0x77, 0x1f, 0x19, 0x7f, 0xfd, 0x94, 0x57, 0x83, 0x4e, 0xaf, 0x07,
+	0x9f, 0xac, 0xe8, 0xa1, 0xa5, 0x17, 0xe0, 0x5a, 0x42, 0x89, 0xf7, 0x49, 0xb3, 0x56, 0xad, 0xaa,
+	0xa9, 0x5d, 0xcc, 0xfe, 0x75, 0x94, 0xec, 0x12, 0x5c, 0xb0, 0x5e, 0xe9, 0x3c, 0x3f, 0x65, 0xe7,
+	0x67, 0x0c, 0xb2, 0xe7, 0x36, 0x57, 0xee, 0x06, 0x69, 0x4b, 0x83, 0xb4, 0xb1, 0xf6, 0xa4, 0x55,
+	0x28, 0x26, 0xd5, 0xb8, 0xbc, 0x95, 0x1f, 0x2f, 0xc2, 0x54, 0x95, 0xaa, 0xd9, 0xaf, 0x11, 0xcc,
+	0x47, 0x1d, 0x94, 0x37, 0xe2, 0x6f, 0xb1, 0xe8, 0xb3, 0x92, 0x70, 0x77, 0x5c, 0xa5, 0xeb, 0x2f,
+	0xfb, 0x0d, 0x82, 0x5c, 0xe4, 0x59, 0xf6, 0xa5, 0x71, 0xdb, 0x53, 0xe1, 0xb5, 0xb1, 0xa5, 0xdc,
+	0xda, 0xa7, 0x08, 0x66, 0x83, 0x87, 0xbf, 0xca, 0x30, 0x8d, 0xfd, 0x1a, 0x41, 0x19, 0x5d, 0xc3,
+	0x5d, 0x30, 0xb8, 0xe0, 0x3b, 0x8c, 0x95, 0x92, 0x7b, 0x79, 0xca, 0x85, 0xf5, 0x91, 0xca, 0xf9,
+	0xa8, 0x1f, 0xc3, 0xff, 0x06, 0xce, 0x46, 0x72, 0x62, 0x23, 0xbf, 0x40, 0xb8, 0x35, 0xa2, 0x80,
+	0x8f, 0xfd, 0x19, 0x82, 0xe7, 0xc2, 0x0e, 0x29, 0x37, 0x12, 0x1b, 0x86, 0xa8, 0x84, 0x57, 0xc6,
+	0x51, 0x71, 0x2f, 0x5f, 0x20, 0x98, 0x0b, 0x3d, 0x64, 0xac, 0x0f, 0x43, 0x17, 0x90, 0x09, 0xb7,
+	0xc7, 0x92, 0xf9, 0xec, 0x84, 0x1e, 0x12, 0x92, 0xed, 0x84, 0xc9, 0x84, 0xdb, 0x63, 0xc9, 0xb8,
+	0x9d, 0x2f, 0x11, 0x3c, 0x1f, 0xbe, 0x99, 0xdf, 0x1c, 0xb2, 0xf1, 0x80, 0x4e, 0xb8, 0x33, 0x9e,
+	0xce, 0xfb, 0xb4, 0xf8, 0xf6, 0xda, 0xe4, 0xa7, 0xc5, 0x5b, 0x2e, 0xac, 0x8f, 0x54, 0xce, 0x47,
+	0xfd, 0x0e, 0x41, 0x3e, 0x76, 0x6f, 0x1c, 0x66, 0xd9, 0xa3, 0xe5, 0xc2, 0xeb, 0x67, 0x92, 0x73,
+	0x9b, 0x8f, 0x10, 0x5c, 0x8e, 0xdf, 0xf8, 0x92, 0xa7, 0x3f, 0x56, 0x2f, 0xbc, 0x71, 0x36, 0xbd,
+	0xeb, 0x74, 0xf3, 0xc3, 0xa3, 0xbf, 0xc5, 0x89, 0xa3, 0x63, 0x11, 0x3d, 0x3e, 0x16, 0xd1, 0x5f,
+	0xc7, 0x22, 0xfa, 0xea, 0x44, 0x9c, 0x78, 0x7c, 0x22, 0x4e, 0xfc, 0x71, 0x22, 0x4e, 0x7c, 0x70,
+	0x47, 0xd5, 0x58, 0xab, 0x57, 0x2f, 0x37, 0x4c, 0xdd, 0xfd, 0x66, 0x54, 0x6a, 0xe3, 0x7a, 0xff,
+	0xc3, 0x51, 0xc9, 0x1d, 0xb5, 0x44, 0x9b, 0x3b, 0xf2, 0xbe, 0xff, 0x63, 0x92, 0x75, 0x42, 0xa4,
+	0xf5, 0x94, 0xfd, 0xf9, 0xe8, 0xc5, 0x7f, 0x06, 0x00, 0x0c, 0x7e, 0xbe, 0x76, 0x18, 0x13, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetVirtualStakingMaxCap creates or updates a maximum cap limit for virtual
+	// staking coins
+	SetVirtualStakingMaxCap(ctx context.Context, in *MsgSetVirtualStakingMaxCap, opts ...grpc.CallOption) (*MsgSetVirtualStakingMaxCapResponse, error)
+	// SetVirtualStakingMaxCaps creates or updates the maximum cap limits of
+	// multiple contracts at once
+	SetVirtualStakingMaxCaps(ctx context.Context, in *MsgSetVirtualStakingMaxCaps, opts ...grpc.CallOption) (*MsgSetVirtualStakingMaxCapsResponse, error)
+	// SetContractConfig sets the contract specific overrides of the module
+	// params
+	SetContractConfig(ctx context.Context, in *MsgSetContractConfig, opts ...grpc.CallOption) (*MsgSetContractConfigResponse, error)
+	// ScheduleTask schedules a new task for a virtual staking contract
+	ScheduleTask(ctx context.Context, in *MsgScheduleTask, opts ...grpc.CallOption) (*MsgScheduleTaskResponse, error)
+	// RescheduleTask moves a scheduled task of a virtual staking contract to a
+	// new height
+	RescheduleTask(ctx context.Context, in *MsgRescheduleTask, opts ...grpc.CallOption) (*MsgRescheduleTaskResponse, error)
+	// PauseScheduledTasks pauses the execution of the scheduled tasks of a
+	// virtual staking contract
+	PauseScheduledTasks(ctx context.Context, in *MsgPauseScheduledTasks, opts ...grpc.CallOption) (*MsgPauseScheduledTasksResponse, error)
+	// ResumeScheduledTasks resumes the execution of paused tasks of a virtual
+	// staking contract
+	ResumeScheduledTasks(ctx context.Context, in *MsgResumeScheduledTasks, opts ...grpc.CallOption) (*MsgResumeScheduledTasksResponse, error)
+	// DeleteScheduledTasks deletes scheduled tasks of a virtual staking contract
+	DeleteScheduledTasks(ctx context.Context, in *MsgDeleteScheduledTasks, opts ...grpc.CallOption) (*MsgDeleteScheduledTasksResponse, error)
+	// DeleteDeadLetterTasks deletes the dead letter tasks of a virtual staking
+	// contract and optionally schedules the task again
+	DeleteDeadLetterTasks(ctx context.Context, in *MsgDeleteDeadLetterTasks, opts ...grpc.CallOption) (*MsgDeleteDeadLetterTasksResponse, error)
+	// UpdateParams updates the module params
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RemoveVirtualStakingContract unbonds all virtual stake of a contract and
+	// removes the contract from the module state
+	RemoveVirtualStakingContract(ctx context.Context, in *MsgRemoveVirtualStakingContract, opts ...grpc.CallOption) (*MsgRemoveVirtualStakingContractResponse, error)
+	// MigrateVirtualStakingContract moves the virtual staking accounting of a
+	// contract to a new contract address
+	MigrateVirtualStakingContract(ctx context.Context, in *MsgMigrateVirtualStakingContract, opts ...grpc.CallOption) (*MsgMigrateVirtualStakingContractResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetVirtualStakingMaxCap(ctx context.Context, in *MsgSetVirtualStakingMaxCap, opts ...grpc.CallOption) (*MsgSetVirtualStakingMaxCapResponse, error) {
+	out := new(MsgSetVirtualStakingMaxCapResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/SetVirtualStakingMaxCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetVirtualStakingMaxCaps(ctx context.Context, in *MsgSetVirtualStakingMaxCaps, opts ...grpc.CallOption) (*MsgSetVirtualStakingMaxCapsResponse, error) {
+	out := new(MsgSetVirtualStakingMaxCapsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/SetVirtualStakingMaxCaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetContractConfig(ctx context.Context, in *MsgSetContractConfig, opts ...grpc.CallOption) (*MsgSetContractConfigResponse, error) {
+	out := new(MsgSetContractConfigResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/SetContractConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleTask(ctx context.Context, in *MsgScheduleTask, opts ...grpc.CallOption) (*MsgScheduleTaskResponse, error) {
+	out := new(MsgScheduleTaskResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/ScheduleTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RescheduleTask(ctx context.Context, in *MsgRescheduleTask, opts ...grpc.CallOption) (*MsgRescheduleTaskResponse, error) {
+	out := new(MsgRescheduleTaskResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/RescheduleTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseScheduledTasks(ctx context.Context, in *MsgPauseScheduledTasks, opts ...grpc.CallOption) (*MsgPauseScheduledTasksResponse, error) {
+	out := new(MsgPauseScheduledTasksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/PauseScheduledTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeScheduledTasks(ctx context.Context, in *MsgResumeScheduledTasks, opts ...grpc.CallOption) (*MsgResumeScheduledTasksResponse, error) {
+	out := new(MsgResumeScheduledTasksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/ResumeScheduledTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteScheduledTasks(ctx context.Context, in *MsgDeleteScheduledTasks, opts ...grpc.CallOption) (*MsgDeleteScheduledTasksResponse, error) {
+	out := new(MsgDeleteScheduledTasksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/DeleteScheduledTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteDeadLetterTasks(ctx context.Context, in *MsgDeleteDeadLetterTasks, opts ...grpc.CallOption) (*MsgDeleteDeadLetterTasksResponse, error) {
+	out := new(MsgDeleteDeadLetterTasksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/DeleteDeadLetterTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveVirtualStakingContract(ctx context.Context, in *MsgRemoveVirtualStakingContract, opts ...grpc.CallOption) (*MsgRemoveVirtualStakingContractResponse, error) {
+	out := new(MsgRemoveVirtualStakingContractResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/RemoveVirtualStakingContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateVirtualStakingContract(ctx context.Context, in *MsgMigrateVirtualStakingContract, opts ...grpc.CallOption) (*MsgMigrateVirtualStakingContractResponse, error) {
+	out := new(MsgMigrateVirtualStakingContractResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurity.v1beta1.Msg/MigrateVirtualStakingContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetVirtualStakingMaxCap creates or updates a maximum cap limit for virtual
+	// staking coins
+	SetVirtualStakingMaxCap(context.Context, *MsgSetVirtualStakingMaxCap) (*MsgSetVirtualStakingMaxCapResponse, error)
+	// SetVirtualStakingMaxCaps creates or updates the maximum cap limits of
+	// multiple contracts at once
+	SetVirtualStakingMaxCaps(context.Context, *MsgSetVirtualStakingMaxCaps) (*MsgSetVirtualStakingMaxCapsResponse, error)
+	// SetContractConfig sets the contract specific overrides of the module
+	// params
+	SetContractConfig(context.Context, *MsgSetContractConfig) (*MsgSetContractConfigResponse, error)
+	// ScheduleTask schedules a new task for a virtual staking contract
+	ScheduleTask(context.Context, *MsgScheduleTask) (*MsgScheduleTaskResponse, error)
+	// RescheduleTask moves a scheduled task of a virtual staking contract to a
+	// new height
+	RescheduleTask(context.Context, *MsgRescheduleTask) (*MsgRescheduleTaskResponse, error)
+	// PauseScheduledTasks pauses the execution of the scheduled tasks of a
+	// virtual staking contract
+	PauseScheduledTasks(context.Context, *MsgPauseScheduledTasks) (*MsgPauseScheduledTasksResponse, error)
+	// ResumeScheduledTasks resumes the execution of paused tasks of a virtual
+	// staking contract
+	ResumeScheduledTasks(context.Context, *MsgResumeScheduledTasks) (*MsgResumeScheduledTasksResponse, error)
+	// DeleteScheduledTasks deletes scheduled tasks of a virtual staking contract
+	DeleteScheduledTasks(context.Context, *MsgDeleteScheduledTasks) (*MsgDeleteScheduledTasksResponse, error)
+	// DeleteDeadLetterTasks deletes the dead letter tasks of a virtual staking
+	// contract and optionally schedules the task again
+	DeleteDeadLetterTasks(context.Context, *MsgDeleteDeadLetterTasks) (*MsgDeleteDeadLetterTasksResponse, error)
+	// UpdateParams updates the module params
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RemoveVirtualStakingContract unbonds all virtual stake of a contract and
+	// removes the contract from the module state
+	RemoveVirtualStakingContract(context.Context, *MsgRemoveVirtualStakingContract) (*MsgRemoveVirtualStakingContractResponse, error)
+	// MigrateVirtualStakingContract moves the virtual staking accounting of a
+	// contract to a new contract address
+	MigrateVirtualStakingContract(context.Context, *MsgMigrateVirtualStakingContract) (*MsgMigrateVirtualStakingContractResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetVirtualStakingMaxCap(ctx context.Context, req *MsgSetVirtualStakingMaxCap) (*MsgSetVirtualStakingMaxCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVirtualStakingMaxCap not implemented")
+}
+func (*UnimplementedMsgServer) SetVirtualStakingMaxCaps(ctx context.Context, req *MsgSetVirtualStakingMaxCaps) (*MsgSetVirtualStakingMaxCapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVirtualStakingMaxCaps not implemented")
+}
+func (*UnimplementedMsgServer) SetContractConfig(ctx context.Context, req *MsgSetContractConfig) (*MsgSetContractConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractConfig not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleTask(ctx context.Context, req *MsgScheduleTask) (*MsgScheduleTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTask not implemented")
+}
+func (*UnimplementedMsgServer) RescheduleTask(ctx context.Context, req *MsgRescheduleTask) (*MsgRescheduleTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleTask not implemented")
+}
+func (*UnimplementedMsgServer) PauseScheduledTasks(ctx context.Context, req *MsgPauseScheduledTasks) (*MsgPauseScheduledTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseScheduledTasks not implemented")
+}
+func (*UnimplementedMsgServer) ResumeScheduledTasks(ctx context.Context, req *MsgResumeScheduledTasks) (*MsgResumeScheduledTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeScheduledTasks not implemented")
+}
+func (*UnimplementedMsgServer) DeleteScheduledTasks(ctx context.Context, req *MsgDeleteScheduledTasks) (*MsgDeleteScheduledTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduledTasks not implemented")
+}
+func (*UnimplementedMsgServer) DeleteDeadLetterTasks(ctx context.Context, req *MsgDeleteDeadLetterTasks) (*MsgDeleteDeadLetterTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeadLetterTasks not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RemoveVirtualStakingContract(ctx context.Context, req *MsgRemoveVirtualStakingContract) (*MsgRemoveVirtualStakingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVirtualStakingContract not implemented")
+}
+func (*UnimplementedMsgServer) MigrateVirtualStakingContract(ctx context.Context, req *MsgMigrateVirtualStakingContract) (*MsgMigrateVirtualStakingContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateVirtualStakingContract not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetVirtualStakingMaxCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVirtualStakingMaxCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVirtualStakingMaxCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/SetVirtualStakingMaxCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVirtualStakingMaxCap(ctx, req.(*MsgSetVirtualStakingMaxCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetVirtualStakingMaxCaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVirtualStakingMaxCaps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVirtualStakingMaxCaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/SetVirtualStakingMaxCaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVirtualStakingMaxCaps(ctx, req.(*MsgSetVirtualStakingMaxCaps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetContractConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/SetContractConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractConfig(ctx, req.(*MsgSetContractConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/ScheduleTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleTask(ctx, req.(*MsgScheduleTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RescheduleTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRescheduleTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RescheduleTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/RescheduleTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RescheduleTask(ctx, req.(*MsgRescheduleTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseScheduledTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseScheduledTasks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseScheduledTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/PauseScheduledTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseScheduledTasks(ctx, req.(*MsgPauseScheduledTasks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeScheduledTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeScheduledTasks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeScheduledTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/ResumeScheduledTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeScheduledTasks(ctx, req.(*MsgResumeScheduledTasks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteScheduledTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteScheduledTasks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteScheduledTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/DeleteScheduledTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteScheduledTasks(ctx, req.(*MsgDeleteScheduledTasks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteDeadLetterTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteDeadLetterTasks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteDeadLetterTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/DeleteDeadLetterTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteDeadLetterTasks(ctx, req.(*MsgDeleteDeadLetterTasks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveVirtualStakingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveVirtualStakingContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveVirtualStakingContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/RemoveVirtualStakingContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveVirtualStakingContract(ctx, req.(*MsgRemoveVirtualStakingContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateVirtualStakingContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateVirtualStakingContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateVirtualStakingContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurity.v1beta1.Msg/MigrateVirtualStakingContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateVirtualStakingContract(ctx, req.(*MsgMigrateVirtualStakingContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.meshsecurity.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetVirtualStakingMaxCap",
+			Handler:    _Msg_SetVirtualStakingMaxCap_Handler,
+		},
+		{
+			MethodName: "SetVirtualStakingMaxCaps",
+			Handler:    _Msg_SetVirtualStakingMaxCaps_Handler,
+		},
+		{
+			MethodName: "SetContractConfig",
+			Handler:    _Msg_SetContractConfig_Handler,
+		},
+		{
+			MethodName: "ScheduleTask",
+			Handler:    _Msg_ScheduleTask_Handler,
+		},
+		{
+			MethodName: "RescheduleTask",
+			Handler:    _Msg_RescheduleTask_Handler,
+		},
+		{
+			MethodName: "PauseScheduledTasks",
+			Handler:    _Msg_PauseScheduledTasks_Handler,
+		},
+		{
+			MethodName: "ResumeScheduledTasks",
+			Handler:    _Msg_ResumeScheduledTasks_Handler,
+		},
+		{
+			MethodName: "DeleteScheduledTasks",
+			Handler:    _Msg_DeleteScheduledTasks_Handler,
+		},
+		{
+			MethodName: "DeleteDeadLetterTasks",
+			Handler:    _Msg_DeleteDeadLetterTasks_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RemoveVirtualStakingContract",
+			Handler:    _Msg_RemoveVirtualStakingContract_Handler,
+		},
+		{
+			MethodName: "MigrateVirtualStakingContract",
+			Handler:    _Msg_MigrateVirtualStakingContract_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/meshsecurity/v1beta1/tx.proto",
+}
+
+func (m *MsgSetVirtualStakingMaxCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVirtualStakingMaxCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVirtualStakingMaxCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxCap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVirtualStakingMaxCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVirtualStakingMaxCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVirtualStakingMaxCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVirtualStakingMaxCaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVirtualStakingMaxCaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVirtualStakingMaxCaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxCaps) > 0 {
+		for iNdEx := len(m.MaxCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VirtualStakingMaxCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VirtualStakingMaxCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VirtualStakingMaxCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MaxCap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVirtualStakingMaxCapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVirtualStakingMaxCapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVirtualStakingMaxCapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Repeat {
+		i--
+		if m.Repeat {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescheduleTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescheduleTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescheduleTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescheduleTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescheduleTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescheduleTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseScheduledTasks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseScheduledTasks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseScheduledTasks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseScheduledTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseScheduledTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseScheduledTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeScheduledTasks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeScheduledTasks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeScheduledTasks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeScheduledTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeScheduledTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeScheduledTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteScheduledTasks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteScheduledTasks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteScheduledTasks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteScheduledTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteScheduledTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteScheduledTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteDeadLetterTasks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteDeadLetterTasks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteDeadLetterTasks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reschedule {
+		i--
+		if m.Reschedule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteDeadLetterTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteDeadLetterTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteDeadLetterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveVirtualStakingContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveVirtualStakingContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveVirtualStakingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveVirtualStakingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveVirtualStakingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveVirtualStakingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateVirtualStakingContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateVirtualStakingContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateVirtualStakingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewContract) > 0 {
+		i -= len(m.NewContract)
+		copy(dAtA[i:], m.NewContract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateVirtualStakingContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateVirtualStakingContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateVirtualStakingContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetVirtualStakingMaxCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetVirtualStakingMaxCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetVirtualStakingMaxCaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MaxCaps) > 0 {
+		for _, e := range m.MaxCaps {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VirtualStakingMaxCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxCap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetVirtualStakingMaxCapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetContractConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetContractConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovTx(uint64(m.TaskType))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Repeat {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgScheduleTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRescheduleTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovTx(uint64(m.TaskType))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.NewHeight != 0 {
+		n += 1 + sovTx(uint64(m.NewHeight))
+	}
+	return n
+}
+
+func (m *MsgRescheduleTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseScheduledTasks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovTx(uint64(m.TaskType))
+	}
+	return n
+}
+
+func (m *MsgPauseScheduledTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeScheduledTasks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovTx(uint64(m.TaskType))
+	}
+	return n
+}
+
+func (m *MsgResumeScheduledTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteScheduledTasks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovTx(uint64(m.TaskType))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgDeleteScheduledTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteDeadLetterTasks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovTx(uint64(m.TaskType))
+	}
+	if m.Reschedule {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDeleteDeadLetterTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveVirtualStakingContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveVirtualStakingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateVirtualStakingContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewContract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMigrateVirtualStakingContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetVirtualStakingMaxCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVirtualStakingMaxCapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVirtualStakingMaxCaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxCaps = append(m.MaxCaps, VirtualStakingMaxCap{})
+			if err := m.MaxCaps[len(m.MaxCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VirtualStakingMaxCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VirtualStakingMaxCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VirtualStakingMaxCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVirtualStakingMaxCapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetContractConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetContractConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repeat", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Repeat = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRescheduleTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRescheduleTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRescheduleTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeight", wireType)
+			}
+			m.NewHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRescheduleTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRescheduleTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRescheduleTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseScheduledTasks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseScheduledTasks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseScheduledTasks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseScheduledTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseScheduledTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseScheduledTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeScheduledTasks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeScheduledTasks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeScheduledTasks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeScheduledTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeScheduledTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeScheduledTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScheduledTasks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScheduledTasks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScheduledTasks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteScheduledTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteScheduledTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteScheduledTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteDeadLetterTasks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteDeadLetterTasks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteDeadLetterTasks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reschedule", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reschedule = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteDeadLetterTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteDeadLetterTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteDeadLetterTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *MsgSetVirtualStakingMaxCapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetVirtualStakingMaxCapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetVirtualStakingMaxCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return nil
 }
+func (m *MsgRemoveVirtualStakingContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveVirtualStakingContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveVirtualStakingContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgSetVirtualStakingMaxCap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRemoveVirtualStakingContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveVirtualStakingContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveVirtualStakingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.MaxCap.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgSetVirtualStakingMaxCapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetVirtualStakingMaxCap) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateVirtualStakingContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -373,10 +5192,10 @@ func (m *MsgSetVirtualStakingMaxCap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateVirtualStakingContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateVirtualStakingContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -445,9 +5264,9 @@ func (m *MsgSetVirtualStakingMaxCap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -457,24 +5276,23 @@ func (m *MsgSetVirtualStakingMaxCap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -497,7 +5315,7 @@ func (m *MsgSetVirtualStakingMaxCap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetVirtualStakingMaxCapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateVirtualStakingContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -520,10 +5338,10 @@ func (m *MsgSetVirtualStakingMaxCapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateVirtualStakingContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetVirtualStakingMaxCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateVirtualStakingContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -86,3 +86,306 @@ func TestValidateMsgSetVirtualStakingMaxCap(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMsgSetVirtualStakingMaxCaps(t *testing.T) {
+	var (
+		validAddr       = sdk.AccAddress(rand.Bytes(20)).String()
+		validContrAddr  = sdk.AccAddress(rand.Bytes(32)).String()
+		otherContrAddr  = sdk.AccAddress(rand.Bytes(32)).String()
+		validCoin       = sdk.NewInt64Coin("ALX", 1)
+		validMaxCapsSrc = func(mutators ...func(*MsgSetVirtualStakingMaxCaps)) MsgSetVirtualStakingMaxCaps {
+			r := MsgSetVirtualStakingMaxCaps{
+				Authority: validAddr,
+				MaxCaps: []VirtualStakingMaxCap{
+					{Contract: validContrAddr, MaxCap: validCoin},
+					{Contract: otherContrAddr, MaxCap: sdk.NewInt64Coin("ALX", 0)},
+				},
+			}
+			for _, m := range mutators {
+				m(&r)
+			}
+			return r
+		}
+	)
+	specs := map[string]struct {
+		src    MsgSetVirtualStakingMaxCaps
+		expErr bool
+	}{
+		"all valid": {
+			src: validMaxCapsSrc(),
+		},
+		"empty authority": {
+			src:    validMaxCapsSrc(func(m *MsgSetVirtualStakingMaxCaps) { m.Authority = "" }),
+			expErr: true,
+		},
+		"invalid authority addr": {
+			src:    validMaxCapsSrc(func(m *MsgSetVirtualStakingMaxCaps) { m.Authority = "invalid-addr" }),
+			expErr: true,
+		},
+		"empty max caps": {
+			src:    validMaxCapsSrc(func(m *MsgSetVirtualStakingMaxCaps) { m.MaxCaps = nil }),
+			expErr: true,
+		},
+		"invalid contract addr": {
+			src:    validMaxCapsSrc(func(m *MsgSetVirtualStakingMaxCaps) { m.MaxCaps[1].Contract = "invalid-addr" }),
+			expErr: true,
+		},
+		"duplicate contract": {
+			src:    validMaxCapsSrc(func(m *MsgSetVirtualStakingMaxCaps) { m.MaxCaps[1].Contract = validContrAddr }),
+			expErr: true,
+		},
+		"empty cap": {
+			src:    validMaxCapsSrc(func(m *MsgSetVirtualStakingMaxCaps) { m.MaxCaps[1].MaxCap = sdk.Coin{} }),
+			expErr: true,
+		},
+		"invalid cap coin": {
+			src:    validMaxCapsSrc(func(m *MsgSetVirtualStakingMaxCaps) { m.MaxCaps[0].MaxCap = sdk.Coin{Amount: math.NewInt(1)} }),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			assert.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestValidateMsgScheduleTask(t *testing.T) {
+	var (
+		validAddr      = sdk.AccAddress(rand.Bytes(20)).String()
+		validContrAddr = sdk.AccAddress(rand.Bytes(32)).String()
+	)
+	specs := map[string]struct {
+		src    MsgScheduleTask
+		expErr bool
+	}{
+		"all valid": {
+			src: MsgScheduleTask{Authority: validAddr, Contract: validContrAddr, TaskType: SchedulerTaskHandleEpoch, Height: 1, Repeat: true},
+		},
+		"empty height": {
+			src: MsgScheduleTask{Authority: validAddr, Contract: validContrAddr, TaskType: SchedulerTaskValsetUpdate},
+		},
+		"undefined type": {
+			src:    MsgScheduleTask{Authority: validAddr, Contract: validContrAddr},
+			expErr: true,
+		},
+		"unknown type": {
+			src:    MsgScheduleTask{Authority: validAddr, Contract: validContrAddr, TaskType: 0xff},
+			expErr: true,
+		},
+		"invalid authority addr": {
+			src:    MsgScheduleTask{Authority: "invalid-addr", Contract: validContrAddr, TaskType: SchedulerTaskHandleEpoch},
+			expErr: true,
+		},
+		"invalid contract addr": {
+			src:    MsgScheduleTask{Authority: validAddr, Contract: "invalid-addr", TaskType: SchedulerTaskHandleEpoch},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMsgRescheduleTask(t *testing.T) {
+	var (
+		validAddr      = sdk.AccAddress(rand.Bytes(20)).String()
+		validContrAddr = sdk.AccAddress(rand.Bytes(32)).String()
+	)
+	specs := map[string]struct {
+		src    MsgRescheduleTask
+		expErr bool
+	}{
+		"all valid": {
+			src: MsgRescheduleTask{Authority: validAddr, Contract: validContrAddr, TaskType: SchedulerTaskHandleEpoch, Height: 1, NewHeight: 2},
+		},
+		"empty new height": {
+			src:    MsgRescheduleTask{Authority: validAddr, Contract: validContrAddr, TaskType: SchedulerTaskHandleEpoch, Height: 1},
+			expErr: true,
+		},
+		"undefined type": {
+			src:    MsgRescheduleTask{Authority: validAddr, Contract: validContrAddr, Height: 1, NewHeight: 2},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMsgDeleteDeadLetterTasks(t *testing.T) {
+	var (
+		validAddr      = sdk.AccAddress(rand.Bytes(20)).String()
+		validContrAddr = sdk.AccAddress(rand.Bytes(32)).String()
+	)
+	specs := map[string]struct {
+		src    MsgDeleteDeadLetterTasks
+		expErr bool
+	}{
+		"all valid": {
+			src: MsgDeleteDeadLetterTasks{Authority: validAddr, Contract: validContrAddr, TaskType: SchedulerTaskValsetUpdate, Reschedule: true},
+		},
+		"undefined type": {
+			src:    MsgDeleteDeadLetterTasks{Authority: validAddr, Contract: validContrAddr},
+			expErr: true,
+		},
+		"invalid authority addr": {
+			src:    MsgDeleteDeadLetterTasks{Authority: "invalid-addr", Contract: validContrAddr, TaskType: SchedulerTaskValsetUpdate},
+			expErr: true,
+		},
+		"invalid contract addr": {
+			src:    MsgDeleteDeadLetterTasks{Authority: validAddr, Contract: "invalid-addr", TaskType: SchedulerTaskValsetUpdate},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMsgUpdateParams(t *testing.T) {
+	validAddr := sdk.AccAddress(rand.Bytes(20)).String()
+	specs := map[string]struct {
+		src    MsgUpdateParams
+		expErr bool
+	}{
+		"all valid": {
+			src: MsgUpdateParams{Authority: validAddr, Params: DefaultParams("ALX")},
+		},
+		"invalid authority": {
+			src:    MsgUpdateParams{Authority: "invalid", Params: DefaultParams("ALX")},
+			expErr: true,
+		},
+		"empty params": {
+			src:    MsgUpdateParams{Authority: validAddr},
+			expErr: true,
+		},
+		"invalid params": {
+			src: MsgUpdateParams{Authority: validAddr, Params: func() Params {
+				p := DefaultParams("ALX")
+				p.EpochLength = 0
+				return p
+			}()},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMsgRemoveVirtualStakingContract(t *testing.T) {
+	var (
+		validAddr      = sdk.AccAddress(rand.Bytes(20)).String()
+		validContrAddr = sdk.AccAddress(rand.Bytes(32)).String()
+	)
+	specs := map[string]struct {
+		src    MsgRemoveVirtualStakingContract
+		expErr bool
+	}{
+		"all valid": {
+			src: MsgRemoveVirtualStakingContract{Authority: validAddr, Contract: validContrAddr},
+		},
+		"empty authority": {
+			src:    MsgRemoveVirtualStakingContract{Contract: validContrAddr},
+			expErr: true,
+		},
+		"invalid authority addr": {
+			src:    MsgRemoveVirtualStakingContract{Authority: "invalid-addr", Contract: validContrAddr},
+			expErr: true,
+		},
+		"empty contract": {
+			src:    MsgRemoveVirtualStakingContract{Authority: validAddr},
+			expErr: true,
+		},
+		"invalid contract addr": {
+			src:    MsgRemoveVirtualStakingContract{Authority: validAddr, Contract: "invalid-addr"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			assert.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestValidateMsgMigrateVirtualStakingContract(t *testing.T) {
+	var (
+		validAddr      = sdk.AccAddress(rand.Bytes(20)).String()
+		validContrAddr = sdk.AccAddress(rand.Bytes(32)).String()
+		newContrAddr   = sdk.AccAddress(rand.Bytes(32)).String()
+	)
+	specs := map[string]struct {
+		src    MsgMigrateVirtualStakingContract
+		expErr bool
+	}{
+		"all valid": {
+			src: MsgMigrateVirtualStakingContract{Authority: validAddr, Contract: validContrAddr, NewContract: newContrAddr},
+		},
+		"invalid authority addr": {
+			src:    MsgMigrateVirtualStakingContract{Authority: "invalid-addr", Contract: validContrAddr, NewContract: newContrAddr},
+			expErr: true,
+		},
+		"invalid contract addr": {
+			src:    MsgMigrateVirtualStakingContract{Authority: validAddr, Contract: "invalid-addr", NewContract: newContrAddr},
+			expErr: true,
+		},
+		"empty new contract": {
+			src:    MsgMigrateVirtualStakingContract{Authority: validAddr, Contract: validContrAddr},
+			expErr: true,
+		},
+		"same contract": {
+			src:    MsgMigrateVirtualStakingContract{Authority: validAddr, Contract: validContrAddr, NewContract: validContrAddr},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			assert.NoError(t, gotErr)
+		})
+	}
+}
@@ -9,4 +9,31 @@ const (
 	SchedulerTaskHandleEpoch = 1
 	// SchedulerTaskValsetUpdate triggered by any update on the active set. This includes add, remove, validator modifications, slashing, tombstone
 	SchedulerTaskValsetUpdate = 2
+	// SchedulerTaskValsetSnapshot triggered by a contract registration, a chain upgrade or a governance request to resync the full validator set
+	SchedulerTaskValsetSnapshot = 3
+	// SchedulerTaskMaxCapEnforcement triggered by a max cap limit that was lowered below the delegated amount of the contract
+	SchedulerTaskMaxCapEnforcement = 4
 )
+
+// SchedulerTaskTypes are all scheduler task types
+var SchedulerTaskTypes = []SchedulerTaskType{SchedulerTaskHandleEpoch, SchedulerTaskValsetUpdate, SchedulerTaskValsetSnapshot, SchedulerTaskMaxCapEnforcement}
+
+// ValidateSchedulerTaskType returns an error for unknown scheduler task types
+func ValidateSchedulerTaskType(tp uint32) error {
+	switch SchedulerTaskType(tp) {
+	case SchedulerTaskHandleEpoch, SchedulerTaskValsetUpdate, SchedulerTaskValsetSnapshot, SchedulerTaskMaxCapEnforcement:
+		return nil
+	default:
+		return ErrInvalid.Wrapf("scheduler task type: %d", tp)
+	}
+}
+
+// ValidateValsetOperation returns an error for unknown valset operations
+func ValidateValsetOperation(op uint32) error {
+	switch PipedValsetOperation(op) {
+	case ValidatorBonded, ValidatorUnbonded, ValidatorJailed, ValidatorTombstoned, ValidatorUnjailed, ValidatorModified, ValidatorSlashed, ValidatorPowerChanged:
+		return nil
+	default:
+		return ErrInvalid.Wrapf("valset operation: %d", op)
+	}
+}
@@ -24,12 +24,13 @@ func GetQueryCmd() *cobra.Command {
 
 	meshsecurityproviderQueryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQueryRemoteStake(),
+		GetCmdQueryRemoteStakes(),
 	)
 
 	return meshsecurityproviderQueryCmd
 }
 
-
 // GetCmdQueryParams implements a command to return the current parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -58,3 +59,59 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryRemoteStake implements a command to return the amount staked remotely
+// for the given consumer connection.
+func GetCmdQueryRemoteStake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-stake [connection_id]",
+		Short: "Query the amount staked remotely for the given consumer connection",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RemoteStake(cmd.Context(), &types.RemoteStakeRequest{ConnectionId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRemoteStakes implements a command to return the amounts staked remotely
+// for all consumer connections.
+func GetCmdQueryRemoteStakes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remote-stakes",
+		Short: "Query the amounts staked remotely for all consumer connections",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RemoteStakes(cmd.Context(), &types.RemoteStakesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,8 +4,6 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/types"
 )
@@ -23,32 +21,3 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand()
 	return txCmd
 }
-
-// NewCmdTest broadcasts a Test message.
-func NewCmdTest() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "test [flags]",
-		Short: "test command",
-		Args:  cobra.ExactArgs(0),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientTxContext(cmd)
-			if err != nil {
-				return err
-			}
-			sender := clientCtx.GetFromAddress()
-
-			msg := &types.MsgTest{
-				Sender: sender.String(),
-			}
-
-			if err := msg.ValidateBasic(); err != nil {
-				return err
-			}
-
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
-		},
-	}
-
-	flags.AddTxFlagsToCmd(cmd)
-	return cmd
-}
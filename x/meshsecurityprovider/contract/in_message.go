@@ -0,0 +1,29 @@
+package contract
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+type (
+	CustomMsg struct {
+		Provider *ProviderMsg `json:"provider,omitempty"`
+	}
+	ProviderMsg struct {
+		Bond      *BondMsg      `json:"bond,omitempty"`
+		Unbond    *UnbondMsg    `json:"unbond,omitempty"`
+		SlashLien *SlashLienMsg `json:"slash_lien,omitempty"`
+	}
+	BondMsg struct {
+		Delegator string           `json:"delegator"`
+		Validator string           `json:"validator"`
+		Amount    wasmvmtypes.Coin `json:"amount"`
+	}
+	UnbondMsg struct {
+		Delegator string           `json:"delegator"`
+		Validator string           `json:"validator"`
+		Amount    wasmvmtypes.Coin `json:"amount"`
+	}
+	SlashLienMsg struct {
+		Delegator string           `json:"delegator"`
+		Validator string           `json:"validator"`
+		Amount    wasmvmtypes.Coin `json:"amount"`
+	}
+)
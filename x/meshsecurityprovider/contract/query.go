@@ -0,0 +1,29 @@
+package contract
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+type (
+	CustomQuery struct {
+		Provider *ProviderQuery `json:"provider,omitempty"`
+	}
+	ProviderQuery struct {
+		Lien        *LienQuery        `json:"lien,omitempty"`
+		RemoteStake *RemoteStakeQuery `json:"remote_stake,omitempty"`
+	}
+	LienQuery struct {
+		Delegator string `json:"delegator"`
+		// Validator is optional. The total of all validators is returned when empty.
+		Validator string `json:"validator,omitempty"`
+	}
+	LienResponse struct {
+		// Amount is the amount bonded on behalf of the vault user
+		Amount wasmvmtypes.Coin `json:"amount"`
+	}
+	RemoteStakeQuery struct {
+		ConnectionID string `json:"connection_id"`
+	}
+	RemoteStakeResponse struct {
+		// Amount is the total amount staked on the consumer chain
+		Amount wasmvmtypes.Coins `json:"amount"`
+	}
+)
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/contract"
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/types"
+)
+
+// abstract keeper
+type providerKeeper interface {
+	IsVault(ctx sdk.Context, addr sdk.AccAddress) bool
+	IsNativeStaking(ctx sdk.Context, addr sdk.AccAddress) bool
+	Bond(ctx sdk.Context, vault, delegator sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Coin) (sdk.Dec, error)
+	Unbond(ctx sdk.Context, vault, delegator sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Coin) error
+	SlashLien(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Coin) (sdk.Coin, error)
+}
+
+type CustomMsgHandler struct {
+	k providerKeeper
+}
+
+// NewCustomMsgHandler constructor to set up the CustomMsgHandler.
+// Bond and unbond are restricted to the vault contract, slashing a lien to the native staking contract.
+//
+// To be used with `wasmkeeper.NewMessageHandlerChain` next to the default handlers, see the demo app.
+func NewCustomMsgHandler(k providerKeeper) *CustomMsgHandler {
+	return &CustomMsgHandler{k: k}
+}
+
+// DispatchMsg handle contract message of type Custom in the provider namespace
+func (h CustomMsgHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom == nil {
+		return nil, nil, wasmtypes.ErrUnknownMsg
+	}
+	var customMsg contract.CustomMsg
+	if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
+		return nil, nil, sdkerrors.ErrJSONUnmarshal.Wrap("custom message")
+	}
+	if customMsg.Provider == nil {
+		// not our message type
+		return nil, nil, wasmtypes.ErrUnknownMsg
+	}
+
+	switch {
+	case customMsg.Provider.Bond != nil:
+		if !h.k.IsVault(ctx, contractAddr) {
+			return nil, nil, sdkerrors.ErrUnauthorized.Wrap("contract is not the vault")
+		}
+		return h.handleBondMsg(ctx, contractAddr, customMsg.Provider.Bond)
+	case customMsg.Provider.Unbond != nil:
+		if !h.k.IsVault(ctx, contractAddr) {
+			return nil, nil, sdkerrors.ErrUnauthorized.Wrap("contract is not the vault")
+		}
+		return h.handleUnbondMsg(ctx, contractAddr, customMsg.Provider.Unbond)
+	case customMsg.Provider.SlashLien != nil:
+		if !h.k.IsNativeStaking(ctx, contractAddr) {
+			return nil, nil, sdkerrors.ErrUnauthorized.Wrap("contract is not the native staking contract")
+		}
+		return h.handleSlashLienMsg(ctx, contractAddr, customMsg.Provider.SlashLien)
+	}
+	return nil, nil, wasmtypes.ErrUnknownMsg
+}
+
+func (h CustomMsgHandler) handleBondMsg(ctx sdk.Context, vault sdk.AccAddress, bondMsg *contract.BondMsg) ([]sdk.Event, [][]byte, error) {
+	coin, err := wasmkeeper.ConvertWasmCoinToSdkCoin(bondMsg.Amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	delAddr, err := sdk.AccAddressFromBech32(bondMsg.Delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(bondMsg.Validator)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := h.k.Bond(ctx, vault, delAddr, valAddr, coin); err != nil {
+		return nil, nil, err
+	}
+
+	return []sdk.Event{sdk.NewEvent(
+		types.EventTypeBond,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeySender, vault.String()),
+	)}, nil, nil
+}
+
+func (h CustomMsgHandler) handleUnbondMsg(ctx sdk.Context, vault sdk.AccAddress, unbondMsg *contract.UnbondMsg) ([]sdk.Event, [][]byte, error) {
+	coin, err := wasmkeeper.ConvertWasmCoinToSdkCoin(unbondMsg.Amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	delAddr, err := sdk.AccAddressFromBech32(unbondMsg.Delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(unbondMsg.Validator)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := h.k.Unbond(ctx, vault, delAddr, valAddr, coin); err != nil {
+		return nil, nil, err
+	}
+
+	return []sdk.Event{sdk.NewEvent(
+		types.EventTypeUnbond,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeySender, vault.String()),
+	)}, nil, nil
+}
+
+func (h CustomMsgHandler) handleSlashLienMsg(ctx sdk.Context, actor sdk.AccAddress, slashMsg *contract.SlashLienMsg) ([]sdk.Event, [][]byte, error) {
+	coin, err := wasmkeeper.ConvertWasmCoinToSdkCoin(slashMsg.Amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	delAddr, err := sdk.AccAddressFromBech32(slashMsg.Delegator)
+	if err != nil {
+		return nil, nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(slashMsg.Validator)
+	if err != nil {
+		return nil, nil, err
+	}
+	slashed, err := h.k.SlashLien(ctx, delAddr, valAddr, coin)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return []sdk.Event{sdk.NewEvent(
+		types.EventTypeSlashLien,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, slashed.String()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeySender, actor.String()),
+	)}, nil, nil
+}
+
+// abstract keeper
+type stakingContractSource interface {
+	IsVault(ctx sdk.Context, addr sdk.AccAddress) bool
+	IsNativeStaking(ctx sdk.Context, addr sdk.AccAddress) bool
+}
+
+// NewIntegrityHandler prevents the vault and native staking contracts from using staking
+// or stargate messages. This ensures that native stake is bonded through the provider
+// module so that liens are tracked.
+//
+// This handler should be chained before any other.
+func NewIntegrityHandler(k stakingContractSource) wasmkeeper.MessageHandlerFunc {
+	return func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (
+		events []sdk.Event,
+		data [][]byte,
+		err error,
+	) {
+		if msg.Stargate == nil && msg.Staking == nil ||
+			!k.IsVault(ctx, contractAddr) && !k.IsNativeStaking(ctx, contractAddr) {
+			return nil, nil, wasmtypes.ErrUnknownMsg // pass down the chain
+		}
+		// reject
+		return nil, nil, types.ErrUnsupported.Wrap("message type for vault or native staking contracts")
+	}
+}
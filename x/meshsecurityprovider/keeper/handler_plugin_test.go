@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/types"
+)
+
+func TestCustomProviderDispatchMsg(t *testing.T) {
+	var (
+		myVault         = sdk.AccAddress(rand.Bytes(32))
+		myNativeStaking = sdk.AccAddress(rand.Bytes(32))
+		myDelegator     = sdk.AccAddress(rand.Bytes(32))
+		myValidatorAddr = sdk.ValAddress(rand.Bytes(20))
+	)
+	validBondMsg := []byte(fmt.Sprintf(
+		`{"provider":{"bond":{"amount":{"denom":"stake", "amount":"100"},"delegator":%q,"validator":%q}}}`,
+		myDelegator.String(), myValidatorAddr.String()))
+	validUnbondMsg := []byte(fmt.Sprintf(
+		`{"provider":{"unbond":{"amount":{"denom":"stake", "amount":"40"},"delegator":%q,"validator":%q}}}`,
+		myDelegator.String(), myValidatorAddr.String()))
+	validSlashMsg := []byte(fmt.Sprintf(
+		`{"provider":{"slash_lien":{"amount":{"denom":"stake", "amount":"10"},"delegator":%q,"validator":%q}}}`,
+		myDelegator.String(), myValidatorAddr.String()))
+
+	specs := map[string]struct {
+		src       wasmvmtypes.CosmosMsg
+		sender    sdk.AccAddress
+		expErr    error
+		expLien   sdk.Coin
+		expEvents []sdk.Event
+	}{
+		"bond - success": {
+			src:     wasmvmtypes.CosmosMsg{Custom: validBondMsg},
+			sender:  myVault,
+			expLien: sdk.NewInt64Coin("stake", 150),
+			expEvents: []sdk.Event{sdk.NewEvent("provider_bond",
+				sdk.NewAttribute("module", "meshsecurityprovider"),
+				sdk.NewAttribute("validator", myValidatorAddr.String()),
+				sdk.NewAttribute("amount", "100stake"),
+				sdk.NewAttribute("delegator", myDelegator.String()),
+				sdk.NewAttribute("sender", myVault.String()),
+			)},
+		},
+		"bond - unauthorized": {
+			src:    wasmvmtypes.CosmosMsg{Custom: validBondMsg},
+			sender: myNativeStaking,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unbond - success": {
+			src:     wasmvmtypes.CosmosMsg{Custom: validUnbondMsg},
+			sender:  myVault,
+			expLien: sdk.NewInt64Coin("stake", 10),
+			expEvents: []sdk.Event{sdk.NewEvent("provider_unbond",
+				sdk.NewAttribute("module", "meshsecurityprovider"),
+				sdk.NewAttribute("validator", myValidatorAddr.String()),
+				sdk.NewAttribute("amount", "40stake"),
+				sdk.NewAttribute("delegator", myDelegator.String()),
+				sdk.NewAttribute("sender", myVault.String()),
+			)},
+		},
+		"unbond - unauthorized": {
+			src:    wasmvmtypes.CosmosMsg{Custom: validUnbondMsg},
+			sender: sdk.AccAddress(rand.Bytes(32)),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"slash lien - success": {
+			src:     wasmvmtypes.CosmosMsg{Custom: validSlashMsg},
+			sender:  myNativeStaking,
+			expLien: sdk.NewInt64Coin("stake", 40),
+			expEvents: []sdk.Event{sdk.NewEvent("slash_lien",
+				sdk.NewAttribute("module", "meshsecurityprovider"),
+				sdk.NewAttribute("validator", myValidatorAddr.String()),
+				sdk.NewAttribute("amount", "10stake"),
+				sdk.NewAttribute("delegator", myDelegator.String()),
+				sdk.NewAttribute("sender", myNativeStaking.String()),
+			)},
+		},
+		"slash lien - unauthorized": {
+			src:    wasmvmtypes.CosmosMsg{Custom: validSlashMsg},
+			sender: myVault,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"non custom msg- skip": {
+			src:    wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}},
+			sender: myVault,
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+		"custom msg without provider payload - skip": {
+			src:    wasmvmtypes.CosmosMsg{Custom: []byte(`{"foo":{}}`)},
+			sender: myVault,
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+		"invalid json": {
+			src:    wasmvmtypes.CosmosMsg{Custom: []byte(`not-json`)},
+			sender: myVault,
+			expErr: sdkerrors.ErrJSONUnmarshal,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, k := createTestInput(t)
+			require.NoError(t, k.SetParams(ctx, types.NewParams(myVault.String(), myNativeStaking.String(), nil)))
+			k.Staking = &stakingKeeperMock{
+				GetValidatorFn: func(_ sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+					return stakingtypes.Validator{OperatorAddress: addr.String(), Tokens: math.NewInt(1000), DelegatorShares: sdk.NewDec(1000)}, true
+				},
+				DelegateFn: func(_ sdk.Context, _ sdk.AccAddress, bondAmt math.Int, _ stakingtypes.BondStatus, _ stakingtypes.Validator, _ bool) (sdk.Dec, error) {
+					return sdk.NewDecFromInt(bondAmt), nil
+				},
+				ValidateUnbondAmountFn: func(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, amt math.Int) (sdk.Dec, error) {
+					return sdk.NewDecFromInt(amt), nil
+				},
+				UndelegateFn: func(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Dec) (time.Time, error) {
+					return time.Time{}, nil
+				},
+				GetDelegationFn: func(_ sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool) {
+					return stakingtypes.NewDelegation(delAddr, valAddr, sdk.NewDec(50)), true
+				},
+				UnbondFn: func(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, shares sdk.Dec) (math.Int, error) {
+					return shares.TruncateInt(), nil
+				},
+			}
+			k.bank = &bankKeeperMock{BurnCoinsFn: func(_ sdk.Context, _ string, _ sdk.Coins) error { return nil }}
+			k.setLien(ctx, myDelegator, myValidatorAddr, sdk.NewInt64Coin("stake", 50))
+			h := NewCustomMsgHandler(k)
+
+			// when
+			gotEvents, _, gotErr := h.DispatchMsg(ctx, spec.sender, "", spec.src)
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Equal(t, sdk.NewInt64Coin("stake", 50), k.GetLien(ctx, myDelegator, myValidatorAddr))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expEvents, gotEvents)
+			assert.Equal(t, spec.expLien, k.GetLien(ctx, myDelegator, myValidatorAddr))
+		})
+	}
+}
+
+func TestIntegrityHandler(t *testing.T) {
+	ctx, k := createTestInput(t)
+	myVault := sdk.AccAddress(rand.Bytes(32))
+	myNativeStaking := sdk.AccAddress(rand.Bytes(32))
+	require.NoError(t, k.SetParams(ctx, types.NewParams(myVault.String(), myNativeStaking.String(), nil)))
+
+	specs := map[string]struct {
+		src    wasmvmtypes.CosmosMsg
+		sender sdk.AccAddress
+		expErr error
+	}{
+		"staking msg from vault": {
+			src:    wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{}},
+			sender: myVault,
+			expErr: types.ErrUnsupported,
+		},
+		"stargate msg from native staking": {
+			src:    wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{}},
+			sender: myNativeStaking,
+			expErr: types.ErrUnsupported,
+		},
+		"staking msg from other contract": {
+			src:    wasmvmtypes.CosmosMsg{Staking: &wasmvmtypes.StakingMsg{}},
+			sender: sdk.AccAddress(rand.Bytes(32)),
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+		"custom msg from vault": {
+			src:    wasmvmtypes.CosmosMsg{Custom: []byte(`{}`)},
+			sender: myVault,
+			expErr: wasmtypes.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			_, _, gotErr := NewIntegrityHandler(k)(ctx, spec.sender, "", spec.src)
+			require.ErrorIs(t, gotErr, spec.expErr)
+		})
+	}
+}
@@ -3,7 +3,10 @@ package keeper
 import (
 	"github.com/cometbft/cometbft/libs/log"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -13,13 +16,17 @@ import (
 type Keeper struct {
 	storeKey  storetypes.StoreKey
 	cdc       codec.BinaryCodec
+	Staking   types.StakingKeeper
+	bank      types.BankKeeper
 	authority string
 }
 
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) *Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, sk types.StakingKeeper, bk types.BankKeeper, authority string) *Keeper {
 	return &Keeper{
 		storeKey:  storeKey,
 		cdc:       cdc,
+		Staking:   sk,
+		bank:      bk,
 		authority: authority,
 	}
 }
@@ -29,12 +36,12 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
-// GetAuthority returns the x/staking module's authority.
+// GetAuthority returns the x/meshsecurityprovider module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
-// SetParams sets the x/staking module parameters.
+// SetParams sets the x/meshsecurityprovider module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
 		return err
@@ -50,7 +57,7 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	return nil
 }
 
-// GetParams sets the x/staking module parameters.
+// GetParams gets the x/meshsecurityprovider module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.ParamsKey)
@@ -62,6 +69,130 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
+// IsVault returns true when the given address is the authorised vault contract
+func (k Keeper) IsVault(ctx sdk.Context, addr sdk.AccAddress) bool {
+	vault := k.GetParams(ctx).VaultAddress
+	return vault != "" && vault == addr.String()
+}
+
+// IsNativeStaking returns true when the given address is the authorised native staking contract
+func (k Keeper) IsNativeStaking(ctx sdk.Context, addr sdk.AccAddress) bool {
+	nativeStaking := k.GetParams(ctx).NativeStakingAddress
+	return nativeStaking != "" && nativeStaking == addr.String()
+}
+
+// GetExternalStakingContract returns the consumer connection registration of the given external staking contract.
+// Returns false when the contract is not authorised.
+func (k Keeper) GetExternalStakingContract(ctx sdk.Context, contractAddr sdk.AccAddress) (types.ExternalStakingContract, bool) {
+	return k.GetParams(ctx).GetExternalStakingContract(contractAddr)
+}
+
+// GetRemoteStake returns the total amount staked remotely on the consumer chain of the given connection.
+// Returns empty coins when nothing is staked.
+func (k Keeper) GetRemoteStake(ctx sdk.Context, connectionID string) sdk.Coins {
+	result := sdk.NewCoins()
+	k.iterateRemoteStakeCoins(ctx, types.BuildRemoteStakeConnectionKeyPrefix(connectionID), func(denom string, amount math.Int) bool {
+		result = result.Add(sdk.NewCoin(denom, amount))
+		return false
+	})
+	return result
+}
+
+// AddRemoteStake adds the given amount to the remote stake of the consumer connection and returns the new total
+func (k Keeper) AddRemoteStake(ctx sdk.Context, connectionID string, amount sdk.Coin) sdk.Coins {
+	current := k.getRemoteStakeAmount(ctx, connectionID, amount.Denom)
+	k.setRemoteStakeAmount(ctx, connectionID, amount.Denom, current.Add(amount.Amount))
+	return k.GetRemoteStake(ctx, connectionID)
+}
+
+// SubRemoteStake subtracts the given amount from the remote stake of the consumer connection and returns the new total.
+// The remote stake can not become negative.
+func (k Keeper) SubRemoteStake(ctx sdk.Context, connectionID string, amount sdk.Coin) (sdk.Coins, error) {
+	current := k.getRemoteStakeAmount(ctx, connectionID, amount.Denom)
+	if current.LT(amount.Amount) {
+		return nil, types.ErrInvalid.Wrapf("amount %s exceeds remote stake %s%s", amount, current, amount.Denom)
+	}
+	k.setRemoteStakeAmount(ctx, connectionID, amount.Denom, current.Sub(amount.Amount))
+	return k.GetRemoteStake(ctx, connectionID), nil
+}
+
+// IterateRemoteStakes iterate over all consumer connections with a remote stake
+// Callback can return true to stop early
+func (k Keeper) IterateRemoteStakes(ctx sdk.Context, cb func(connectionID string, amount sdk.Coins) bool) {
+	var (
+		lastConnectionID string
+		amount           sdk.Coins
+	)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RemoteStakeKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		connectionID, denom := parseRemoteStakeKey(iter.Key())
+		if connectionID != lastConnectionID && !amount.Empty() {
+			if cb(lastConnectionID, amount) {
+				return
+			}
+			amount = sdk.NewCoins()
+		}
+		lastConnectionID = connectionID
+		amount = amount.Add(sdk.NewCoin(denom, mustUnmarshalInt(iter.Value())))
+	}
+	if !amount.Empty() {
+		cb(lastConnectionID, amount)
+	}
+}
+
+func (k Keeper) getRemoteStakeAmount(ctx sdk.Context, connectionID, denom string) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.BuildRemoteStakeKey(connectionID, denom))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	return mustUnmarshalInt(bz)
+}
+
+// stores the amount or deletes the entry for a zero value
+func (k Keeper) setRemoteStakeAmount(ctx sdk.Context, connectionID, denom string, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.BuildRemoteStakeKey(connectionID, denom)
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	bz, err := amount.Marshal()
+	if err != nil { // always nil
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+func (k Keeper) iterateRemoteStakeCoins(ctx sdk.Context, keyPrefix []byte, cb func(denom string, amount math.Int) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(string(iter.Key()), mustUnmarshalInt(iter.Value())) {
+			return
+		}
+	}
+}
+
+// splits the remote stake key without prefix into connection id and denom
+func parseRemoteStakeKey(key []byte) (connectionID, denom string) {
+	n := int(key[0])
+	return string(key[1 : n+1]), string(key[n+1:])
+}
+
+// helper to deserialize a math.Int from store. Panics when Unmarshal fails
+func mustUnmarshalInt(bz []byte) math.Int {
+	var r math.Int
+	if err := r.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return r
+}
+
 // InitGenesis initializes the meshsecurity provider module's state from a provided genesis
 // state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
@@ -69,12 +200,38 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 		panic(err)
 	}
 
-	k.SetParams(ctx, genState.Params)
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+	for _, s := range genState.RemoteStakes {
+		for _, c := range s.Amount {
+			k.setRemoteStakeAmount(ctx, s.ConnectionId, c.Denom, c.Amount)
+		}
+	}
+	for _, l := range genState.Liens {
+		valAddr, err := sdk.ValAddressFromBech32(l.Validator)
+		if err != nil {
+			panic(err)
+		}
+		k.setLien(ctx, sdk.MustAccAddressFromBech32(l.Delegator), valAddr, l.Amount)
+	}
 }
 
 // ExportGenesis returns the meshsecurity provider module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	remoteStakes := make([]types.RemoteStake, 0)
+	k.IterateRemoteStakes(ctx, func(connectionID string, amount sdk.Coins) bool {
+		remoteStakes = append(remoteStakes, types.RemoteStake{ConnectionId: connectionID, Amount: amount})
+		return false
+	})
+	liens := make([]types.Lien, 0)
+	k.IterateLiens(ctx, func(delegator sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) bool {
+		liens = append(liens, types.Lien{Delegator: delegator.String(), Validator: valAddr.String(), Amount: amount})
+		return false
+	})
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:       k.GetParams(ctx),
+		RemoteStakes: remoteStakes,
+		Liens:        liens,
 	}
 }
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/rand"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/types"
+)
+
+func TestRemoteStake(t *testing.T) {
+	ctx, k := createTestInput(t)
+
+	// when
+	total := k.AddRemoteStake(ctx, "connection-0", sdk.NewInt64Coin("stake", 100))
+	// then
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), total)
+
+	// and other denoms are tracked separately
+	total = k.AddRemoteStake(ctx, "connection-0", sdk.NewInt64Coin("other", 1))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("other", 1)), total)
+
+	// and other connections are not affected
+	k.AddRemoteStake(ctx, "connection-1", sdk.NewInt64Coin("stake", 2))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 2)), k.GetRemoteStake(ctx, "connection-1"))
+
+	// when sub
+	total, err := k.SubRemoteStake(ctx, "connection-0", sdk.NewInt64Coin("stake", 40))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60), sdk.NewInt64Coin("other", 1)), total)
+
+	// and sub more than staked fails
+	_, err = k.SubRemoteStake(ctx, "connection-0", sdk.NewInt64Coin("stake", 61))
+	require.Error(t, err)
+
+	// and zero amounts are removed
+	total, err = k.SubRemoteStake(ctx, "connection-0", sdk.NewInt64Coin("other", 1))
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), total)
+
+	// and all stakes are iterated in order
+	var got []types.RemoteStake
+	k.IterateRemoteStakes(ctx, func(connectionID string, amount sdk.Coins) bool {
+		got = append(got, types.RemoteStake{ConnectionId: connectionID, Amount: amount})
+		return false
+	})
+	exp := []types.RemoteStake{
+		{ConnectionId: "connection-0", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))},
+		{ConnectionId: "connection-1", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 2))},
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	ctx, k := createTestInput(t)
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	genState := types.GenesisState{
+		Params: types.NewParams(sdk.AccAddress(rand.Bytes(32)).String(), sdk.AccAddress(rand.Bytes(32)).String(), []types.ExternalStakingContract{
+			{ConnectionId: "connection-0", ContractAddress: myContract.String()},
+		}),
+		RemoteStakes: []types.RemoteStake{
+			{ConnectionId: "connection-0", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))},
+		},
+		Liens: []types.Lien{
+			{Delegator: sdk.AccAddress(rand.Bytes(32)).String(), Validator: sdk.ValAddress(rand.Bytes(20)).String(), Amount: sdk.NewInt64Coin("stake", 70)},
+		},
+	}
+
+	// when
+	k.InitGenesis(ctx, &genState)
+	// then
+	assert.Equal(t, &genState, k.ExportGenesis(ctx))
+	c, ok := k.GetExternalStakingContract(ctx, myContract)
+	require.True(t, ok)
+	assert.Equal(t, "connection-0", c.ConnectionId)
+}
+
+func createTestInput(t testing.TB) (sdk.Context, *Keeper) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := NewKeeper(cdc, storeKey, &stakingKeeperMock{}, &bankKeeperMock{}, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 1234567}, false, log.NewNopLogger())
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	return ctx, k
+}
+
+var _ types.StakingKeeper = &stakingKeeperMock{}
+
+type stakingKeeperMock struct {
+	GetValidatorFn         func(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool)
+	DelegateFn             func(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (sdk.Dec, error)
+	ValidateUnbondAmountFn func(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt math.Int) (sdk.Dec, error)
+	UndelegateFn           func(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) (time.Time, error)
+	GetDelegationFn        func(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool)
+	UnbondFn               func(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) (math.Int, error)
+}
+
+func (m stakingKeeperMock) BondDenom(_ sdk.Context) string {
+	return "stake"
+}
+
+func (m stakingKeeperMock) GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	if m.GetValidatorFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetValidatorFn(ctx, addr)
+}
+
+func (m stakingKeeperMock) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (sdk.Dec, error) {
+	if m.DelegateFn == nil {
+		panic("not expected to be called")
+	}
+	return m.DelegateFn(ctx, delAddr, bondAmt, tokenSrc, validator, subtractAccount)
+}
+
+func (m stakingKeeperMock) ValidateUnbondAmount(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt math.Int) (sdk.Dec, error) {
+	if m.ValidateUnbondAmountFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ValidateUnbondAmountFn(ctx, delAddr, valAddr, amt)
+}
+
+func (m stakingKeeperMock) Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec) (time.Time, error) {
+	if m.UndelegateFn == nil {
+		panic("not expected to be called")
+	}
+	return m.UndelegateFn(ctx, delAddr, valAddr, sharesAmount)
+}
+
+func (m stakingKeeperMock) GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool) {
+	if m.GetDelegationFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetDelegationFn(ctx, delAddr, valAddr)
+}
+
+func (m stakingKeeperMock) Unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) (math.Int, error) {
+	if m.UnbondFn == nil {
+		panic("not expected to be called")
+	}
+	return m.UnbondFn(ctx, delAddr, valAddr, shares)
+}
+
+var _ types.BankKeeper = &bankKeeperMock{}
+
+type bankKeeperMock struct {
+	BurnCoinsFn func(ctx sdk.Context, moduleName string, amounts sdk.Coins) error
+}
+
+func (m bankKeeperMock) BurnCoins(ctx sdk.Context, moduleName string, amounts sdk.Coins) error {
+	if m.BurnCoinsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.BurnCoinsFn(ctx, moduleName, amounts)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -14,7 +15,7 @@ type msgServer struct {
 	*Keeper
 }
 
-// NewMsgServerImpl returns an implementation of the bank MsgServer interface
+// NewMsgServerImpl returns an implementation of the meshsecurityprovider MsgServer interface
 // for the provided Keeper.
 func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 	return &msgServer{Keeper: keeper}
@@ -22,11 +23,52 @@ func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 
 var _ types.MsgServer = msgServer{}
 
-func (ms msgServer) Test(goCtx context.Context, msg *types.MsgTest) (*types.MsgTestResponse, error) {
-	return &types.MsgTestResponse{}, nil
+// StakeRemote records new stake of an authorised external staking contract on its consumer chain
+func (ms msgServer) StakeRemote(goCtx context.Context, msg *types.MsgStakeRemote) (*types.MsgStakeRemoteResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	c, ok := ms.GetExternalStakingContract(ctx, sender)
+	if !ok {
+		return nil, types.ErrUnauthorized.Wrapf("not an external staking contract: %s", msg.Sender)
+	}
+	total := ms.AddRemoteStake(ctx, c.ConnectionId, msg.Amount)
+	types.EmitRemoteStakeUpdatedEvent(ctx, c.ConnectionId, sender, total)
+	return &types.MsgStakeRemoteResponse{}, nil
 }
 
+// UnstakeRemote records stake of an authorised external staking contract removed from its consumer chain
+func (ms msgServer) UnstakeRemote(goCtx context.Context, msg *types.MsgUnstakeRemote) (*types.MsgUnstakeRemoteResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	c, ok := ms.GetExternalStakingContract(ctx, sender)
+	if !ok {
+		return nil, types.ErrUnauthorized.Wrapf("not an external staking contract: %s", msg.Sender)
+	}
+	total, err := ms.SubRemoteStake(ctx, c.ConnectionId, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	types.EmitRemoteStakeUpdatedEvent(ctx, c.ConnectionId, sender, total)
+	return &types.MsgUnstakeRemoteResponse{}, nil
+}
+
+// UpdateParams updates the module parameters
 func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	if ms.authority != msg.Authority {
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/types"
+)
+
+func TestStakeRemote(t *testing.T) {
+	pCtx, k := createTestInput(t)
+	m := NewMsgServerImpl(k)
+	myContract := sdk.AccAddress(rand.Bytes(32))
+	require.NoError(t, k.SetParams(pCtx, types.NewParams("", "", []types.ExternalStakingContract{
+		{ConnectionId: "connection-0", ContractAddress: myContract.String()},
+	})))
+	k.AddRemoteStake(pCtx, "connection-0", sdk.NewInt64Coin("stake", 10))
+
+	specs := map[string]struct {
+		stake    bool
+		sender   sdk.AccAddress
+		amount   sdk.Coin
+		expErr   bool
+		expTotal sdk.Coins
+	}{
+		"stake": {
+			stake:    true,
+			sender:   myContract,
+			amount:   sdk.NewInt64Coin("stake", 5),
+			expTotal: sdk.NewCoins(sdk.NewInt64Coin("stake", 15)),
+		},
+		"unstake": {
+			sender:   myContract,
+			amount:   sdk.NewInt64Coin("stake", 5),
+			expTotal: sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+		},
+		"unstake more than staked": {
+			sender: myContract,
+			amount: sdk.NewInt64Coin("stake", 11),
+			expErr: true,
+		},
+		"stake unauthorized": {
+			stake:  true,
+			sender: sdk.AccAddress(rand.Bytes(32)),
+			amount: sdk.NewInt64Coin("stake", 5),
+			expErr: true,
+		},
+		"unstake unauthorized": {
+			sender: sdk.AccAddress(rand.Bytes(32)),
+			amount: sdk.NewInt64Coin("stake", 5),
+			expErr: true,
+		},
+		"zero amount": {
+			stake:  true,
+			sender: myContract,
+			amount: sdk.NewInt64Coin("stake", 0),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			var gotErr error
+			if spec.stake {
+				_, gotErr = m.StakeRemote(sdk.WrapSDKContext(ctx), &types.MsgStakeRemote{Sender: spec.sender.String(), Amount: spec.amount})
+			} else {
+				_, gotErr = m.UnstakeRemote(sdk.WrapSDKContext(ctx), &types.MsgUnstakeRemote{Sender: spec.sender.String(), Amount: spec.amount})
+			}
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expTotal, k.GetRemoteStake(ctx, "connection-0"))
+		})
+	}
+}
+
+func TestUpdateParams(t *testing.T) {
+	pCtx, k := createTestInput(t)
+	m := NewMsgServerImpl(k)
+	myContract := sdk.AccAddress(rand.Bytes(32)).String()
+
+	specs := map[string]struct {
+		authority string
+		params    types.Params
+		expErr    bool
+	}{
+		"all good": {
+			authority: k.GetAuthority(),
+			params:    types.NewParams(myContract, "", []types.ExternalStakingContract{{ConnectionId: "connection-0", ContractAddress: myContract}}),
+		},
+		"unauthorized": {
+			authority: myContract,
+			params:    types.DefaultParams(),
+			expErr:    true,
+		},
+		"invalid authority address": {
+			authority: "invalid",
+			params:    types.DefaultParams(),
+			expErr:    true,
+		},
+		"duplicate connection": {
+			authority: k.GetAuthority(),
+			params: types.NewParams("", "", []types.ExternalStakingContract{
+				{ConnectionId: "connection-0", ContractAddress: myContract},
+				{ConnectionId: "connection-0", ContractAddress: sdk.AccAddress(rand.Bytes(32)).String()},
+			}),
+			expErr: true,
+		},
+		"invalid vault address": {
+			authority: k.GetAuthority(),
+			params:    types.NewParams("invalid", "", nil),
+			expErr:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			_, gotErr := m.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: spec.authority, Params: spec.params})
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.params, k.GetParams(ctx))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/types"
+)
+
+var _ types.QueryServer = &querier{}
+
+type querier struct {
+	k *Keeper
+}
+
+// NewQuerier constructor
+func NewQuerier(k *Keeper) *querier {
+	return &querier{k: k}
+}
+
+// Params implements the gRPC service handler for querying the meshsecurityprovider parameters.
+func (q querier) Params(goCtx context.Context, _ *types.ParamsRequest) (*types.ParamsResponse, error) {
+	return &types.ParamsResponse{Params: q.k.GetParams(sdk.UnwrapSDKContext(goCtx))}, nil
+}
+
+// RemoteStake returns the amount staked remotely for the given consumer connection. Returns empty coins for
+// unknown connections
+func (q querier) RemoteStake(goCtx context.Context, req *types.RemoteStakeRequest) (*types.RemoteStakeResponse, error) {
+	if req.ConnectionId == "" {
+		return nil, types.ErrInvalid.Wrap("empty connection id")
+	}
+	return &types.RemoteStakeResponse{Amount: q.k.GetRemoteStake(sdk.UnwrapSDKContext(goCtx), req.ConnectionId)}, nil
+}
+
+// RemoteStakes returns the amounts staked remotely for all consumer connections
+func (q querier) RemoteStakes(goCtx context.Context, _ *types.RemoteStakesRequest) (*types.RemoteStakesResponse, error) {
+	rsp := types.RemoteStakesResponse{RemoteStakes: make([]types.RemoteStake, 0)}
+	q.k.IterateRemoteStakes(sdk.UnwrapSDKContext(goCtx), func(connectionID string, amount sdk.Coins) bool {
+		rsp.RemoteStakes = append(rsp.RemoteStakes, types.RemoteStake{ConnectionId: connectionID, Amount: amount})
+		return false
+	})
+	return &rsp, nil
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/contract"
+)
+
+// abstract query keeper
+type viewKeeper interface {
+	GetLien(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress) sdk.Coin
+	GetTotalLien(ctx sdk.Context, delegator sdk.AccAddress) sdk.Coin
+	GetRemoteStake(ctx sdk.Context, connectionID string) sdk.Coins
+}
+
+// NewQueryDecorator constructor to build a chained custom querier.
+// The provider custom query handler is placed at the first position
+// and delegates to the next in chain for any queries that do not match
+// the provider custom query namespace.
+//
+// To be used with `wasmkeeper.WithQueryHandlerDecorator(providerkeeper.NewQueryDecorator(app.MeshSecProvKeeper)))`
+func NewQueryDecorator(k viewKeeper) func(wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	return func(next wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+		return ChainedCustomQuerier(k, next)
+	}
+}
+
+// ChainedCustomQuerier implements the provider custom query handler.
+// The given WasmVMQueryHandler is receiving all unhandled queries and must therefore
+// not be nil.
+func ChainedCustomQuerier(k viewKeeper, next wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	if k == nil {
+		panic("provider keeper must not be nil")
+	}
+	if next == nil {
+		panic("next handler must not be nil")
+	}
+	return QueryHandlerFn(func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+		if request.Custom == nil {
+			return next.HandleQuery(ctx, caller, request)
+		}
+		var contractQuery contract.CustomQuery
+		if err := json.Unmarshal(request.Custom, &contractQuery); err != nil {
+			return nil, errorsmod.Wrap(err, "provider query")
+		}
+		query := contractQuery.Provider
+		if query == nil {
+			return next.HandleQuery(ctx, caller, request)
+		}
+
+		var res any
+		switch {
+		case query.Lien != nil:
+			delAddr, err := sdk.AccAddressFromBech32(query.Lien.Delegator)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidAddress.Wrap(query.Lien.Delegator)
+			}
+			lien := k.GetTotalLien(ctx, delAddr)
+			if query.Lien.Validator != "" {
+				valAddr, err := sdk.ValAddressFromBech32(query.Lien.Validator)
+				if err != nil {
+					return nil, sdkerrors.ErrInvalidAddress.Wrap(query.Lien.Validator)
+				}
+				lien = k.GetLien(ctx, delAddr, valAddr)
+			}
+			res = contract.LienResponse{
+				Amount: wasmkeeper.ConvertSdkCoinToWasmCoin(lien),
+			}
+		case query.RemoteStake != nil:
+			res = contract.RemoteStakeResponse{
+				Amount: wasmkeeper.ConvertSdkCoinsToWasmCoins(k.GetRemoteStake(ctx, query.RemoteStake.ConnectionID)),
+			}
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown provider query variant"}
+		}
+		return json.Marshal(res)
+	})
+}
+
+var _ wasmkeeper.WasmVMQueryHandler = QueryHandlerFn(nil)
+
+// QueryHandlerFn helper type that implements wasmkeeper.WasmVMQueryHandler
+type QueryHandlerFn func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error)
+
+// HandleQuery handles contract query
+func (q QueryHandlerFn) HandleQuery(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+	return q(ctx, caller, request)
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/contract"
+)
+
+func TestChainedCustomQuerier(t *testing.T) {
+	ctx, k := createTestInput(t)
+	myDelegator := sdk.AccAddress(rand.Bytes(32))
+	myValAddr := sdk.ValAddress(rand.Bytes(20))
+	k.setLien(ctx, myDelegator, myValAddr, sdk.NewInt64Coin("stake", 100))
+	k.setLien(ctx, myDelegator, sdk.ValAddress(rand.Bytes(20)), sdk.NewInt64Coin("stake", 1))
+	k.AddRemoteStake(ctx, "connection-0", sdk.NewInt64Coin("stake", 20))
+
+	specs := map[string]struct {
+		src           wasmvmtypes.QueryRequest
+		expData       []byte
+		expErr        bool
+		expNextCalled bool
+	}{
+		"lien query": {
+			src: wasmvmtypes.QueryRequest{Custom: []byte(fmt.Sprintf(`{"provider":{"lien":{"delegator":%q}}}`, myDelegator.String()))},
+			expData: mustMarshal(t, contract.LienResponse{
+				Amount: wasmvmtypes.NewCoin(101, "stake"),
+			}),
+		},
+		"lien query - by validator": {
+			src: wasmvmtypes.QueryRequest{Custom: []byte(fmt.Sprintf(`{"provider":{"lien":{"delegator":%q,"validator":%q}}}`, myDelegator.String(), myValAddr.String()))},
+			expData: mustMarshal(t, contract.LienResponse{
+				Amount: wasmvmtypes.NewCoin(100, "stake"),
+			}),
+		},
+		"lien query - invalid validator address": {
+			src:    wasmvmtypes.QueryRequest{Custom: []byte(fmt.Sprintf(`{"provider":{"lien":{"delegator":%q,"validator":"invalid"}}}`, myDelegator.String()))},
+			expErr: true,
+		},
+		"lien query - invalid address": {
+			src:    wasmvmtypes.QueryRequest{Custom: []byte(`{"provider":{"lien":{"delegator":"invalid"}}}`)},
+			expErr: true,
+		},
+		"remote stake query": {
+			src: wasmvmtypes.QueryRequest{Custom: []byte(`{"provider":{"remote_stake":{"connection_id":"connection-0"}}}`)},
+			expData: mustMarshal(t, contract.RemoteStakeResponse{
+				Amount: wasmvmtypes.Coins{wasmvmtypes.NewCoin(20, "stake")},
+			}),
+		},
+		"unknown provider query": {
+			src:    wasmvmtypes.QueryRequest{Custom: []byte(`{"provider":{}}`)},
+			expErr: true,
+		},
+		"non custom query": {
+			src:           wasmvmtypes.QueryRequest{Bank: &wasmvmtypes.BankQuery{}},
+			expNextCalled: true,
+		},
+		"custom non provider query": {
+			src:           wasmvmtypes.QueryRequest{Custom: []byte(`{"foo":{}}`)},
+			expNextCalled: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var nextCalled bool
+			next := QueryHandlerFn(func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+				nextCalled = true
+				return nil, nil
+			})
+			gotData, gotErr := ChainedCustomQuerier(k, next).HandleQuery(ctx, sdk.AccAddress(rand.Bytes(32)), spec.src)
+			assert.Equal(t, spec.expNextCalled, nextCalled)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expData, gotData)
+		})
+	}
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	t.Helper()
+	bz, err := json.Marshal(v)
+	require.NoError(t, err)
+	return bz
+}
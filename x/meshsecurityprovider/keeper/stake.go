@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/types"
+)
+
+// Bond delegates the given amount from the vault contract balance to the validator and adds it to the lien
+// of the vault user for this validator.
+// Authorization of the vault should be handled before entering this method.
+func (k Keeper) Bond(pCtx sdk.Context, vault, delegator sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Coin) (sdk.Dec, error) {
+	if amt.Amount.IsNil() || !amt.Amount.IsPositive() {
+		return sdk.ZeroDec(), errors.ErrInvalidRequest.Wrap("amount")
+	}
+	bondDenom := k.Staking.BondDenom(pCtx)
+	if amt.Denom != bondDenom {
+		return sdk.ZeroDec(), errors.ErrInvalidRequest.Wrapf("invalid coin denomination: got %s, expected %s", amt.Denom, bondDenom)
+	}
+	validator, found := k.Staking.GetValidator(pCtx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), stakingtypes.ErrNoValidatorFound
+	}
+
+	cacheCtx, done := pCtx.CacheContext()
+	newShares, err := k.Staking.Delegate(cacheCtx, vault, amt.Amount, stakingtypes.Unbonded, validator, true)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	k.setLien(cacheCtx, delegator, valAddr, k.GetLien(cacheCtx, delegator, valAddr).Add(amt))
+	done()
+	return newShares, nil
+}
+
+// Unbond undelegates the given amount of the vault contract from the validator and removes it from the lien
+// of the vault user for this validator. The tokens are returned to the vault contract after the unbonding period.
+// Authorization of the vault should be handled before entering this method.
+func (k Keeper) Unbond(pCtx sdk.Context, vault, delegator sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Coin) error {
+	if amt.Amount.IsNil() || !amt.Amount.IsPositive() {
+		return errors.ErrInvalidRequest.Wrap("amount")
+	}
+	bondDenom := k.Staking.BondDenom(pCtx)
+	if amt.Denom != bondDenom {
+		return errors.ErrInvalidRequest.Wrapf("invalid coin denomination: got %s, expected %s", amt.Denom, bondDenom)
+	}
+	lien := k.GetLien(pCtx, delegator, valAddr)
+	if lien.IsLT(amt) {
+		return errors.ErrInvalidRequest.Wrap("amount exceeds lien")
+	}
+
+	cacheCtx, done := pCtx.CacheContext()
+	shares, err := k.Staking.ValidateUnbondAmount(cacheCtx, vault, valAddr, amt.Amount)
+	if err != nil {
+		return err
+	}
+	if _, err := k.Staking.Undelegate(cacheCtx, vault, valAddr, shares); err != nil {
+		return err
+	}
+	k.setLien(cacheCtx, delegator, valAddr, lien.Sub(amt))
+	done()
+	return nil
+}
+
+// SlashLien reduces the lien of the vault user for the validator by the given amount and burns the slashed stake
+// from the vault delegation. The lien can not become negative so that the amount actually slashed is returned.
+// When the delegation is worth less than the slashed amount, for example after a slash of the validator, only the
+// remaining delegation is burned.
+// Authorization of the native staking contract should be handled before entering this method.
+func (k Keeper) SlashLien(pCtx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Coin) (sdk.Coin, error) {
+	if amt.Amount.IsNil() || !amt.Amount.IsPositive() {
+		return sdk.Coin{}, errors.ErrInvalidRequest.Wrap("amount")
+	}
+	lien := k.GetLien(pCtx, delegator, valAddr)
+	if amt.Denom != lien.Denom {
+		return sdk.Coin{}, errors.ErrInvalidRequest.Wrapf("invalid coin denomination: got %s, expected %s", amt.Denom, lien.Denom)
+	}
+	if lien.IsLT(amt) {
+		amt = lien
+	}
+	if amt.IsZero() {
+		return amt, nil
+	}
+	vault, err := sdk.AccAddressFromBech32(k.GetParams(pCtx).VaultAddress)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "vault address")
+	}
+
+	cacheCtx, done := pCtx.CacheContext()
+	if err := k.burnDelegation(cacheCtx, vault, valAddr, amt.Amount); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.setLien(cacheCtx, delegator, valAddr, lien.Sub(amt))
+	done()
+	return amt, nil
+}
+
+// burnDelegation unbonds up to the given amount from the delegation and burns the tokens from the staking pool
+func (k Keeper) burnDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt math.Int) error {
+	validator, found := k.Staking.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+	delegation, found := k.Staking.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return stakingtypes.ErrNoDelegation
+	}
+	if delegated := validator.TokensFromShares(delegation.Shares).TruncateInt(); delegated.LT(amt) {
+		amt = delegated
+	}
+	if !amt.IsPositive() {
+		return nil
+	}
+	shares, err := k.Staking.ValidateUnbondAmount(ctx, delAddr, valAddr, amt)
+	if err != nil {
+		return err
+	}
+	unbonded, err := k.Staking.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return err
+	}
+	pool := stakingtypes.NotBondedPoolName
+	if validator.IsBonded() {
+		pool = stakingtypes.BondedPoolName
+	}
+	return k.bank.BurnCoins(ctx, pool, sdk.NewCoins(sdk.NewCoin(k.Staking.BondDenom(ctx), unbonded)))
+}
+
+// GetLien returns the amount bonded to the validator on behalf of the vault user.
+// Returns a zero coin in bond denom when nothing is bonded.
+func (k Keeper) GetLien(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress) sdk.Coin {
+	bondDenom := k.Staking.BondDenom(ctx)
+	bz := ctx.KVStore(k.storeKey).Get(types.BuildLienKey(delegator, valAddr))
+	if bz == nil {
+		return sdk.NewCoin(bondDenom, sdk.ZeroInt())
+	}
+	return sdk.NewCoin(bondDenom, mustUnmarshalInt(bz))
+}
+
+// GetTotalLien returns the amount bonded to all validators on behalf of the vault user.
+// Returns a zero coin in bond denom when nothing is bonded.
+func (k Keeper) GetTotalLien(ctx sdk.Context, delegator sdk.AccAddress) sdk.Coin {
+	total := sdk.NewCoin(k.Staking.BondDenom(ctx), sdk.ZeroInt())
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BuildLienDelegatorKeyPrefix(delegator))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		total.Amount = total.Amount.Add(mustUnmarshalInt(iter.Value()))
+	}
+	return total
+}
+
+// IterateLiens iterate over all vault users and validators with a lien
+// Callback can return true to stop early
+func (k Keeper) IterateLiens(ctx sdk.Context, cb func(delegator sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) bool) {
+	bondDenom := k.Staking.BondDenom(ctx)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LienKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		delegator := sdk.AccAddress(key[1 : 1+key[0]]) // length prefixed
+		valAddr := sdk.ValAddress(key[2+key[0]:])      // skip length prefix
+		if cb(delegator, valAddr, sdk.NewCoin(bondDenom, mustUnmarshalInt(iter.Value()))) {
+			return
+		}
+	}
+}
+
+// stores the lien amount or deletes the entry for a zero value
+func (k Keeper) setLien(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.BuildLienKey(delegator, valAddr)
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	bz, err := amount.Amount.Marshal()
+	if err != nil { // always nil
+		panic(err)
+	}
+	store.Set(key, bz)
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/mesh-security-sdk/x/meshsecurityprovider/types"
+)
+
+func TestBondUnbond(t *testing.T) {
+	ctx, k := createTestInput(t)
+	var (
+		myVault     = sdk.AccAddress(rand.Bytes(32))
+		myDelegator = sdk.AccAddress(rand.Bytes(32))
+		myValAddr   = sdk.ValAddress(rand.Bytes(20))
+		delegated   math.Int
+		undelegated sdk.Dec
+	)
+	k.Staking = &stakingKeeperMock{
+		GetValidatorFn: func(_ sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+			return stakingtypes.Validator{OperatorAddress: addr.String()}, addr.Equals(myValAddr)
+		},
+		DelegateFn: func(_ sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc stakingtypes.BondStatus, _ stakingtypes.Validator, subtractAccount bool) (sdk.Dec, error) {
+			require.Equal(t, myVault, delAddr)
+			require.Equal(t, stakingtypes.Unbonded, tokenSrc)
+			require.True(t, subtractAccount)
+			delegated = bondAmt
+			return sdk.NewDecFromInt(bondAmt), nil
+		},
+		ValidateUnbondAmountFn: func(_ sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt math.Int) (sdk.Dec, error) {
+			require.Equal(t, myVault, delAddr)
+			require.Equal(t, myValAddr, valAddr)
+			return sdk.NewDecFromInt(amt), nil
+		},
+		UndelegateFn: func(_ sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) (time.Time, error) {
+			require.Equal(t, myVault, delAddr)
+			undelegated = shares
+			return time.Time{}, nil
+		},
+	}
+
+	// when bond
+	_, err := k.Bond(ctx, myVault, myDelegator, myValAddr, sdk.NewInt64Coin("stake", 100))
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, math.NewInt(100), delegated)
+	assert.Equal(t, sdk.NewInt64Coin("stake", 100), k.GetLien(ctx, myDelegator, myValAddr))
+	// and liens are tracked per validator
+	assert.Equal(t, sdk.NewInt64Coin("stake", 0), k.GetLien(ctx, myDelegator, sdk.ValAddress(rand.Bytes(20))))
+	assert.Equal(t, sdk.NewInt64Coin("stake", 100), k.GetTotalLien(ctx, myDelegator))
+
+	// and invalid bonds fail
+	_, err = k.Bond(ctx, myVault, myDelegator, myValAddr, sdk.NewInt64Coin("other", 100))
+	require.Error(t, err)
+	_, err = k.Bond(ctx, myVault, myDelegator, sdk.ValAddress(rand.Bytes(20)), sdk.NewInt64Coin("stake", 100))
+	require.Error(t, err)
+	_, err = k.Bond(ctx, myVault, myDelegator, myValAddr, sdk.NewInt64Coin("stake", 0))
+	require.Error(t, err)
+
+	// when unbond
+	err = k.Unbond(ctx, myVault, myDelegator, myValAddr, sdk.NewInt64Coin("stake", 40))
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(40), undelegated)
+	assert.Equal(t, sdk.NewInt64Coin("stake", 60), k.GetLien(ctx, myDelegator, myValAddr))
+
+	// and unbond more than the lien fails
+	err = k.Unbond(ctx, myVault, myDelegator, myValAddr, sdk.NewInt64Coin("stake", 61))
+	require.Error(t, err)
+
+	// and the lien is not modified when the staking keeper fails
+	k.Staking.(*stakingKeeperMock).UndelegateFn = func(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, _ sdk.Dec) (time.Time, error) {
+		return time.Time{}, errors.New("testing")
+	}
+	err = k.Unbond(ctx, myVault, myDelegator, myValAddr, sdk.NewInt64Coin("stake", 10))
+	require.Error(t, err)
+	assert.Equal(t, sdk.NewInt64Coin("stake", 60), k.GetLien(ctx, myDelegator, myValAddr))
+}
+
+func TestSlashLien(t *testing.T) {
+	pCtx, k := createTestInput(t)
+	var (
+		myVault     = sdk.AccAddress(rand.Bytes(32))
+		myDelegator = sdk.AccAddress(rand.Bytes(32))
+		myValAddr   = sdk.ValAddress(rand.Bytes(20))
+		otherVal    = sdk.ValAddress(rand.Bytes(20))
+	)
+	require.NoError(t, k.SetParams(pCtx, types.NewParams(myVault.String(), sdk.AccAddress(rand.Bytes(32)).String(), nil)))
+	k.setLien(pCtx, myDelegator, myValAddr, sdk.NewInt64Coin("stake", 100))
+	k.setLien(pCtx, myDelegator, otherVal, sdk.NewInt64Coin("stake", 30))
+
+	specs := map[string]struct {
+		amount     sdk.Coin
+		delegated  int64
+		expErr     bool
+		expSlashed sdk.Coin
+		expBurned  sdk.Coins
+		expLien    sdk.Coin
+	}{
+		"partial": {
+			amount:     sdk.NewInt64Coin("stake", 40),
+			delegated:  100,
+			expSlashed: sdk.NewInt64Coin("stake", 40),
+			expBurned:  sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+			expLien:    sdk.NewInt64Coin("stake", 60),
+		},
+		"all": {
+			amount:     sdk.NewInt64Coin("stake", 100),
+			delegated:  100,
+			expSlashed: sdk.NewInt64Coin("stake", 100),
+			expBurned:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expLien:    sdk.NewInt64Coin("stake", 0),
+		},
+		"capped at lien": {
+			amount:     sdk.NewInt64Coin("stake", 101),
+			delegated:  100,
+			expSlashed: sdk.NewInt64Coin("stake", 100),
+			expBurned:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expLien:    sdk.NewInt64Coin("stake", 0),
+		},
+		"burn capped at delegation": {
+			amount:     sdk.NewInt64Coin("stake", 40),
+			delegated:  25,
+			expSlashed: sdk.NewInt64Coin("stake", 40),
+			expBurned:  sdk.NewCoins(sdk.NewInt64Coin("stake", 25)),
+			expLien:    sdk.NewInt64Coin("stake", 60),
+		},
+		"invalid denom": {
+			amount: sdk.NewInt64Coin("other", 1),
+			expErr: true,
+		},
+		"zero amount": {
+			amount: sdk.NewInt64Coin("stake", 0),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := pCtx.CacheContext()
+			var gotBurned sdk.Coins
+			k.Staking = &stakingKeeperMock{
+				GetValidatorFn: func(_ sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+					return stakingtypes.Validator{OperatorAddress: addr.String(), Status: stakingtypes.Bonded, Tokens: math.NewInt(1000), DelegatorShares: sdk.NewDec(1000)}, true
+				},
+				GetDelegationFn: func(_ sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool) {
+					require.Equal(t, myVault, delAddr)
+					require.Equal(t, myValAddr, valAddr)
+					return stakingtypes.NewDelegation(delAddr, valAddr, sdk.NewDec(spec.delegated)), true
+				},
+				ValidateUnbondAmountFn: func(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, amt math.Int) (sdk.Dec, error) {
+					return sdk.NewDecFromInt(amt), nil
+				},
+				UnbondFn: func(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, shares sdk.Dec) (math.Int, error) {
+					return shares.TruncateInt(), nil
+				},
+			}
+			k.bank = &bankKeeperMock{BurnCoinsFn: func(_ sdk.Context, moduleName string, amounts sdk.Coins) error {
+				require.Equal(t, stakingtypes.BondedPoolName, moduleName)
+				gotBurned = amounts
+				return nil
+			}}
+
+			gotSlashed, gotErr := k.SlashLien(ctx, myDelegator, myValAddr, spec.amount)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expSlashed, gotSlashed)
+			assert.Equal(t, spec.expBurned, gotBurned)
+			assert.Equal(t, spec.expLien, k.GetLien(ctx, myDelegator, myValAddr))
+			// and other validators not affected
+			assert.Equal(t, sdk.NewInt64Coin("stake", 30), k.GetLien(ctx, myDelegator, otherVal))
+		})
+	}
+
+	// and the lien is not modified when the burn fails
+	k.Staking = &stakingKeeperMock{
+		GetValidatorFn: func(_ sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+			return stakingtypes.Validator{OperatorAddress: addr.String(), Status: stakingtypes.Bonded, Tokens: math.NewInt(1000), DelegatorShares: sdk.NewDec(1000)}, true
+		},
+		GetDelegationFn: func(_ sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stakingtypes.Delegation, bool) {
+			return stakingtypes.NewDelegation(delAddr, valAddr, sdk.NewDec(100)), true
+		},
+		ValidateUnbondAmountFn: func(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, amt math.Int) (sdk.Dec, error) {
+			return sdk.NewDecFromInt(amt), nil
+		},
+		UnbondFn: func(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress, shares sdk.Dec) (math.Int, error) {
+			return shares.TruncateInt(), nil
+		},
+	}
+	k.bank = &bankKeeperMock{BurnCoinsFn: func(_ sdk.Context, _ string, _ sdk.Coins) error {
+		return errors.New("testing")
+	}}
+	_, err := k.SlashLien(pCtx, myDelegator, myValAddr, sdk.NewInt64Coin("stake", 10))
+	require.Error(t, err)
+	assert.Equal(t, sdk.NewInt64Coin("stake", 100), k.GetLien(pCtx, myDelegator, myValAddr))
+}
@@ -11,13 +11,17 @@ import (
 // RegisterLegacyAminoCodec registers the necessary x/meshsecurityprovider interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
-	cdc.RegisterConcrete(&MsgTest{}, "meshsecurityprovider/MsgTest", nil)
+	cdc.RegisterConcrete(&MsgStakeRemote{}, "meshsecurityprovider/MsgStakeRemote", nil)
+	cdc.RegisterConcrete(&MsgUnstakeRemote{}, "meshsecurityprovider/MsgUnstakeRemote", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "meshsecurityprovider/MsgUpdateParams", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
-		&MsgTest{},
+		&MsgStakeRemote{},
+		&MsgUnstakeRemote{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
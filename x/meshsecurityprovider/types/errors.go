@@ -1 +1,11 @@
 package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalid      = errorsmod.Register(ModuleName, 1, "invalid")
+	ErrUnauthorized = errorsmod.Register(ModuleName, 2, "unauthorized")
+	ErrUnsupported  = errorsmod.Register(ModuleName, 3, "unsupported")
+)
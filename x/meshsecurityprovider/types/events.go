@@ -1,5 +1,34 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	AttributeValueCategory = ModuleName
+
+	EventTypeRemoteStakeUpdated = "remote_stake_updated"
+	EventTypeBond               = "provider_bond"
+	EventTypeUnbond             = "provider_unbond"
+	EventTypeSlashLien          = "slash_lien"
 )
+
+const (
+	AttributeKeyConnectionID = "connection_id"
+	AttributeKeyContractAddr = "external_staking_contract"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyDelegator    = "delegator"
+)
+
+// EmitRemoteStakeUpdatedEvent emits an event signalling that the remote stake of a consumer connection was updated
+func EmitRemoteStakeUpdatedEvent(ctx sdk.Context, connectionID string, contractAddr sdk.AccAddress, total sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeRemoteStakeUpdated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(AttributeKeyContractAddr, contractAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+		),
+	)
+}
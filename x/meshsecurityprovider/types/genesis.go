@@ -1,9 +1,17 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesis returns the default meshsecurityprovider genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:       DefaultParams(),
+		RemoteStakes: []RemoteStake{},
+		Liens:        []Lien{},
 	}
 }
 
@@ -13,5 +21,37 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	connections := make(map[string]struct{}, len(gs.RemoteStakes))
+	for _, s := range gs.RemoteStakes {
+		if s.ConnectionId == "" {
+			return ErrInvalid.Wrap("remote stake: empty connection id")
+		}
+		if _, exists := connections[s.ConnectionId]; exists {
+			return ErrInvalid.Wrapf("duplicate remote stake connection id: %s", s.ConnectionId)
+		}
+		connections[s.ConnectionId] = struct{}{}
+		if err := s.Amount.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "remote stake amount for connection %s", s.ConnectionId)
+		}
+	}
+	liens := make(map[string]struct{}, len(gs.Liens))
+	for _, l := range gs.Liens {
+		delAddr, err := sdk.AccAddressFromBech32(l.Delegator)
+		if err != nil {
+			return errorsmod.Wrap(err, "lien delegator")
+		}
+		valAddr, err := sdk.ValAddressFromBech32(l.Validator)
+		if err != nil {
+			return errorsmod.Wrap(err, "lien validator")
+		}
+		key := string(BuildLienKey(delAddr, valAddr))
+		if _, exists := liens[key]; exists {
+			return ErrInvalid.Wrapf("duplicate lien for delegator %s and validator %s", l.Delegator, l.Validator)
+		}
+		liens[key] = struct{}{}
+		if err := l.Amount.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "lien amount for delegator %s", l.Delegator)
+		}
+	}
 	return nil
 }
@@ -6,7 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,7 +26,17 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Params defines the parameters for the x/meshsecurityprovider module.
 type Params struct {
+	// VaultAddress is the address of the vault contract that is authorised to
+	// use the module
+	VaultAddress string `protobuf:"bytes,1,opt,name=vault_address,json=vaultAddress,proto3" json:"vault_address,omitempty"`
+	// ExternalStakingContracts are the authorised external staking contracts,
+	// one per consumer connection
+	ExternalStakingContracts []ExternalStakingContract `protobuf:"bytes,2,rep,name=external_staking_contracts,json=externalStakingContracts,proto3" json:"external_staking_contracts"`
+	// NativeStakingAddress is the address of the native staking contract that
+	// is authorised to slash liens
+	NativeStakingAddress string `protobuf:"bytes,3,opt,name=native_staking_address,json=nativeStakingAddress,proto3" json:"native_staking_address,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,17 +72,218 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetVaultAddress() string {
+	if m != nil {
+		return m.VaultAddress
+	}
+	return ""
+}
+
+func (m *Params) GetExternalStakingContracts() []ExternalStakingContract {
+	if m != nil {
+		return m.ExternalStakingContracts
+	}
+	return nil
+}
+
+func (m *Params) GetNativeStakingAddress() string {
+	if m != nil {
+		return m.NativeStakingAddress
+	}
+	return ""
+}
+
+// ExternalStakingContract maps an external staking contract to the consumer
+// chain it stakes on
+type ExternalStakingContract struct {
+	// ConnectionID is the IBC connection to the consumer chain
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// ContractAddress is the address of the external staking contract
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *ExternalStakingContract) Reset()         { *m = ExternalStakingContract{} }
+func (m *ExternalStakingContract) String() string { return proto.CompactTextString(m) }
+func (*ExternalStakingContract) ProtoMessage()    {}
+func (*ExternalStakingContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78f837a040f3d391, []int{1}
+}
+func (m *ExternalStakingContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalStakingContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalStakingContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalStakingContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalStakingContract.Merge(m, src)
+}
+func (m *ExternalStakingContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalStakingContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalStakingContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalStakingContract proto.InternalMessageInfo
+
+func (m *ExternalStakingContract) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ExternalStakingContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// RemoteStake is the amount staked remotely on a consumer chain
+type RemoteStake struct {
+	// ConnectionID is the IBC connection to the consumer chain
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Amount is the total amount staked on the consumer chain
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *RemoteStake) Reset()         { *m = RemoteStake{} }
+func (m *RemoteStake) String() string { return proto.CompactTextString(m) }
+func (*RemoteStake) ProtoMessage()    {}
+func (*RemoteStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78f837a040f3d391, []int{2}
+}
+func (m *RemoteStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteStake.Merge(m, src)
+}
+func (m *RemoteStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteStake proto.InternalMessageInfo
+
+func (m *RemoteStake) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *RemoteStake) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// Lien is the amount bonded by the vault contract on behalf of a vault user
+// to a validator
+type Lien struct {
+	// Delegator is the address of the vault user
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// Amount is the bonded amount
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// Validator is the operator address of the validator bonded to
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *Lien) Reset()         { *m = Lien{} }
+func (m *Lien) String() string { return proto.CompactTextString(m) }
+func (*Lien) ProtoMessage()    {}
+func (*Lien) Descriptor() ([]byte, []int) {
+	return fileDescriptor_78f837a040f3d391, []int{3}
+}
+func (m *Lien) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Lien) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Lien.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Lien) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lien.Merge(m, src)
+}
+func (m *Lien) XXX_Size() int {
+	return m.Size()
+}
+func (m *Lien) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lien.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lien proto.InternalMessageInfo
+
+func (m *Lien) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *Lien) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *Lien) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 // GenesisState defines the meshsecurityprovider module's genesis state.
 type GenesisState struct {
 	// params is the container of meshsecurityprovider parameters.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// remote_stakes contains the amounts staked per consumer connection
+	RemoteStakes []RemoteStake `protobuf:"bytes,2,rep,name=remote_stakes,json=remoteStakes,proto3" json:"remote_stakes"`
+	// liens contains the amounts bonded on behalf of vault users per validator
+	Liens []Lien `protobuf:"bytes,3,rep,name=liens,proto3" json:"liens"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_78f837a040f3d391, []int{1}
+	return fileDescriptor_78f837a040f3d391, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -107,8 +319,25 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRemoteStakes() []RemoteStake {
+	if m != nil {
+		return m.RemoteStakes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiens() []Lien {
+	if m != nil {
+		return m.Liens
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.meshsecurityprovider.Params")
+	proto.RegisterType((*ExternalStakingContract)(nil), "osmosis.meshsecurityprovider.ExternalStakingContract")
+	proto.RegisterType((*RemoteStake)(nil), "osmosis.meshsecurityprovider.RemoteStake")
+	proto.RegisterType((*Lien)(nil), "osmosis.meshsecurityprovider.Lien")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.meshsecurityprovider.GenesisState")
 }
 
@@ -117,23 +346,42 @@ func init() {
 }
 
 var fileDescriptor_78f837a040f3d391 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4d, 0x2d, 0xce, 0x28, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9,
-	0x2c, 0x28, 0xca, 0x2f, 0xcb, 0x4c, 0x49, 0x2d, 0xd2, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0xc3, 0xa6, 0x56, 0x4a, 0x24,
-	0x3d, 0x3f, 0x3d, 0x1f, 0xac, 0x50, 0x1f, 0xc4, 0x82, 0xe8, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f,
-	0xcf, 0x49, 0xd5, 0x07, 0xf3, 0x92, 0x4a, 0xd3, 0xf4, 0x13, 0xf3, 0x2a, 0x61, 0x52, 0xc9, 0x60,
-	0xf3, 0xe2, 0x21, 0x7a, 0x20, 0x1c, 0x88, 0x94, 0x12, 0x07, 0x17, 0x5b, 0x40, 0x62, 0x51, 0x62,
-	0x6e, 0xb1, 0x52, 0x10, 0x17, 0x8f, 0x3b, 0xc4, 0x11, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x4e,
-	0x5c, 0x6c, 0x05, 0x60, 0x19, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x15, 0x3d, 0x7c, 0x8e,
-	0xd2, 0x83, 0x98, 0xe2, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xa7, 0x53, 0xc2, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xb9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0xcd, 0xd5, 0xcd, 0x49, 0x4c, 0x82, 0x84, 0x8e, 0x2e, 0xcc,
-	0x74, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x0a, 0xec, 0x21, 0x56, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4,
-	0x06, 0xf6, 0x86, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x0e, 0x47, 0xfc, 0x5e, 0x01, 0x00,
-	0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x8b, 0x13, 0x31,
+	0x14, 0x6e, 0xba, 0x6b, 0x61, 0xd3, 0x16, 0x25, 0x14, 0xed, 0x16, 0x99, 0x5d, 0xaa, 0x87, 0x2a,
+	0x74, 0xc6, 0x5d, 0x51, 0x4f, 0x0a, 0xb6, 0xa8, 0x08, 0x22, 0xd2, 0x7a, 0xf2, 0x52, 0xd3, 0x99,
+	0xc7, 0x6c, 0xd8, 0x99, 0xa4, 0x24, 0x69, 0xd9, 0x9e, 0xfd, 0x03, 0xe2, 0xc5, 0xff, 0xe0, 0xc5,
+	0x8b, 0x3f, 0x62, 0x8f, 0x8b, 0x27, 0x4f, 0x2a, 0xed, 0xc5, 0x9f, 0x21, 0x93, 0x64, 0xb6, 0x3d,
+	0xd4, 0xa9, 0xa7, 0x99, 0xe4, 0xbd, 0xef, 0x7b, 0xdf, 0xcb, 0xfb, 0x12, 0x7c, 0x57, 0xa8, 0x54,
+	0x28, 0xa6, 0x82, 0x14, 0xd4, 0x89, 0x82, 0x70, 0x2a, 0x99, 0x9e, 0x4f, 0xa4, 0x98, 0xb1, 0x08,
+	0x64, 0x10, 0x03, 0x07, 0xc5, 0x94, 0x3f, 0x91, 0x42, 0x0b, 0x72, 0xd3, 0xe5, 0xfa, 0x9b, 0x72,
+	0x5b, 0x8d, 0x58, 0xc4, 0xc2, 0x24, 0x06, 0xd9, 0x9f, 0xc5, 0xb4, 0xf6, 0x43, 0x03, 0x1a, 0xd9,
+	0x80, 0x5d, 0xb8, 0x90, 0x67, 0x57, 0xc1, 0x98, 0x2a, 0x08, 0x66, 0x47, 0x63, 0xd0, 0xf4, 0x28,
+	0x08, 0x05, 0xe3, 0x36, 0xde, 0xfe, 0x54, 0xc6, 0x95, 0x37, 0x54, 0xd2, 0x54, 0x91, 0xc7, 0xb8,
+	0x3e, 0xa3, 0xd3, 0x44, 0x8f, 0x68, 0x14, 0x49, 0x50, 0xaa, 0x89, 0x0e, 0x51, 0x67, 0xaf, 0xd7,
+	0xfc, 0xfe, 0xad, 0xdb, 0x70, 0x9c, 0x4f, 0x6d, 0x64, 0xa8, 0x25, 0xe3, 0xf1, 0xa0, 0x66, 0xd2,
+	0xdd, 0x1e, 0x99, 0xe3, 0x16, 0x9c, 0x69, 0x90, 0x9c, 0x26, 0x23, 0xa5, 0xe9, 0x29, 0xe3, 0xf1,
+	0x28, 0x14, 0x5c, 0x4b, 0x1a, 0x6a, 0xd5, 0x2c, 0x1f, 0xee, 0x74, 0xaa, 0xc7, 0x0f, 0xfc, 0xa2,
+	0xee, 0xfc, 0x67, 0x0e, 0x3f, 0xb4, 0xf0, 0xbe, 0x43, 0xf7, 0x76, 0xcf, 0x7f, 0x1e, 0x94, 0x06,
+	0x4d, 0xd8, 0x1c, 0x56, 0xe4, 0x35, 0xbe, 0xce, 0xa9, 0x66, 0x33, 0xb8, 0x2c, 0x9c, 0xb7, 0xb0,
+	0xb3, 0xa5, 0x85, 0x86, 0xc5, 0x39, 0x46, 0x17, 0x6b, 0x7f, 0x40, 0xf8, 0xc6, 0x3f, 0xb4, 0x90,
+	0x5b, 0xb8, 0x1e, 0x0a, 0xce, 0x21, 0xd4, 0x4c, 0xf0, 0x11, 0x8b, 0xec, 0x29, 0x0d, 0x6a, 0xab,
+	0xcd, 0x97, 0x11, 0xe9, 0xe3, 0x6b, 0x79, 0xeb, 0x97, 0x52, 0xca, 0x5b, 0xa4, 0x5c, 0xcd, 0x11,
+	0xb9, 0x8a, 0xcf, 0x08, 0x57, 0x07, 0x90, 0x0a, 0x6d, 0xe4, 0xc1, 0xff, 0x55, 0x0e, 0x71, 0x85,
+	0xa6, 0x62, 0xca, 0xb5, 0x3b, 0xf1, 0x7d, 0xdf, 0x15, 0xcb, 0x0c, 0xe0, 0x3b, 0x03, 0xf8, 0x7d,
+	0xc1, 0x78, 0xef, 0x5e, 0x76, 0xaa, 0x5f, 0x7e, 0x1d, 0x74, 0x62, 0xa6, 0x4f, 0xa6, 0x63, 0x3f,
+	0x14, 0xa9, 0xf3, 0x8e, 0xfb, 0x74, 0x55, 0x74, 0x1a, 0xe8, 0xf9, 0x04, 0x94, 0x01, 0xa8, 0x81,
+	0xa3, 0x6e, 0x7f, 0x45, 0x78, 0xf7, 0x15, 0x03, 0x4e, 0x1e, 0xe2, 0xbd, 0x08, 0x12, 0x88, 0xa9,
+	0x16, 0x72, 0xab, 0x5d, 0x56, 0xa9, 0xe4, 0xd1, 0x9a, 0x4a, 0x54, 0xac, 0xd2, 0xce, 0xde, 0xa5,
+	0x67, 0x05, 0x67, 0x34, 0x61, 0x91, 0x29, 0xb8, 0x6d, 0xb8, 0xab, 0xd4, 0xf6, 0x1f, 0x84, 0x6b,
+	0x2f, 0xec, 0x3d, 0x1b, 0x6a, 0xaa, 0x81, 0xf4, 0x70, 0x65, 0x62, 0x6c, 0x6f, 0x64, 0x57, 0x8f,
+	0x6f, 0x17, 0x3b, 0xd3, 0x5e, 0x91, 0x5c, 0x8c, 0x45, 0x92, 0xb7, 0xb8, 0x2e, 0xcd, 0x7c, 0x8c,
+	0xed, 0x20, 0x37, 0xf9, 0x9d, 0x62, 0xaa, 0xb5, 0x91, 0x3a, 0xbe, 0x9a, 0x5c, 0x6d, 0x29, 0xf2,
+	0x04, 0x5f, 0x49, 0x18, 0xf0, 0xcc, 0xbb, 0x19, 0x5b, 0xbb, 0x98, 0x2d, 0x1b, 0x83, 0xa3, 0xb1,
+	0xb0, 0xde, 0xfb, 0xf3, 0x85, 0x87, 0x2e, 0x16, 0x1e, 0xfa, 0xbd, 0xf0, 0xd0, 0xc7, 0xa5, 0x57,
+	0xba, 0x58, 0x7a, 0xa5, 0x1f, 0x4b, 0xaf, 0xf4, 0xee, 0xf9, 0xda, 0xa0, 0x1d, 0x69, 0x37, 0xa1,
+	0x63, 0xfb, 0x2c, 0x75, 0x73, 0x6a, 0x33, 0xf5, 0xb3, 0xcd, 0x4f, 0x95, 0x31, 0xc3, 0xb8, 0x62,
+	0x9e, 0x8e, 0xfb, 0x7f, 0x07, 0x00, 0xac, 0xab, 0x32, 0x23, 0xd7, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -156,6 +404,162 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NativeStakingAddress) > 0 {
+		i -= len(m.NativeStakingAddress)
+		copy(dAtA[i:], m.NativeStakingAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NativeStakingAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ExternalStakingContracts) > 0 {
+		for iNdEx := len(m.ExternalStakingContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalStakingContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.VaultAddress) > 0 {
+		i -= len(m.VaultAddress)
+		copy(dAtA[i:], m.VaultAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VaultAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalStakingContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalStakingContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalStakingContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Lien) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lien) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Lien) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -179,6 +583,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Liens) > 0 {
+		for iNdEx := len(m.Liens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RemoteStakes) > 0 {
+		for iNdEx := len(m.RemoteStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -209,6 +641,75 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.VaultAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ExternalStakingContracts) > 0 {
+		for _, e := range m.ExternalStakingContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.NativeStakingAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ExternalStakingContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Lien) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -220,6 +721,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RemoteStakes) > 0 {
+		for _, e := range m.RemoteStakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Liens) > 0 {
+		for _, e := range m.Liens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -258,13 +771,488 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalStakingContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalStakingContracts = append(m.ExternalStakingContracts, ExternalStakingContract{})
+			if err := m.ExternalStakingContracts[len(m.ExternalStakingContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeStakingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeStakingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalStakingContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalStakingContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalStakingContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lien) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lien: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lien: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if (iNdEx + skippy) > l {
@@ -341,6 +1329,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteStakes = append(m.RemoteStakes, RemoteStake{})
+			if err := m.RemoteStakes[len(m.RemoteStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liens = append(m.Liens, Lien{})
+			if err := m.Liens[len(m.Liens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,15 +1,45 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
-	ModuleName = "test_module"
+	// ModuleName defines the module name.
+	ModuleName = "meshsecurityprovider"
 
-	StoreKey = ModuleName
+	// StoreKey defines the primary module store key.
+	// It must not share a prefix with the meshsecurity module store key.
+	StoreKey = "meshprovider"
 
+	// RouterKey is the message route
 	RouterKey = ModuleName
 )
 
 var (
-	// Key defines the store key for test_module.
-	Key       = []byte{0x01}
-	ParamsKey = []byte{0x02}
+	// ParamsKey is the prefix for the module parameters
+	ParamsKey            = []byte{0x02}
+	RemoteStakeKeyPrefix = []byte{0x03}
+	LienKeyPrefix        = []byte{0x04}
 )
+
+// BuildRemoteStakeConnectionKeyPrefix build remote stake store key prefix for the given consumer connection
+func BuildRemoteStakeConnectionKeyPrefix(connectionID string) []byte {
+	return append(RemoteStakeKeyPrefix, address.MustLengthPrefix([]byte(connectionID))...)
+}
+
+// BuildRemoteStakeKey build remote stake store key for the given consumer connection and denom
+func BuildRemoteStakeKey(connectionID, denom string) []byte {
+	return append(BuildRemoteStakeConnectionKeyPrefix(connectionID), []byte(denom)...)
+}
+
+// BuildLienDelegatorKeyPrefix build the lien store key prefix for the given vault user
+func BuildLienDelegatorKeyPrefix(delegator sdk.AccAddress) []byte {
+	return append(LienKeyPrefix, address.MustLengthPrefix(delegator)...)
+}
+
+// BuildLienKey build the lien store key for the given vault user and validator
+func BuildLienKey(delegator sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(BuildLienDelegatorKeyPrefix(delegator), address.MustLengthPrefix(valAddr)...)
+}
@@ -1,35 +1,83 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-const (
-	TypeMsgTest = "test"
+var (
+	_ sdk.Msg = &MsgStakeRemote{}
+	_ sdk.Msg = &MsgUnstakeRemote{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
-var _ sdk.Msg = &MsgTest{}
-
-func (msg MsgTest) Route() string { return ModuleName }
-func (msg MsgTest) Type() string  { return TypeMsgTest }
-func (msg MsgTest) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Sender)
-	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+// ValidateBasic validate basic constraints
+func (msg MsgStakeRemote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(err, "amount")
+	}
+	if msg.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrap("zero amount")
 	}
-
 	return nil
 }
 
-func (msg MsgTest) GetSignBytes() []byte {
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgStakeRemote) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
-func (msg MsgTest) GetSigners() []sdk.AccAddress {
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
-	if err != nil {
-		panic(err)
+// GetSigners returns the expected signers for MsgStakeRemote.
+func (msg MsgStakeRemote) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic validate basic constraints
+func (msg MsgUnstakeRemote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(err, "amount")
 	}
+	if msg.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrap("zero amount")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUnstakeRemote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgUnstakeRemote.
+func (msg MsgUnstakeRemote) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+// ValidateBasic validate basic constraints
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return msg.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgUpdateParams.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -1,21 +1,18 @@
 package types
 
 import (
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
-)
+	errorsmod "cosmossdk.io/errors"
 
-// Parameter store keys.
-var (
-	KeyParamField = []byte("TODO: CHANGE ME")
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// ParamTable for meshsecurityprovider module.
-func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
-}
-
-func NewParams() Params {
-	return Params{}
+// NewParams constructor
+func NewParams(vaultAddress, nativeStakingAddress string, externalStakingContracts []ExternalStakingContract) Params {
+	return Params{
+		VaultAddress:             vaultAddress,
+		NativeStakingAddress:     nativeStakingAddress,
+		ExternalStakingContracts: externalStakingContracts,
+	}
 }
 
 // DefaultParams are the default meshsecurityprovider module parameters.
@@ -25,12 +22,51 @@ func DefaultParams() Params {
 
 // Validate validates params.
 func (p Params) Validate() error {
+	if p.VaultAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(p.VaultAddress); err != nil {
+			return errorsmod.Wrap(err, "vault address")
+		}
+	}
+	if p.NativeStakingAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(p.NativeStakingAddress); err != nil {
+			return errorsmod.Wrap(err, "native staking address")
+		}
+	}
+	connections := make(map[string]struct{}, len(p.ExternalStakingContracts))
+	contracts := make(map[string]struct{}, len(p.ExternalStakingContracts))
+	for _, c := range p.ExternalStakingContracts {
+		if err := c.Validate(); err != nil {
+			return errorsmod.Wrap(err, "external staking contract")
+		}
+		if _, exists := connections[c.ConnectionId]; exists {
+			return ErrInvalid.Wrapf("duplicate connection id: %s", c.ConnectionId)
+		}
+		connections[c.ConnectionId] = struct{}{}
+		if _, exists := contracts[c.ContractAddress]; exists {
+			return ErrInvalid.Wrapf("duplicate external staking contract: %s", c.ContractAddress)
+		}
+		contracts[c.ContractAddress] = struct{}{}
+	}
 	return nil
 }
 
-// Implements params.ParamSet.
-func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{
-		// paramtypes.NewParamSetPair(KeyParamField, &p.Field, validateFn),
+// GetExternalStakingContract returns the external staking contract registered for the given address
+func (p Params) GetExternalStakingContract(contractAddr sdk.AccAddress) (ExternalStakingContract, bool) {
+	for _, c := range p.ExternalStakingContracts {
+		if c.ContractAddress == contractAddr.String() {
+			return c, true
+		}
+	}
+	return ExternalStakingContract{}, false
+}
+
+// Validate validates the external staking contract
+func (c ExternalStakingContract) Validate() error {
+	if c.ConnectionId == "" {
+		return ErrInvalid.Wrap("empty connection id")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "contract address")
 	}
+	return nil
 }
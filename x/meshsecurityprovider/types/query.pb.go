@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -110,9 +112,184 @@ func (m *ParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// =============================== RemoteStake
+type RemoteStakeRequest struct {
+	// ConnectionID is the IBC connection to the consumer chain
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *RemoteStakeRequest) Reset()         { *m = RemoteStakeRequest{} }
+func (m *RemoteStakeRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoteStakeRequest) ProtoMessage()    {}
+func (*RemoteStakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a786d55f7d35ac36, []int{2}
+}
+func (m *RemoteStakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteStakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteStakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteStakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteStakeRequest.Merge(m, src)
+}
+func (m *RemoteStakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteStakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteStakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteStakeRequest proto.InternalMessageInfo
+
+func (m *RemoteStakeRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+type RemoteStakeResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *RemoteStakeResponse) Reset()         { *m = RemoteStakeResponse{} }
+func (m *RemoteStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoteStakeResponse) ProtoMessage()    {}
+func (*RemoteStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a786d55f7d35ac36, []int{3}
+}
+func (m *RemoteStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteStakeResponse.Merge(m, src)
+}
+func (m *RemoteStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteStakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteStakeResponse proto.InternalMessageInfo
+
+func (m *RemoteStakeResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// =============================== RemoteStakes
+type RemoteStakesRequest struct {
+}
+
+func (m *RemoteStakesRequest) Reset()         { *m = RemoteStakesRequest{} }
+func (m *RemoteStakesRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoteStakesRequest) ProtoMessage()    {}
+func (*RemoteStakesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a786d55f7d35ac36, []int{4}
+}
+func (m *RemoteStakesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteStakesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteStakesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteStakesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteStakesRequest.Merge(m, src)
+}
+func (m *RemoteStakesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteStakesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteStakesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteStakesRequest proto.InternalMessageInfo
+
+type RemoteStakesResponse struct {
+	RemoteStakes []RemoteStake `protobuf:"bytes,1,rep,name=remote_stakes,json=remoteStakes,proto3" json:"remote_stakes"`
+}
+
+func (m *RemoteStakesResponse) Reset()         { *m = RemoteStakesResponse{} }
+func (m *RemoteStakesResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoteStakesResponse) ProtoMessage()    {}
+func (*RemoteStakesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a786d55f7d35ac36, []int{5}
+}
+func (m *RemoteStakesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteStakesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteStakesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteStakesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteStakesResponse.Merge(m, src)
+}
+func (m *RemoteStakesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteStakesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteStakesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteStakesResponse proto.InternalMessageInfo
+
+func (m *RemoteStakesResponse) GetRemoteStakes() []RemoteStake {
+	if m != nil {
+		return m.RemoteStakes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.meshsecurityprovider.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.meshsecurityprovider.ParamsResponse")
+	proto.RegisterType((*RemoteStakeRequest)(nil), "osmosis.meshsecurityprovider.RemoteStakeRequest")
+	proto.RegisterType((*RemoteStakeResponse)(nil), "osmosis.meshsecurityprovider.RemoteStakeResponse")
+	proto.RegisterType((*RemoteStakesRequest)(nil), "osmosis.meshsecurityprovider.RemoteStakesRequest")
+	proto.RegisterType((*RemoteStakesResponse)(nil), "osmosis.meshsecurityprovider.RemoteStakesResponse")
 }
 
 func init() {
@@ -120,26 +297,40 @@ func init() {
 }
 
 var fileDescriptor_a786d55f7d35ac36 = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc8, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4d, 0x2d, 0xce, 0x28, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9,
-	0x2c, 0x28, 0xca, 0x2f, 0xcb, 0x4c, 0x49, 0x2d, 0xd2, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd4, 0xc3, 0xa6, 0x52, 0x4a, 0x24, 0x3d, 0x3f,
-	0x3d, 0x1f, 0xac, 0x50, 0x1f, 0xc4, 0x82, 0xe8, 0x91, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49,
-	0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b,
-	0x86, 0xca, 0x6a, 0xe1, 0xb5, 0x3b, 0x3d, 0x35, 0x2f, 0x15, 0x64, 0x1d, 0x58, 0xad, 0x12, 0x3f,
-	0x17, 0x6f, 0x40, 0x62, 0x51, 0x62, 0x6e, 0x71, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x52,
-	0x08, 0x17, 0x1f, 0x4c, 0xa0, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0xc8, 0x89, 0x8b, 0xad, 0x00,
-	0x2c, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa2, 0x87, 0xcf, 0xc5, 0x7a, 0x10, 0xdd,
-	0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x1a, 0xcd, 0x66, 0xe4, 0x62, 0x0d, 0x04,
-	0x79, 0x5a, 0x68, 0x22, 0x23, 0x17, 0x1b, 0x44, 0x89, 0x90, 0x36, 0x31, 0x06, 0x41, 0xdd, 0x25,
-	0xa5, 0x43, 0x9c, 0x62, 0x88, 0x9b, 0x95, 0x74, 0x9a, 0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x26, 0xa4,
-	0xa2, 0x8f, 0x37, 0x2c, 0xa0, 0x6e, 0x4d, 0x38, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6,
-	0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39,
-	0x86, 0x28, 0xb7, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x98, 0x49, 0xba,
-	0x39, 0x89, 0x49, 0x10, 0xe3, 0x74, 0x61, 0xe6, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x57, 0x60, 0xb7,
-	0xa2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xda, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xe6, 0x2d, 0xbd, 0xe8, 0x17, 0x02, 0x00, 0x00,
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x28, 0x91, 0xd8, 0x24, 0x20, 0x2d, 0x45, 0x2a, 0x51, 0xe5, 0x56, 0xa6, 0x42,
+	0x81, 0x36, 0xde, 0x26, 0x3d, 0x81, 0x38, 0x19, 0x09, 0x89, 0x1b, 0x98, 0x9e, 0xb8, 0x94, 0xb5,
+	0xb3, 0x72, 0x57, 0x8d, 0x77, 0x5c, 0xef, 0xba, 0x22, 0x20, 0x0e, 0xf0, 0x04, 0x20, 0xde, 0xa2,
+	0x0f, 0xc0, 0x33, 0xf4, 0x58, 0x89, 0x0b, 0x27, 0x40, 0x09, 0x0f, 0x52, 0x79, 0xbd, 0x69, 0x1d,
+	0xa9, 0xb2, 0x9c, 0x53, 0xa2, 0xd9, 0xf9, 0x67, 0xbe, 0xf9, 0x67, 0x64, 0xd4, 0x03, 0x19, 0x83,
+	0xe4, 0x92, 0xc4, 0x4c, 0x1e, 0x4a, 0x16, 0x66, 0x29, 0x57, 0x93, 0x24, 0x85, 0x13, 0x3e, 0x62,
+	0x29, 0x39, 0xce, 0x58, 0x3a, 0x71, 0x93, 0x14, 0x14, 0xe0, 0x75, 0x93, 0xe9, 0x5e, 0x97, 0xd9,
+	0x5d, 0x8d, 0x20, 0x02, 0x9d, 0x48, 0xf2, 0x7f, 0x85, 0xa6, 0xbb, 0x1e, 0x01, 0x44, 0x63, 0x46,
+	0x68, 0xc2, 0x09, 0x15, 0x02, 0x14, 0x55, 0x1c, 0x84, 0x34, 0xaf, 0x76, 0xa8, 0x4b, 0x92, 0x80,
+	0x4a, 0x46, 0x4e, 0x06, 0x01, 0x53, 0x74, 0x40, 0x42, 0xe0, 0xc2, 0xbc, 0x3f, 0xa9, 0x64, 0x8b,
+	0x98, 0x60, 0x39, 0x8e, 0xce, 0x75, 0xee, 0xa2, 0xce, 0x6b, 0x9a, 0xd2, 0x58, 0xfa, 0xec, 0x38,
+	0x63, 0x52, 0x39, 0xfb, 0xe8, 0xce, 0x3c, 0x20, 0x13, 0x10, 0x92, 0x61, 0x0f, 0x35, 0x13, 0x1d,
+	0x59, 0xb3, 0x36, 0xad, 0x5e, 0x6b, 0xb8, 0xe5, 0x56, 0x4d, 0xe4, 0x16, 0x6a, 0x6f, 0xe5, 0xec,
+	0xcf, 0x46, 0xc3, 0x37, 0x4a, 0xe7, 0x29, 0xc2, 0x3e, 0x8b, 0x41, 0xb1, 0xb7, 0x8a, 0x1e, 0x31,
+	0xd3, 0x0b, 0x3f, 0x44, 0x9d, 0x10, 0x84, 0x60, 0x61, 0x3e, 0xdd, 0x01, 0x1f, 0xe9, 0x06, 0xb7,
+	0xfd, 0xf6, 0x55, 0xf0, 0xd5, 0xc8, 0xf9, 0x88, 0xee, 0x2d, 0x48, 0x0d, 0x55, 0x88, 0x9a, 0x34,
+	0x86, 0x4c, 0xa8, 0x35, 0x6b, 0xf3, 0x66, 0xaf, 0x35, 0x7c, 0xe0, 0x16, 0xae, 0xb8, 0xb9, 0x2b,
+	0xae, 0x71, 0xc5, 0x7d, 0x01, 0x5c, 0x78, 0xbb, 0x39, 0xca, 0xe9, 0xdf, 0x8d, 0x5e, 0xc4, 0xd5,
+	0x61, 0x16, 0xb8, 0x21, 0xc4, 0xc4, 0x58, 0x58, 0xfc, 0xf4, 0xe5, 0xe8, 0x88, 0xa8, 0x49, 0xc2,
+	0xa4, 0x16, 0x48, 0xdf, 0x94, 0x76, 0xee, 0x2f, 0xf4, 0xbe, 0xf4, 0x68, 0x8c, 0x56, 0x17, 0xc3,
+	0x86, 0x69, 0x1f, 0x75, 0x52, 0x1d, 0x3f, 0x90, 0xfa, 0xc1, 0xa0, 0x3d, 0xae, 0x36, 0xac, 0x54,
+	0xca, 0xb8, 0xd6, 0x4e, 0xaf, 0x42, 0x72, 0xf8, 0x65, 0x05, 0xdd, 0x7a, 0x93, 0x1f, 0x14, 0xfe,
+	0x6e, 0xa1, 0x66, 0x61, 0x2f, 0xde, 0xae, 0xb3, 0x04, 0xc3, 0xdb, 0xdd, 0xa9, 0x97, 0x5c, 0x4c,
+	0xe1, 0xec, 0x7c, 0xfd, 0xf5, 0xff, 0xc7, 0x8d, 0x47, 0x78, 0x8b, 0x54, 0xde, 0x91, 0x01, 0xf9,
+	0x69, 0xa1, 0x56, 0x69, 0x02, 0xbc, 0x5b, 0x7b, 0xd8, 0x39, 0xdd, 0x60, 0x09, 0x85, 0x41, 0xf4,
+	0x34, 0xe2, 0x73, 0xfc, 0xac, 0x1a, 0xb1, 0xbc, 0x0c, 0xf2, 0x69, 0xe1, 0xd4, 0x3e, 0xe3, 0x53,
+	0x0b, 0xb5, 0xcb, 0x5b, 0xc4, 0xf5, 0x39, 0x2e, 0x8d, 0x1d, 0x2e, 0x23, 0x31, 0xec, 0x7b, 0x9a,
+	0xbd, 0x8f, 0xb7, 0xeb, 0xb3, 0x4b, 0xef, 0xfd, 0xd9, 0xd4, 0xb6, 0xce, 0xa7, 0xb6, 0xf5, 0x6f,
+	0x6a, 0x5b, 0xdf, 0x66, 0x76, 0xe3, 0x7c, 0x66, 0x37, 0x7e, 0xcf, 0xec, 0xc6, 0xbb, 0x97, 0xa5,
+	0xa3, 0x36, 0x05, 0xfb, 0x63, 0x1a, 0x14, 0x55, 0xfb, 0xf3, 0xb2, 0xfa, 0xc2, 0x3f, 0x5c, 0xdf,
+	0x49, 0x1f, 0x7e, 0xd0, 0xd4, 0xdf, 0x83, 0xbd, 0x8b, 0x01, 0x00, 0x30, 0x80, 0x4d, 0x28, 0xd9,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,7 +345,13 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params queries the parameters of x/meshsecurityprovider module.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// RemoteStake gets the amount staked remotely for the given consumer
+	// connection
+	RemoteStake(ctx context.Context, in *RemoteStakeRequest, opts ...grpc.CallOption) (*RemoteStakeResponse, error)
+	// RemoteStakes gets the amounts staked remotely for all consumer connections
+	RemoteStakes(ctx context.Context, in *RemoteStakesRequest, opts ...grpc.CallOption) (*RemoteStakesResponse, error)
 }
 
 type queryClient struct {
@@ -174,9 +371,33 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) RemoteStake(ctx context.Context, in *RemoteStakeRequest, opts ...grpc.CallOption) (*RemoteStakeResponse, error) {
+	out := new(RemoteStakeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurityprovider.Query/RemoteStake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RemoteStakes(ctx context.Context, in *RemoteStakesRequest, opts ...grpc.CallOption) (*RemoteStakesResponse, error) {
+	out := new(RemoteStakesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurityprovider.Query/RemoteStakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params queries the parameters of x/meshsecurityprovider module.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// RemoteStake gets the amount staked remotely for the given consumer
+	// connection
+	RemoteStake(context.Context, *RemoteStakeRequest) (*RemoteStakeResponse, error)
+	// RemoteStakes gets the amounts staked remotely for all consumer connections
+	RemoteStakes(context.Context, *RemoteStakesRequest) (*RemoteStakesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -186,6 +407,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RemoteStake(ctx context.Context, req *RemoteStakeRequest) (*RemoteStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteStake not implemented")
+}
+func (*UnimplementedQueryServer) RemoteStakes(ctx context.Context, req *RemoteStakesRequest) (*RemoteStakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoteStakes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -209,6 +436,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RemoteStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteStakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemoteStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurityprovider.Query/RemoteStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemoteStake(ctx, req.(*RemoteStakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RemoteStakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteStakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RemoteStakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurityprovider.Query/RemoteStakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RemoteStakes(ctx, req.(*RemoteStakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.meshsecurityprovider.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -217,6 +480,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RemoteStake",
+			Handler:    _Query_RemoteStake_Handler,
+		},
+		{
+			MethodName: "RemoteStakes",
+			Handler:    _Query_RemoteStakes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/meshsecurityprovider/query.proto",
@@ -278,6 +549,133 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RemoteStakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteStakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteStakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteStakesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteStakesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteStakesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteStakesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteStakesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteStakesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemoteStakes) > 0 {
+		for iNdEx := len(m.RemoteStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoteStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -309,17 +707,69 @@ func (m *ParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *RemoteStakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *RemoteStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *RemoteStakesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoteStakesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemoteStakes) > 0 {
+		for _, e := range m.RemoteStakes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -448,6 +898,306 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RemoteStakeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteStakeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteStakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteStakesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteStakesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteStakesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteStakesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteStakesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteStakesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteStakes = append(m.RemoteStakes, RemoteStake{})
+			if err := m.RemoteStakes[len(m.RemoteStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
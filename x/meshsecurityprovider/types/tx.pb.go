@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -30,23 +30,26 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// ===================== MsgTest
-type MsgTest struct {
+// ===================== MsgStakeRemote
+type MsgStakeRemote struct {
+	// sender is the address of the external staking contract
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// amount is the stake added on the consumer chain
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgTest) Reset()         { *m = MsgTest{} }
-func (m *MsgTest) String() string { return proto.CompactTextString(m) }
-func (*MsgTest) ProtoMessage()    {}
-func (*MsgTest) Descriptor() ([]byte, []int) {
+func (m *MsgStakeRemote) Reset()         { *m = MsgStakeRemote{} }
+func (m *MsgStakeRemote) String() string { return proto.CompactTextString(m) }
+func (*MsgStakeRemote) ProtoMessage()    {}
+func (*MsgStakeRemote) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2777f63b77ca86c2, []int{0}
 }
-func (m *MsgTest) XXX_Unmarshal(b []byte) error {
+func (m *MsgStakeRemote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgTest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgStakeRemote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgTest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgStakeRemote.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -56,40 +59,138 @@ func (m *MsgTest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgTest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTest.Merge(m, src)
+func (m *MsgStakeRemote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStakeRemote.Merge(m, src)
 }
-func (m *MsgTest) XXX_Size() int {
+func (m *MsgStakeRemote) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgTest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTest.DiscardUnknown(m)
+func (m *MsgStakeRemote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStakeRemote.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgTest proto.InternalMessageInfo
+var xxx_messageInfo_MsgStakeRemote proto.InternalMessageInfo
 
-func (m *MsgTest) GetSender() string {
+func (m *MsgStakeRemote) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-type MsgTestResponse struct {
+func (m *MsgStakeRemote) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type MsgStakeRemoteResponse struct {
 }
 
-func (m *MsgTestResponse) Reset()         { *m = MsgTestResponse{} }
-func (m *MsgTestResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgTestResponse) ProtoMessage()    {}
-func (*MsgTestResponse) Descriptor() ([]byte, []int) {
+func (m *MsgStakeRemoteResponse) Reset()         { *m = MsgStakeRemoteResponse{} }
+func (m *MsgStakeRemoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStakeRemoteResponse) ProtoMessage()    {}
+func (*MsgStakeRemoteResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2777f63b77ca86c2, []int{1}
 }
-func (m *MsgTestResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgStakeRemoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStakeRemoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStakeRemoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStakeRemoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStakeRemoteResponse.Merge(m, src)
+}
+func (m *MsgStakeRemoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStakeRemoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStakeRemoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStakeRemoteResponse proto.InternalMessageInfo
+
+// ===================== MsgUnstakeRemote
+type MsgUnstakeRemote struct {
+	// sender is the address of the external staking contract
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// amount is the stake removed from the consumer chain
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgUnstakeRemote) Reset()         { *m = MsgUnstakeRemote{} }
+func (m *MsgUnstakeRemote) String() string { return proto.CompactTextString(m) }
+func (*MsgUnstakeRemote) ProtoMessage()    {}
+func (*MsgUnstakeRemote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2777f63b77ca86c2, []int{2}
+}
+func (m *MsgUnstakeRemote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnstakeRemote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnstakeRemote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnstakeRemote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnstakeRemote.Merge(m, src)
+}
+func (m *MsgUnstakeRemote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnstakeRemote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnstakeRemote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnstakeRemote proto.InternalMessageInfo
+
+func (m *MsgUnstakeRemote) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUnstakeRemote) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+type MsgUnstakeRemoteResponse struct {
+}
+
+func (m *MsgUnstakeRemoteResponse) Reset()         { *m = MsgUnstakeRemoteResponse{} }
+func (m *MsgUnstakeRemoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnstakeRemoteResponse) ProtoMessage()    {}
+func (*MsgUnstakeRemoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2777f63b77ca86c2, []int{3}
+}
+func (m *MsgUnstakeRemoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgTestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUnstakeRemoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgTestResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUnstakeRemoteResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -99,26 +200,24 @@ func (m *MsgTestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgTestResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgTestResponse.Merge(m, src)
+func (m *MsgUnstakeRemoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnstakeRemoteResponse.Merge(m, src)
 }
-func (m *MsgTestResponse) XXX_Size() int {
+func (m *MsgUnstakeRemoteResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgTestResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgTestResponse.DiscardUnknown(m)
+func (m *MsgUnstakeRemoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnstakeRemoteResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgTestResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUnstakeRemoteResponse proto.InternalMessageInfo
 
 // MsgUpdateParams is the Msg/UpdateParams request type.
-//
-// Since: cosmos-sdk 0.47
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless
 	// overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/staking parameters to update.
+	// params defines the x/meshsecurityprovider parameters to update.
 	//
 	// NOTE: All parameters must be supplied.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
@@ -128,7 +227,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2777f63b77ca86c2, []int{2}
+	return fileDescriptor_2777f63b77ca86c2, []int{4}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,8 +272,6 @@ func (m *MsgUpdateParams) GetParams() Params {
 
 // MsgUpdateParamsResponse defines the response structure for executing a
 // MsgUpdateParams message.
-//
-// Since: cosmos-sdk 0.47
 type MsgUpdateParamsResponse struct {
 }
 
@@ -182,7 +279,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2777f63b77ca86c2, []int{3}
+	return fileDescriptor_2777f63b77ca86c2, []int{5}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,8 +309,10 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 func init() {
-	proto.RegisterType((*MsgTest)(nil), "osmosis.meshsecurityprovider.MsgTest")
-	proto.RegisterType((*MsgTestResponse)(nil), "osmosis.meshsecurityprovider.MsgTestResponse")
+	proto.RegisterType((*MsgStakeRemote)(nil), "osmosis.meshsecurityprovider.MsgStakeRemote")
+	proto.RegisterType((*MsgStakeRemoteResponse)(nil), "osmosis.meshsecurityprovider.MsgStakeRemoteResponse")
+	proto.RegisterType((*MsgUnstakeRemote)(nil), "osmosis.meshsecurityprovider.MsgUnstakeRemote")
+	proto.RegisterType((*MsgUnstakeRemoteResponse)(nil), "osmosis.meshsecurityprovider.MsgUnstakeRemoteResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "osmosis.meshsecurityprovider.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "osmosis.meshsecurityprovider.MsgUpdateParamsResponse")
 }
@@ -223,32 +322,37 @@ func init() {
 }
 
 var fileDescriptor_2777f63b77ca86c2 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4d, 0x2d, 0xce, 0x28, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9,
-	0x2c, 0x28, 0xca, 0x2f, 0xcb, 0x4c, 0x49, 0x2d, 0xd2, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x81, 0x2a, 0xd3, 0xc3, 0xa6, 0x4c, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xac,
-	0x50, 0x1f, 0xc4, 0x82, 0xe8, 0x91, 0x12, 0x4f, 0x06, 0x6b, 0xd2, 0xcf, 0x2d, 0x4e, 0xd7, 0x2f,
-	0x33, 0x04, 0x51, 0x50, 0x09, 0x39, 0xa8, 0x44, 0x52, 0x62, 0x71, 0xaa, 0x7e, 0x99, 0x61, 0x52,
-	0x6a, 0x49, 0xa2, 0xa1, 0x7e, 0x72, 0x7e, 0x66, 0x1e, 0x54, 0x5e, 0x0b, 0xaf, 0x9b, 0xd2, 0x53,
-	0xf3, 0x52, 0x41, 0x2e, 0x01, 0xab, 0x55, 0x32, 0xe1, 0x62, 0xf7, 0x2d, 0x4e, 0x0f, 0x49, 0x2d,
-	0x2e, 0x11, 0xd2, 0xe4, 0x62, 0x2b, 0x4e, 0xcd, 0x4b, 0x49, 0x2d, 0x92, 0x60, 0x54, 0x60, 0xd4,
-	0xe0, 0x74, 0x12, 0xfc, 0x74, 0x4f, 0x9e, 0xb7, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0x22, 0xae,
-	0x14, 0x04, 0x55, 0xa0, 0x24, 0xc8, 0xc5, 0x0f, 0xd5, 0x15, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57,
-	0x9c, 0xaa, 0xd4, 0xcc, 0x08, 0x16, 0x0b, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0x0d, 0x48, 0x2c, 0x4a,
-	0xcc, 0x2d, 0x16, 0x92, 0xe1, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8, 0x07, 0xd9, 0x0f, 0x31, 0x34,
-	0x08, 0x21, 0x20, 0xe4, 0xc4, 0xc5, 0x56, 0x00, 0x56, 0x27, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x6d,
-	0xa4, 0xa2, 0x87, 0x2f, 0x90, 0xf4, 0x20, 0x66, 0x3a, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04,
-	0xd5, 0x69, 0xc5, 0xd7, 0xf4, 0x7c, 0x83, 0x16, 0xc2, 0x4c, 0x25, 0x49, 0x2e, 0x71, 0x34, 0x47,
-	0xc0, 0x1c, 0x68, 0xf4, 0x80, 0x91, 0x8b, 0xd9, 0xb7, 0x38, 0x5d, 0x28, 0x86, 0x8b, 0x05, 0xec,
-	0x5d, 0x55, 0xfc, 0xd6, 0x41, 0xfd, 0x27, 0xa5, 0x4b, 0x94, 0x32, 0x98, 0x2d, 0x42, 0x25, 0x5c,
-	0x3c, 0x28, 0x41, 0x40, 0x58, 0x3b, 0xb2, 0x72, 0x29, 0x53, 0x92, 0x94, 0xc3, 0x6c, 0x95, 0x62,
-	0x6d, 0x78, 0xbe, 0x41, 0x8b, 0xd1, 0x29, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18,
-	0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5,
-	0x18, 0xa2, 0xdc, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xa1, 0x36,
-	0xe8, 0xe6, 0x24, 0x26, 0x41, 0x92, 0x88, 0x2e, 0xcc, 0x1e, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x0a,
-	0x1c, 0x49, 0xb9, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0x6a, 0x8c, 0x01, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xbe, 0x97, 0x85, 0x04, 0xf7, 0x02, 0x00, 0x00,
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xb3, 0x05, 0x22, 0x75, 0x43, 0x0b, 0x58, 0x88, 0xa6, 0x56, 0xe5, 0x56, 0x16, 0x48,
+	0x25, 0x22, 0xbb, 0x4a, 0xf9, 0x92, 0x72, 0x0c, 0x12, 0xb7, 0x48, 0xc8, 0x88, 0x0b, 0x27, 0xd6,
+	0xc9, 0xc8, 0xb1, 0xda, 0xf5, 0x1a, 0xcf, 0x3a, 0x34, 0x37, 0x3e, 0x5e, 0x80, 0x47, 0xe9, 0x63,
+	0xf4, 0xd8, 0x23, 0xa7, 0x0a, 0x25, 0x87, 0xde, 0x38, 0xf0, 0x04, 0xc8, 0xf6, 0x9a, 0xc6, 0x15,
+	0x24, 0xca, 0xa9, 0xa7, 0x44, 0x3b, 0xbf, 0x99, 0xff, 0x4f, 0x1e, 0xaf, 0xe9, 0x23, 0x85, 0x52,
+	0x61, 0x88, 0x5c, 0x02, 0x8e, 0x10, 0x06, 0x69, 0x12, 0xea, 0x49, 0x9c, 0xa8, 0x71, 0x38, 0x84,
+	0x84, 0xeb, 0x63, 0x16, 0x27, 0x4a, 0x2b, 0x6b, 0xc7, 0x60, 0xec, 0x5f, 0x98, 0x7d, 0x3f, 0x50,
+	0x81, 0xca, 0x41, 0x9e, 0xfd, 0x2b, 0x7a, 0xec, 0xad, 0x41, 0xde, 0xc4, 0x25, 0x06, 0x7c, 0xdc,
+	0xc9, 0x7e, 0x4c, 0xc1, 0x31, 0x05, 0x5f, 0x20, 0xf0, 0x71, 0xc7, 0x07, 0x2d, 0x3a, 0x7c, 0xa0,
+	0xc2, 0xc8, 0xd4, 0x5b, 0x0b, 0x9d, 0x02, 0x88, 0x20, 0x33, 0xc9, 0x59, 0xf7, 0x0b, 0xa1, 0x9b,
+	0x7d, 0x0c, 0xde, 0x6a, 0x71, 0x08, 0x1e, 0x48, 0xa5, 0xc1, 0x7a, 0x4c, 0xeb, 0x08, 0xd1, 0x10,
+	0x92, 0x26, 0xd9, 0x23, 0xfb, 0xeb, 0xbd, 0x7b, 0xbf, 0xcf, 0x77, 0x37, 0x26, 0x42, 0x1e, 0x75,
+	0xdd, 0xe2, 0xdc, 0xf5, 0x0c, 0x60, 0xbd, 0xa4, 0x75, 0x21, 0x55, 0x1a, 0xe9, 0xe6, 0xda, 0x1e,
+	0xd9, 0x6f, 0x1c, 0x6c, 0xb3, 0x42, 0x8d, 0x65, 0x6a, 0xcc, 0xa8, 0xb1, 0x57, 0x2a, 0x8c, 0x7a,
+	0x37, 0x4f, 0xcf, 0x77, 0x6b, 0x9e, 0xc1, 0xbb, 0x8d, 0xaf, 0x17, 0x27, 0x2d, 0x33, 0xc5, 0x6d,
+	0xd2, 0x07, 0x55, 0x05, 0x0f, 0x30, 0x56, 0x11, 0x82, 0xfb, 0x8d, 0xd0, 0xbb, 0x7d, 0x0c, 0xde,
+	0x45, 0x78, 0x9d, 0x7e, 0x36, 0x6d, 0x5e, 0x95, 0x98, 0x37, 0xbc, 0x93, 0x15, 0xe3, 0xa1, 0xd0,
+	0xf0, 0x46, 0x24, 0x42, 0xa2, 0xb5, 0x43, 0xd7, 0x45, 0xaa, 0x47, 0x2a, 0x7b, 0xec, 0x85, 0xa3,
+	0x77, 0x79, 0x60, 0xf5, 0x68, 0x3d, 0xce, 0x39, 0xe3, 0xf4, 0x90, 0x2d, 0x7a, 0x37, 0x58, 0x31,
+	0xb3, 0xd4, 0x2b, 0x3a, 0xbb, 0x9b, 0x99, 0xde, 0xe5, 0x4c, 0x77, 0x9b, 0x6e, 0x5d, 0x91, 0x28,
+	0x05, 0x0f, 0x7e, 0xad, 0xd1, 0x1b, 0x7d, 0x0c, 0xac, 0x8f, 0xb4, 0x31, 0xbf, 0xe4, 0x27, 0x8b,
+	0x53, 0xab, 0xfb, 0xb0, 0x9f, 0xad, 0x42, 0x97, 0xd1, 0xd6, 0x27, 0xba, 0x51, 0xdd, 0x1c, 0x5b,
+	0x3a, 0xa6, 0xc2, 0xdb, 0x2f, 0x56, 0xe3, 0xff, 0x06, 0x6b, 0x7a, 0xbb, 0xb2, 0x90, 0xf6, 0xf2,
+	0x39, 0x73, 0xb8, 0xfd, 0x7c, 0x25, 0xbc, 0x4c, 0xb5, 0x6f, 0x7d, 0xbe, 0x38, 0x69, 0x91, 0xde,
+	0x87, 0xd3, 0xa9, 0x43, 0xce, 0xa6, 0x0e, 0xf9, 0x39, 0x75, 0xc8, 0xf7, 0x99, 0x53, 0x3b, 0x9b,
+	0x39, 0xb5, 0x1f, 0x33, 0xa7, 0xf6, 0xfe, 0x75, 0x10, 0xea, 0x51, 0xea, 0xb3, 0x81, 0x92, 0xdc,
+	0x24, 0xb4, 0x8f, 0x84, 0x5f, 0xdc, 0xd3, 0x76, 0x99, 0xd3, 0xc6, 0xe1, 0x21, 0x3f, 0xfe, 0xcf,
+	0xf7, 0x64, 0x12, 0x03, 0xfa, 0xf5, 0xfc, 0xea, 0x3e, 0xfd, 0x33, 0x00, 0x37, 0xcc, 0xe9, 0x0d,
+	0x7c, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -263,10 +367,14 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
-	Test(ctx context.Context, in *MsgTest, opts ...grpc.CallOption) (*MsgTestResponse, error)
-	// UpdateParams defines an operation for updating the x/staking module
-	// parameters.
-	// Since: cosmos-sdk 0.47
+	// StakeRemote is sent by an external staking contract to record new stake
+	// on its consumer chain
+	StakeRemote(ctx context.Context, in *MsgStakeRemote, opts ...grpc.CallOption) (*MsgStakeRemoteResponse, error)
+	// UnstakeRemote is sent by an external staking contract to record stake
+	// removed from its consumer chain
+	UnstakeRemote(ctx context.Context, in *MsgUnstakeRemote, opts ...grpc.CallOption) (*MsgUnstakeRemoteResponse, error)
+	// UpdateParams defines an operation for updating the x/meshsecurityprovider
+	// module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
@@ -278,9 +386,18 @@ func NewMsgClient(cc grpc1.ClientConn) MsgClient {
 	return &msgClient{cc}
 }
 
-func (c *msgClient) Test(ctx context.Context, in *MsgTest, opts ...grpc.CallOption) (*MsgTestResponse, error) {
-	out := new(MsgTestResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.meshsecurityprovider.Msg/Test", in, out, opts...)
+func (c *msgClient) StakeRemote(ctx context.Context, in *MsgStakeRemote, opts ...grpc.CallOption) (*MsgStakeRemoteResponse, error) {
+	out := new(MsgStakeRemoteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurityprovider.Msg/StakeRemote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnstakeRemote(ctx context.Context, in *MsgUnstakeRemote, opts ...grpc.CallOption) (*MsgUnstakeRemoteResponse, error) {
+	out := new(MsgUnstakeRemoteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.meshsecurityprovider.Msg/UnstakeRemote", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -298,10 +415,14 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
-	Test(context.Context, *MsgTest) (*MsgTestResponse, error)
-	// UpdateParams defines an operation for updating the x/staking module
-	// parameters.
-	// Since: cosmos-sdk 0.47
+	// StakeRemote is sent by an external staking contract to record new stake
+	// on its consumer chain
+	StakeRemote(context.Context, *MsgStakeRemote) (*MsgStakeRemoteResponse, error)
+	// UnstakeRemote is sent by an external staking contract to record stake
+	// removed from its consumer chain
+	UnstakeRemote(context.Context, *MsgUnstakeRemote) (*MsgUnstakeRemoteResponse, error)
+	// UpdateParams defines an operation for updating the x/meshsecurityprovider
+	// module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
@@ -309,8 +430,11 @@ type MsgServer interface {
 type UnimplementedMsgServer struct {
 }
 
-func (*UnimplementedMsgServer) Test(ctx context.Context, req *MsgTest) (*MsgTestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Test not implemented")
+func (*UnimplementedMsgServer) StakeRemote(ctx context.Context, req *MsgStakeRemote) (*MsgStakeRemoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeRemote not implemented")
+}
+func (*UnimplementedMsgServer) UnstakeRemote(ctx context.Context, req *MsgUnstakeRemote) (*MsgUnstakeRemoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstakeRemote not implemented")
 }
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
@@ -320,20 +444,38 @@ func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func _Msg_Test_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTest)
+func _Msg_StakeRemote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStakeRemote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StakeRemote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.meshsecurityprovider.Msg/StakeRemote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StakeRemote(ctx, req.(*MsgStakeRemote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnstakeRemote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstakeRemote)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Test(ctx, in)
+		return srv.(MsgServer).UnstakeRemote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.meshsecurityprovider.Msg/Test",
+		FullMethod: "/osmosis.meshsecurityprovider.Msg/UnstakeRemote",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Test(ctx, req.(*MsgTest))
+		return srv.(MsgServer).UnstakeRemote(ctx, req.(*MsgUnstakeRemote))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -361,8 +503,12 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Test",
-			Handler:    _Msg_Test_Handler,
+			MethodName: "StakeRemote",
+			Handler:    _Msg_StakeRemote_Handler,
+		},
+		{
+			MethodName: "UnstakeRemote",
+			Handler:    _Msg_UnstakeRemote_Handler,
 		},
 		{
 			MethodName: "UpdateParams",
@@ -373,7 +519,70 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 	Metadata: "osmosis/meshsecurityprovider/tx.proto",
 }
 
-func (m *MsgTest) Marshal() (dAtA []byte, err error) {
+func (m *MsgStakeRemote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeRemote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeRemote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeRemoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeRemoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeRemoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeRemote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -383,16 +592,26 @@ func (m *MsgTest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUnstakeRemote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUnstakeRemote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -403,7 +622,7 @@ func (m *MsgTest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTestResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUnstakeRemoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -413,12 +632,12 @@ func (m *MsgTestResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTestResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUnstakeRemoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUnstakeRemoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -500,7 +719,7 @@ func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *MsgTest) Size() (n int) {
+func (m *MsgStakeRemote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -510,10 +729,36 @@ func (m *MsgTest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgTestResponse) Size() (n int) {
+func (m *MsgStakeRemoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnstakeRemote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUnstakeRemoteResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -552,7 +797,172 @@ func sovTx(x uint64) (n int) {
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgTest) Unmarshal(dAtA []byte) error {
+func (m *MsgStakeRemote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeRemote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeRemote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeRemoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeRemoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeRemoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeRemote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -575,10 +985,10 @@ func (m *MsgTest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakeRemote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakeRemote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -613,6 +1023,39 @@ func (m *MsgTest) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -634,7 +1077,7 @@ func (m *MsgTest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTestResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnstakeRemoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -657,10 +1100,10 @@ func (m *MsgTestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnstakeRemoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnstakeRemoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: